@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-gin. DO NOT EDIT.
+
+package proto
+
+import (
+	gin "github.com/gin-gonic/gin"
+	http "net/http"
+)
+
+type CouponHttpServer struct {
+	server CouponServer
+	router gin.IRouter
+}
+
+func RegisterCouponServerHTTPServer(srv CouponServer, r gin.IRouter) {
+	s := CouponHttpServer{
+		server: srv,
+		router: r,
+	}
+	s.RegisterService()
+}
+
+func (s *CouponHttpServer) CreateCouponTemplate_0(c *gin.Context) {
+	var in CouponTemplateRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CreateCouponTemplate(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) UpdateCouponTemplate_0(c *gin.Context) {
+	var in CouponTemplateRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.UpdateCouponTemplate(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) CouponTemplateList_0(c *gin.Context) {
+	var in CouponTemplateFilter
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CouponTemplateList(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) ClaimCoupon_0(c *gin.Context) {
+	var in ClaimCouponRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ClaimCoupon(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) UserCouponList_0(c *gin.Context) {
+	var in UserCouponFilter
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.UserCouponList(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) GetUserCoupon_0(c *gin.Context) {
+	var in UserCouponRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.GetUserCoupon(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) RedeemCoupon_0(c *gin.Context) {
+	var in UserCouponRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.RedeemCoupon(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) ReleaseCoupon_0(c *gin.Context) {
+	var in UserCouponRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ReleaseCoupon(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *CouponHttpServer) RegisterService() {
+
+	s.router.Handle("POST", "", s.CreateCouponTemplate_0)
+
+	s.router.Handle("POST", "", s.UpdateCouponTemplate_0)
+
+	s.router.Handle("POST", "", s.CouponTemplateList_0)
+
+	s.router.Handle("POST", "", s.ClaimCoupon_0)
+
+	s.router.Handle("POST", "", s.UserCouponList_0)
+
+	s.router.Handle("POST", "", s.GetUserCoupon_0)
+
+	s.router.Handle("POST", "", s.RedeemCoupon_0)
+
+	s.router.Handle("POST", "", s.ReleaseCoupon_0)
+
+}
@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: coupon.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Coupon_CreateCouponTemplate_FullMethodName = "/Coupon/CreateCouponTemplate"
+	Coupon_UpdateCouponTemplate_FullMethodName = "/Coupon/UpdateCouponTemplate"
+	Coupon_CouponTemplateList_FullMethodName   = "/Coupon/CouponTemplateList"
+	Coupon_ClaimCoupon_FullMethodName          = "/Coupon/ClaimCoupon"
+	Coupon_UserCouponList_FullMethodName       = "/Coupon/UserCouponList"
+	Coupon_GetUserCoupon_FullMethodName        = "/Coupon/GetUserCoupon"
+	Coupon_RedeemCoupon_FullMethodName         = "/Coupon/RedeemCoupon"
+	Coupon_ReleaseCoupon_FullMethodName        = "/Coupon/ReleaseCoupon"
+)
+
+// CouponClient is the client API for Coupon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 金额单位为分，时间为 Unix 秒，0 表示不限
+type CouponClient interface {
+	CreateCouponTemplate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateResponse, error)
+	UpdateCouponTemplate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CouponTemplateList(ctx context.Context, in *CouponTemplateFilter, opts ...grpc.CallOption) (*CouponTemplateListResponse, error)
+	ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponResponse, error)
+	UserCouponList(ctx context.Context, in *UserCouponFilter, opts ...grpc.CallOption) (*UserCouponListResponse, error)
+	GetUserCoupon(ctx context.Context, in *UserCouponRequest, opts ...grpc.CallOption) (*UserCouponResponse, error)
+	RedeemCoupon(ctx context.Context, in *UserCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReleaseCoupon(ctx context.Context, in *UserCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type couponClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCouponClient(cc grpc.ClientConnInterface) CouponClient {
+	return &couponClient{cc}
+}
+
+func (c *couponClient) CreateCouponTemplate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateResponse)
+	err := c.cc.Invoke(ctx, Coupon_CreateCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) UpdateCouponTemplate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Coupon_UpdateCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) CouponTemplateList(ctx context.Context, in *CouponTemplateFilter, opts ...grpc.CallOption) (*CouponTemplateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateListResponse)
+	err := c.cc.Invoke(ctx, Coupon_CouponTemplateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponResponse)
+	err := c.cc.Invoke(ctx, Coupon_ClaimCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) UserCouponList(ctx context.Context, in *UserCouponFilter, opts ...grpc.CallOption) (*UserCouponListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponListResponse)
+	err := c.cc.Invoke(ctx, Coupon_UserCouponList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) GetUserCoupon(ctx context.Context, in *UserCouponRequest, opts ...grpc.CallOption) (*UserCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponResponse)
+	err := c.cc.Invoke(ctx, Coupon_GetUserCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) RedeemCoupon(ctx context.Context, in *UserCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Coupon_RedeemCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponClient) ReleaseCoupon(ctx context.Context, in *UserCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Coupon_ReleaseCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CouponServer is the server API for Coupon service.
+// All implementations must embed UnimplementedCouponServer
+// for forward compatibility.
+//
+// 金额单位为分，时间为 Unix 秒，0 表示不限
+type CouponServer interface {
+	CreateCouponTemplate(context.Context, *CouponTemplateRequest) (*CouponTemplateResponse, error)
+	UpdateCouponTemplate(context.Context, *CouponTemplateRequest) (*emptypb.Empty, error)
+	CouponTemplateList(context.Context, *CouponTemplateFilter) (*CouponTemplateListResponse, error)
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponResponse, error)
+	UserCouponList(context.Context, *UserCouponFilter) (*UserCouponListResponse, error)
+	GetUserCoupon(context.Context, *UserCouponRequest) (*UserCouponResponse, error)
+	RedeemCoupon(context.Context, *UserCouponRequest) (*emptypb.Empty, error)
+	ReleaseCoupon(context.Context, *UserCouponRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCouponServer()
+}
+
+// UnimplementedCouponServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCouponServer struct{}
+
+func (UnimplementedCouponServer) CreateCouponTemplate(context.Context, *CouponTemplateRequest) (*CouponTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCouponTemplate not implemented")
+}
+func (UnimplementedCouponServer) UpdateCouponTemplate(context.Context, *CouponTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCouponTemplate not implemented")
+}
+func (UnimplementedCouponServer) CouponTemplateList(context.Context, *CouponTemplateFilter) (*CouponTemplateListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CouponTemplateList not implemented")
+}
+func (UnimplementedCouponServer) ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimCoupon not implemented")
+}
+func (UnimplementedCouponServer) UserCouponList(context.Context, *UserCouponFilter) (*UserCouponListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UserCouponList not implemented")
+}
+func (UnimplementedCouponServer) GetUserCoupon(context.Context, *UserCouponRequest) (*UserCouponResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserCoupon not implemented")
+}
+func (UnimplementedCouponServer) RedeemCoupon(context.Context, *UserCouponRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedCouponServer) ReleaseCoupon(context.Context, *UserCouponRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseCoupon not implemented")
+}
+func (UnimplementedCouponServer) mustEmbedUnimplementedCouponServer() {}
+func (UnimplementedCouponServer) testEmbeddedByValue()                {}
+
+// UnsafeCouponServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CouponServer will
+// result in compilation errors.
+type UnsafeCouponServer interface {
+	mustEmbedUnimplementedCouponServer()
+}
+
+func RegisterCouponServer(s grpc.ServiceRegistrar, srv CouponServer) {
+	// If the following call panics, it indicates UnimplementedCouponServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Coupon_ServiceDesc, srv)
+}
+
+func _Coupon_CreateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).CreateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_CreateCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).CreateCouponTemplate(ctx, req.(*CouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_UpdateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).UpdateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_UpdateCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).UpdateCouponTemplate(ctx, req.(*CouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_CouponTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).CouponTemplateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_CouponTemplateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).CouponTemplateList(ctx, req.(*CouponTemplateFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_ClaimCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).ClaimCoupon(ctx, req.(*ClaimCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_UserCouponList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).UserCouponList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_UserCouponList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).UserCouponList(ctx, req.(*UserCouponFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_GetUserCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).GetUserCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_GetUserCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).GetUserCoupon(ctx, req.(*UserCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).RedeemCoupon(ctx, req.(*UserCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupon_ReleaseCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServer).ReleaseCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coupon_ReleaseCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServer).ReleaseCoupon(ctx, req.(*UserCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Coupon_ServiceDesc is the grpc.ServiceDesc for Coupon service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Coupon_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Coupon",
+	HandlerType: (*CouponServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCouponTemplate",
+			Handler:    _Coupon_CreateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateCouponTemplate",
+			Handler:    _Coupon_UpdateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "CouponTemplateList",
+			Handler:    _Coupon_CouponTemplateList_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _Coupon_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "UserCouponList",
+			Handler:    _Coupon_UserCouponList_Handler,
+		},
+		{
+			MethodName: "GetUserCoupon",
+			Handler:    _Coupon_GetUserCoupon_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _Coupon_RedeemCoupon_Handler,
+		},
+		{
+			MethodName: "ReleaseCoupon",
+			Handler:    _Coupon_ReleaseCoupon_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coupon.proto",
+}
@@ -40,6 +40,23 @@ func (s *GoodsHttpServer) GoodsList_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *GoodsHttpServer) SuggestGoods_0(c *gin.Context) {
+	var in SuggestRequest
+
+	if err := c.ShouldBindQuery(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.SuggestGoods(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *GoodsHttpServer) BatchGetGoods_0(c *gin.Context) {
 	var in BatchGoodsIdInfo
 
@@ -522,10 +539,152 @@ func (s *GoodsHttpServer) UpdateCategoryBrand_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *GoodsHttpServer) SpecificationList_0(c *gin.Context) {
+	var in SpecificationFilterRequest
+
+	if err := c.ShouldBindQuery(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.SpecificationList(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *GoodsHttpServer) CreateSpecification_0(c *gin.Context) {
+	var in SpecificationRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CreateSpecification(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *GoodsHttpServer) UpdateSpecification_0(c *gin.Context) {
+	var in SpecificationRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+		atoi, err := strconv.Atoi(c.Params.ByName("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	in.Id = int32(atoi)
+
+	out, err := s.server.UpdateSpecification(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *GoodsHttpServer) GoodsSkuList_0(c *gin.Context) {
+	var in GoodInfoRequest
+
+	if err := c.ShouldBindQuery(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+		atoi, err := strconv.Atoi(c.Params.ByName("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	in.Id = int32(atoi)
+
+	out, err := s.server.GoodsSkuList(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *GoodsHttpServer) BatchGetSkus_0(c *gin.Context) {
+	var in BatchSkuIdInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.BatchGetSkus(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *GoodsHttpServer) CreateSku_0(c *gin.Context) {
+	var in SkuInfoRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CreateSku(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *GoodsHttpServer) UpdateSku_0(c *gin.Context) {
+	var in SkuInfoRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+		atoi, err := strconv.Atoi(c.Params.ByName("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	in.Id = int32(atoi)
+
+	out, err := s.server.UpdateSku(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *GoodsHttpServer) RegisterService() {
 
 	s.router.Handle("GET", "/g/v1/good/list", s.GoodsList_0)
 
+	s.router.Handle("GET", "/g/v1/good/suggest", s.SuggestGoods_0)
+
 	s.router.Handle("POST", "/g/v1/good/batch", s.BatchGetGoods_0)
 
 	s.router.Handle("POST", "/g/v1/good", s.CreateGoods_0)
@@ -572,4 +731,18 @@ func (s *GoodsHttpServer) RegisterService() {
 
 	s.router.Handle("PUT", "/g/v1/categorybrands/:id", s.UpdateCategoryBrand_0)
 
+	s.router.Handle("GET", "/g/v1/specifications", s.SpecificationList_0)
+
+	s.router.Handle("POST", "/g/v1/specifications", s.CreateSpecification_0)
+
+	s.router.Handle("PUT", "/g/v1/specifications/:id", s.UpdateSpecification_0)
+
+	s.router.Handle("GET", "/g/v1/good/:id/skus", s.GoodsSkuList_0)
+
+	s.router.Handle("POST", "/g/v1/skus/batch", s.BatchGetSkus_0)
+
+	s.router.Handle("POST", "/g/v1/skus", s.CreateSku_0)
+
+	s.router.Handle("PUT", "/g/v1/skus/:id", s.UpdateSku_0)
+
 }
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Goods_GoodsList_FullMethodName            = "/Goods/GoodsList"
+	Goods_SuggestGoods_FullMethodName         = "/Goods/SuggestGoods"
 	Goods_BatchGetGoods_FullMethodName        = "/Goods/BatchGetGoods"
 	Goods_CreateGoods_FullMethodName          = "/Goods/CreateGoods"
 	Goods_DeleteGoods_FullMethodName          = "/Goods/DeleteGoods"
@@ -44,6 +45,13 @@ const (
 	Goods_CreateCategoryBrand_FullMethodName  = "/Goods/CreateCategoryBrand"
 	Goods_DeleteCategoryBrand_FullMethodName  = "/Goods/DeleteCategoryBrand"
 	Goods_UpdateCategoryBrand_FullMethodName  = "/Goods/UpdateCategoryBrand"
+	Goods_SpecificationList_FullMethodName    = "/Goods/SpecificationList"
+	Goods_CreateSpecification_FullMethodName  = "/Goods/CreateSpecification"
+	Goods_UpdateSpecification_FullMethodName  = "/Goods/UpdateSpecification"
+	Goods_GoodsSkuList_FullMethodName         = "/Goods/GoodsSkuList"
+	Goods_BatchGetSkus_FullMethodName         = "/Goods/BatchGetSkus"
+	Goods_CreateSku_FullMethodName            = "/Goods/CreateSku"
+	Goods_UpdateSku_FullMethodName            = "/Goods/UpdateSku"
 )
 
 // GoodsClient is the client API for Goods service.
@@ -52,6 +60,7 @@ const (
 type GoodsClient interface {
 	// 商品接口
 	GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	SuggestGoods(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
 	BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error)
 	CreateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
 	DeleteGoods(ctx context.Context, in *DeleteGoodsInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -79,6 +88,15 @@ type GoodsClient interface {
 	CreateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*CategoryBrandResponse, error)
 	DeleteCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 商品规格
+	SpecificationList(ctx context.Context, in *SpecificationFilterRequest, opts ...grpc.CallOption) (*SpecificationListResponse, error)
+	CreateSpecification(ctx context.Context, in *SpecificationRequest, opts ...grpc.CallOption) (*SpecificationResponse, error)
+	UpdateSpecification(ctx context.Context, in *SpecificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 商品 SKU
+	GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error)
+	BatchGetSkus(ctx context.Context, in *BatchSkuIdInfo, opts ...grpc.CallOption) (*SkuListResponse, error)
+	CreateSku(ctx context.Context, in *SkuInfoRequest, opts ...grpc.CallOption) (*SkuInfoResponse, error)
+	UpdateSku(ctx context.Context, in *SkuInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type goodsClient struct {
@@ -99,6 +117,16 @@ func (c *goodsClient) GoodsList(ctx context.Context, in *GoodsFilterRequest, opt
 	return out, nil
 }
 
+func (c *goodsClient) SuggestGoods(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, Goods_SuggestGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GoodsListResponse)
@@ -329,12 +357,83 @@ func (c *goodsClient) UpdateCategoryBrand(ctx context.Context, in *CategoryBrand
 	return out, nil
 }
 
+func (c *goodsClient) SpecificationList(ctx context.Context, in *SpecificationFilterRequest, opts ...grpc.CallOption) (*SpecificationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpecificationListResponse)
+	err := c.cc.Invoke(ctx, Goods_SpecificationList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateSpecification(ctx context.Context, in *SpecificationRequest, opts ...grpc.CallOption) (*SpecificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpecificationResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateSpecification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateSpecification(ctx context.Context, in *SpecificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateSpecification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsSkuList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BatchGetSkus(ctx context.Context, in *BatchSkuIdInfo, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_BatchGetSkus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateSku(ctx context.Context, in *SkuInfoRequest, opts ...grpc.CallOption) (*SkuInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateSku(ctx context.Context, in *SkuInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoodsServer is the server API for Goods service.
 // All implementations must embed UnimplementedGoodsServer
 // for forward compatibility.
 type GoodsServer interface {
 	// 商品接口
 	GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
+	SuggestGoods(context.Context, *SuggestRequest) (*SuggestResponse, error)
 	BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error)
 	CreateGoods(context.Context, *CreateGoodsInfo) (*GoodsInfoResponse, error)
 	DeleteGoods(context.Context, *DeleteGoodsInfo) (*emptypb.Empty, error)
@@ -362,6 +461,15 @@ type GoodsServer interface {
 	CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error)
 	DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*emptypb.Empty, error)
 	UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*emptypb.Empty, error)
+	// 商品规格
+	SpecificationList(context.Context, *SpecificationFilterRequest) (*SpecificationListResponse, error)
+	CreateSpecification(context.Context, *SpecificationRequest) (*SpecificationResponse, error)
+	UpdateSpecification(context.Context, *SpecificationRequest) (*emptypb.Empty, error)
+	// 商品 SKU
+	GoodsSkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error)
+	BatchGetSkus(context.Context, *BatchSkuIdInfo) (*SkuListResponse, error)
+	CreateSku(context.Context, *SkuInfoRequest) (*SkuInfoResponse, error)
+	UpdateSku(context.Context, *SkuInfoRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedGoodsServer()
 }
 
@@ -375,6 +483,9 @@ type UnimplementedGoodsServer struct{}
 func (UnimplementedGoodsServer) GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GoodsList not implemented")
 }
+func (UnimplementedGoodsServer) SuggestGoods(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestGoods not implemented")
+}
 func (UnimplementedGoodsServer) BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchGetGoods not implemented")
 }
@@ -444,6 +555,27 @@ func (UnimplementedGoodsServer) DeleteCategoryBrand(context.Context, *CategoryBr
 func (UnimplementedGoodsServer) UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateCategoryBrand not implemented")
 }
+func (UnimplementedGoodsServer) SpecificationList(context.Context, *SpecificationFilterRequest) (*SpecificationListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SpecificationList not implemented")
+}
+func (UnimplementedGoodsServer) CreateSpecification(context.Context, *SpecificationRequest) (*SpecificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSpecification not implemented")
+}
+func (UnimplementedGoodsServer) UpdateSpecification(context.Context, *SpecificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSpecification not implemented")
+}
+func (UnimplementedGoodsServer) GoodsSkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GoodsSkuList not implemented")
+}
+func (UnimplementedGoodsServer) BatchGetSkus(context.Context, *BatchSkuIdInfo) (*SkuListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetSkus not implemented")
+}
+func (UnimplementedGoodsServer) CreateSku(context.Context, *SkuInfoRequest) (*SkuInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSku not implemented")
+}
+func (UnimplementedGoodsServer) UpdateSku(context.Context, *SkuInfoRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSku not implemented")
+}
 func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
 func (UnimplementedGoodsServer) testEmbeddedByValue()               {}
 
@@ -483,6 +615,24 @@ func _Goods_GoodsList_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_SuggestGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SuggestGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SuggestGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SuggestGoods(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_BatchGetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchGoodsIdInfo)
 	if err := dec(in); err != nil {
@@ -897,6 +1047,132 @@ func _Goods_UpdateCategoryBrand_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_SpecificationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecificationFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SpecificationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SpecificationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SpecificationList(ctx, req.(*SpecificationFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateSpecification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateSpecification(ctx, req.(*SpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateSpecification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateSpecification(ctx, req.(*SpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GoodsSkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsSkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsSkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsSkuList(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchGetSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSkuIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetSkus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetSkus(ctx, req.(*BatchSkuIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateSku(ctx, req.(*SkuInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateSku(ctx, req.(*SkuInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -908,6 +1184,10 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GoodsList",
 			Handler:    _Goods_GoodsList_Handler,
 		},
+		{
+			MethodName: "SuggestGoods",
+			Handler:    _Goods_SuggestGoods_Handler,
+		},
 		{
 			MethodName: "BatchGetGoods",
 			Handler:    _Goods_BatchGetGoods_Handler,
@@ -1000,6 +1280,34 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCategoryBrand",
 			Handler:    _Goods_UpdateCategoryBrand_Handler,
 		},
+		{
+			MethodName: "SpecificationList",
+			Handler:    _Goods_SpecificationList_Handler,
+		},
+		{
+			MethodName: "CreateSpecification",
+			Handler:    _Goods_CreateSpecification_Handler,
+		},
+		{
+			MethodName: "UpdateSpecification",
+			Handler:    _Goods_UpdateSpecification_Handler,
+		},
+		{
+			MethodName: "GoodsSkuList",
+			Handler:    _Goods_GoodsSkuList_Handler,
+		},
+		{
+			MethodName: "BatchGetSkus",
+			Handler:    _Goods_BatchGetSkus_Handler,
+		},
+		{
+			MethodName: "CreateSku",
+			Handler:    _Goods_CreateSku_Handler,
+		},
+		{
+			MethodName: "UpdateSku",
+			Handler:    _Goods_UpdateSku_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goods.proto",
@@ -88,6 +88,244 @@ func (s *InventoryHttpServer) Reback_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *InventoryHttpServer) TrySell_0(c *gin.Context) {
+	var in SellInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.TrySell(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) ConfirmSell_0(c *gin.Context) {
+	var in SellInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ConfirmSell(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) CancelSell_0(c *gin.Context) {
+	var in SellInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CancelSell(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) BatchInvDetail_0(c *gin.Context) {
+	var in BatchInvRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.BatchInvDetail(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) CreateWarehouse_0(c *gin.Context) {
+	var in WarehouseInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CreateWarehouse(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) AdjustInv_0(c *gin.Context) {
+	var in AdjustInvInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.AdjustInv(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) InventoryLedger_0(c *gin.Context) {
+	var in LedgerFilterRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.InventoryLedger(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) SetStockThreshold_0(c *gin.Context) {
+	var in StockThresholdInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.SetStockThreshold(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) PreloadFlashSale_0(c *gin.Context) {
+	var in FlashSaleInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.PreloadFlashSale(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) StopFlashSale_0(c *gin.Context) {
+	var in FlashSaleInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.StopFlashSale(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) RunReconcile_0(c *gin.Context) {
+	var in ReconcileRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.RunReconcile(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) ReconcileReport_0(c *gin.Context) {
+	var in ReconcileReportRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ReconcileReport(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) ReturnGoods_0(c *gin.Context) {
+	var in SellInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ReturnGoods(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *InventoryHttpServer) PlanWarehouse_0(c *gin.Context) {
+	var in SellInfo
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.PlanWarehouse(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *InventoryHttpServer) RegisterService() {
 
 	s.router.Handle("POST", "", s.SetInv_0)
@@ -98,4 +336,32 @@ func (s *InventoryHttpServer) RegisterService() {
 
 	s.router.Handle("POST", "", s.Reback_0)
 
+	s.router.Handle("POST", "", s.TrySell_0)
+
+	s.router.Handle("POST", "", s.ConfirmSell_0)
+
+	s.router.Handle("POST", "", s.CancelSell_0)
+
+	s.router.Handle("POST", "", s.BatchInvDetail_0)
+
+	s.router.Handle("POST", "", s.CreateWarehouse_0)
+
+	s.router.Handle("POST", "", s.AdjustInv_0)
+
+	s.router.Handle("POST", "", s.InventoryLedger_0)
+
+	s.router.Handle("POST", "", s.SetStockThreshold_0)
+
+	s.router.Handle("POST", "", s.PreloadFlashSale_0)
+
+	s.router.Handle("POST", "", s.StopFlashSale_0)
+
+	s.router.Handle("POST", "", s.RunReconcile_0)
+
+	s.router.Handle("POST", "", s.ReconcileReport_0)
+
+	s.router.Handle("POST", "", s.ReturnGoods_0)
+
+	s.router.Handle("POST", "", s.PlanWarehouse_0)
+
 }
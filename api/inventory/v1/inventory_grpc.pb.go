@@ -27,6 +27,20 @@ type InventoryClient interface {
 	InvDetail(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*GoodsInvInfo, error)
 	Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Reback(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TrySell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ConfirmSell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelSell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchInvDetail(ctx context.Context, in *BatchInvRequest, opts ...grpc.CallOption) (*BatchInvResponse, error)
+	CreateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*WarehouseInfo, error)
+	AdjustInv(ctx context.Context, in *AdjustInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InventoryLedger(ctx context.Context, in *LedgerFilterRequest, opts ...grpc.CallOption) (*LedgerListResponse, error)
+	SetStockThreshold(ctx context.Context, in *StockThresholdInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PreloadFlashSale(ctx context.Context, in *FlashSaleInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StopFlashSale(ctx context.Context, in *FlashSaleInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RunReconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileReportResponse, error)
+	ReconcileReport(ctx context.Context, in *ReconcileReportRequest, opts ...grpc.CallOption) (*ReconcileReportResponse, error)
+	ReturnGoods(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PlanWarehouse(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*SellInfo, error)
 }
 
 type inventoryClient struct {
@@ -73,6 +87,132 @@ func (c *inventoryClient) Reback(ctx context.Context, in *SellInfo, opts ...grpc
 	return out, nil
 }
 
+func (c *inventoryClient) TrySell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/TrySell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ConfirmSell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/ConfirmSell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) CancelSell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/CancelSell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) BatchInvDetail(ctx context.Context, in *BatchInvRequest, opts ...grpc.CallOption) (*BatchInvResponse, error) {
+	out := new(BatchInvResponse)
+	err := c.cc.Invoke(ctx, "/Inventory/BatchInvDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) CreateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*WarehouseInfo, error) {
+	out := new(WarehouseInfo)
+	err := c.cc.Invoke(ctx, "/Inventory/CreateWarehouse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) AdjustInv(ctx context.Context, in *AdjustInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/AdjustInv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) InventoryLedger(ctx context.Context, in *LedgerFilterRequest, opts ...grpc.CallOption) (*LedgerListResponse, error) {
+	out := new(LedgerListResponse)
+	err := c.cc.Invoke(ctx, "/Inventory/InventoryLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) SetStockThreshold(ctx context.Context, in *StockThresholdInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/SetStockThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) PreloadFlashSale(ctx context.Context, in *FlashSaleInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/PreloadFlashSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) StopFlashSale(ctx context.Context, in *FlashSaleInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/StopFlashSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) RunReconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileReportResponse, error) {
+	out := new(ReconcileReportResponse)
+	err := c.cc.Invoke(ctx, "/Inventory/RunReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ReconcileReport(ctx context.Context, in *ReconcileReportRequest, opts ...grpc.CallOption) (*ReconcileReportResponse, error) {
+	out := new(ReconcileReportResponse)
+	err := c.cc.Invoke(ctx, "/Inventory/ReconcileReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) ReturnGoods(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/Inventory/ReturnGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) PlanWarehouse(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*SellInfo, error) {
+	out := new(SellInfo)
+	err := c.cc.Invoke(ctx, "/Inventory/PlanWarehouse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServer is the server API for Inventory service.
 // All implementations must embed UnimplementedInventoryServer
 // for forward compatibility
@@ -81,6 +221,20 @@ type InventoryServer interface {
 	InvDetail(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error)
 	Sell(context.Context, *SellInfo) (*emptypb.Empty, error)
 	Reback(context.Context, *SellInfo) (*emptypb.Empty, error)
+	TrySell(context.Context, *SellInfo) (*emptypb.Empty, error)
+	ConfirmSell(context.Context, *SellInfo) (*emptypb.Empty, error)
+	CancelSell(context.Context, *SellInfo) (*emptypb.Empty, error)
+	BatchInvDetail(context.Context, *BatchInvRequest) (*BatchInvResponse, error)
+	CreateWarehouse(context.Context, *WarehouseInfo) (*WarehouseInfo, error)
+	AdjustInv(context.Context, *AdjustInvInfo) (*emptypb.Empty, error)
+	InventoryLedger(context.Context, *LedgerFilterRequest) (*LedgerListResponse, error)
+	SetStockThreshold(context.Context, *StockThresholdInfo) (*emptypb.Empty, error)
+	PreloadFlashSale(context.Context, *FlashSaleInfo) (*emptypb.Empty, error)
+	StopFlashSale(context.Context, *FlashSaleInfo) (*emptypb.Empty, error)
+	RunReconcile(context.Context, *ReconcileRequest) (*ReconcileReportResponse, error)
+	ReconcileReport(context.Context, *ReconcileReportRequest) (*ReconcileReportResponse, error)
+	ReturnGoods(context.Context, *SellInfo) (*emptypb.Empty, error)
+	PlanWarehouse(context.Context, *SellInfo) (*SellInfo, error)
 	mustEmbedUnimplementedInventoryServer()
 }
 
@@ -100,6 +254,48 @@ func (UnimplementedInventoryServer) Sell(context.Context, *SellInfo) (*emptypb.E
 func (UnimplementedInventoryServer) Reback(context.Context, *SellInfo) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reback not implemented")
 }
+func (UnimplementedInventoryServer) TrySell(context.Context, *SellInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrySell not implemented")
+}
+func (UnimplementedInventoryServer) ConfirmSell(context.Context, *SellInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSell not implemented")
+}
+func (UnimplementedInventoryServer) CancelSell(context.Context, *SellInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSell not implemented")
+}
+func (UnimplementedInventoryServer) BatchInvDetail(context.Context, *BatchInvRequest) (*BatchInvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchInvDetail not implemented")
+}
+func (UnimplementedInventoryServer) CreateWarehouse(context.Context, *WarehouseInfo) (*WarehouseInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedInventoryServer) AdjustInv(context.Context, *AdjustInvInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustInv not implemented")
+}
+func (UnimplementedInventoryServer) InventoryLedger(context.Context, *LedgerFilterRequest) (*LedgerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InventoryLedger not implemented")
+}
+func (UnimplementedInventoryServer) SetStockThreshold(context.Context, *StockThresholdInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockThreshold not implemented")
+}
+func (UnimplementedInventoryServer) PreloadFlashSale(context.Context, *FlashSaleInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreloadFlashSale not implemented")
+}
+func (UnimplementedInventoryServer) StopFlashSale(context.Context, *FlashSaleInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopFlashSale not implemented")
+}
+func (UnimplementedInventoryServer) RunReconcile(context.Context, *ReconcileRequest) (*ReconcileReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunReconcile not implemented")
+}
+func (UnimplementedInventoryServer) ReconcileReport(context.Context, *ReconcileReportRequest) (*ReconcileReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileReport not implemented")
+}
+func (UnimplementedInventoryServer) ReturnGoods(context.Context, *SellInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnGoods not implemented")
+}
+func (UnimplementedInventoryServer) PlanWarehouse(context.Context, *SellInfo) (*SellInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanWarehouse not implemented")
+}
 func (UnimplementedInventoryServer) mustEmbedUnimplementedInventoryServer() {}
 
 // UnsafeInventoryServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +381,258 @@ func _Inventory_Reback_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inventory_TrySell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).TrySell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/TrySell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).TrySell(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ConfirmSell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ConfirmSell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/ConfirmSell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ConfirmSell(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_CancelSell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CancelSell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/CancelSell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CancelSell(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_BatchInvDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchInvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).BatchInvDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/BatchInvDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).BatchInvDetail(ctx, req.(*BatchInvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/CreateWarehouse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CreateWarehouse(ctx, req.(*WarehouseInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_AdjustInv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustInvInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).AdjustInv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/AdjustInv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).AdjustInv(ctx, req.(*AdjustInvInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_InventoryLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).InventoryLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/InventoryLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).InventoryLedger(ctx, req.(*LedgerFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_SetStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockThresholdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).SetStockThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/SetStockThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).SetStockThreshold(ctx, req.(*StockThresholdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_PreloadFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlashSaleInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).PreloadFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/PreloadFlashSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).PreloadFlashSale(ctx, req.(*FlashSaleInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_StopFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlashSaleInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).StopFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/StopFlashSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).StopFlashSale(ctx, req.(*FlashSaleInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_RunReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).RunReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/RunReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).RunReconcile(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ReconcileReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ReconcileReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/ReconcileReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ReconcileReport(ctx, req.(*ReconcileReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_ReturnGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).ReturnGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/ReturnGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).ReturnGoods(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_PlanWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).PlanWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Inventory/PlanWarehouse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).PlanWarehouse(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Inventory_ServiceDesc is the grpc.ServiceDesc for Inventory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +656,62 @@ var Inventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reback",
 			Handler:    _Inventory_Reback_Handler,
 		},
+		{
+			MethodName: "TrySell",
+			Handler:    _Inventory_TrySell_Handler,
+		},
+		{
+			MethodName: "ConfirmSell",
+			Handler:    _Inventory_ConfirmSell_Handler,
+		},
+		{
+			MethodName: "CancelSell",
+			Handler:    _Inventory_CancelSell_Handler,
+		},
+		{
+			MethodName: "BatchInvDetail",
+			Handler:    _Inventory_BatchInvDetail_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _Inventory_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "AdjustInv",
+			Handler:    _Inventory_AdjustInv_Handler,
+		},
+		{
+			MethodName: "InventoryLedger",
+			Handler:    _Inventory_InventoryLedger_Handler,
+		},
+		{
+			MethodName: "SetStockThreshold",
+			Handler:    _Inventory_SetStockThreshold_Handler,
+		},
+		{
+			MethodName: "PreloadFlashSale",
+			Handler:    _Inventory_PreloadFlashSale_Handler,
+		},
+		{
+			MethodName: "StopFlashSale",
+			Handler:    _Inventory_StopFlashSale_Handler,
+		},
+		{
+			MethodName: "RunReconcile",
+			Handler:    _Inventory_RunReconcile_Handler,
+		},
+		{
+			MethodName: "ReconcileReport",
+			Handler:    _Inventory_ReconcileReport_Handler,
+		},
+		{
+			MethodName: "ReturnGoods",
+			Handler:    _Inventory_ReturnGoods_Handler,
+		},
+		{
+			MethodName: "PlanWarehouse",
+			Handler:    _Inventory_PlanWarehouse_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory.proto",
@@ -88,6 +88,40 @@ func (s *OrderHttpServer) DeleteCartItem_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *OrderHttpServer) MergeCart_0(c *gin.Context) {
+	var in MergeCartRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.MergeCart(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) ValidateCart_0(c *gin.Context) {
+	var in ValidateCartRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ValidateCart(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *OrderHttpServer) CreateOrder_0(c *gin.Context) {
 	var in CreateRequest
 
@@ -106,7 +140,7 @@ func (s *OrderHttpServer) CreateOrder_0(c *gin.Context) {
 }
 
 func (s *OrderHttpServer) CreateOrderCom_0(c *gin.Context) {
-	var in OrderRequest
+	var in CreateRequest
 
 	if err := c.ShouldBindJSON(&in); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -139,6 +173,23 @@ func (s *OrderHttpServer) SubmitOrder_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *OrderHttpServer) PreviewOrder_0(c *gin.Context) {
+	var in OrderRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.PreviewOrder(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *OrderHttpServer) OrderList_0(c *gin.Context) {
 	var in OrderFilterRequest
 
@@ -190,6 +241,125 @@ func (s *OrderHttpServer) UpdateOrderStatus_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *OrderHttpServer) CancelOrder_0(c *gin.Context) {
+	var in OrderRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CancelOrder(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) CloseOrder_0(c *gin.Context) {
+	var in OrderStatus
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.CloseOrder(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) OrderStatusHistory_0(c *gin.Context) {
+	var in OrderStatusHistoryRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.OrderStatusHistory(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) ApplyRefund_0(c *gin.Context) {
+	var in RefundApplyRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ApplyRefund(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) ApproveRefund_0(c *gin.Context) {
+	var in RefundReviewRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ApproveRefund(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) RejectRefund_0(c *gin.Context) {
+	var in RefundReviewRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.RejectRefund(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) RefundList_0(c *gin.Context) {
+	var in RefundFilterRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.RefundList(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *OrderHttpServer) OrderDetailByOrderSn_0(c *gin.Context) {
 	var in AlipayOrderSnRequest
 
@@ -207,6 +377,91 @@ func (s *OrderHttpServer) OrderDetailByOrderSn_0(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
+func (s *OrderHttpServer) PayNotify_0(c *gin.Context) {
+	var in PayNotification
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.PayNotify(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) PayNotificationList_0(c *gin.Context) {
+	var in PayNotificationFilterRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.PayNotificationList(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) ReplayPayNotification_0(c *gin.Context) {
+	var in PayNotificationReplayRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ReplayPayNotification(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) ShipOrder_0(c *gin.Context) {
+	var in ShipRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ShipOrder(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (s *OrderHttpServer) ShipmentDetail_0(c *gin.Context) {
+	var in ShipmentRequest
+
+	if err := c.ShouldBindJSON(&in); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	out, err := s.server.ShipmentDetail(c, &in)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
 func (s *OrderHttpServer) RegisterService() {
 
 	s.router.Handle("POST", "", s.CartItemList_0)
@@ -217,18 +472,48 @@ func (s *OrderHttpServer) RegisterService() {
 
 	s.router.Handle("POST", "", s.DeleteCartItem_0)
 
+	s.router.Handle("POST", "", s.MergeCart_0)
+
+	s.router.Handle("POST", "", s.ValidateCart_0)
+
 	s.router.Handle("POST", "", s.CreateOrder_0)
 
 	s.router.Handle("POST", "", s.CreateOrderCom_0)
 
 	s.router.Handle("POST", "", s.SubmitOrder_0)
 
+	s.router.Handle("POST", "", s.PreviewOrder_0)
+
 	s.router.Handle("POST", "", s.OrderList_0)
 
 	s.router.Handle("POST", "", s.OrderDetail_0)
 
 	s.router.Handle("POST", "", s.UpdateOrderStatus_0)
 
+	s.router.Handle("POST", "", s.CancelOrder_0)
+
+	s.router.Handle("POST", "", s.CloseOrder_0)
+
+	s.router.Handle("POST", "", s.OrderStatusHistory_0)
+
+	s.router.Handle("POST", "", s.ApplyRefund_0)
+
+	s.router.Handle("POST", "", s.ApproveRefund_0)
+
+	s.router.Handle("POST", "", s.RejectRefund_0)
+
+	s.router.Handle("POST", "", s.RefundList_0)
+
 	s.router.Handle("POST", "", s.OrderDetailByOrderSn_0)
 
+	s.router.Handle("POST", "", s.PayNotify_0)
+
+	s.router.Handle("POST", "", s.PayNotificationList_0)
+
+	s.router.Handle("POST", "", s.ReplayPayNotification_0)
+
+	s.router.Handle("POST", "", s.ShipOrder_0)
+
+	s.router.Handle("POST", "", s.ShipmentDetail_0)
+
 }
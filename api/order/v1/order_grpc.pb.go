@@ -20,17 +20,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Order_CartItemList_FullMethodName         = "/Order/CartItemList"
-	Order_CreateCartItem_FullMethodName       = "/Order/CreateCartItem"
-	Order_UpdateCartItem_FullMethodName       = "/Order/UpdateCartItem"
-	Order_DeleteCartItem_FullMethodName       = "/Order/DeleteCartItem"
-	Order_CreateOrder_FullMethodName          = "/Order/CreateOrder"
-	Order_CreateOrderCom_FullMethodName       = "/Order/CreateOrderCom"
-	Order_SubmitOrder_FullMethodName          = "/Order/SubmitOrder"
-	Order_OrderList_FullMethodName            = "/Order/OrderList"
-	Order_OrderDetail_FullMethodName          = "/Order/OrderDetail"
-	Order_UpdateOrderStatus_FullMethodName    = "/Order/UpdateOrderStatus"
-	Order_OrderDetailByOrderSn_FullMethodName = "/Order/OrderDetailByOrderSn"
+	Order_CartItemList_FullMethodName          = "/Order/CartItemList"
+	Order_CreateCartItem_FullMethodName        = "/Order/CreateCartItem"
+	Order_UpdateCartItem_FullMethodName        = "/Order/UpdateCartItem"
+	Order_DeleteCartItem_FullMethodName        = "/Order/DeleteCartItem"
+	Order_MergeCart_FullMethodName             = "/Order/MergeCart"
+	Order_ValidateCart_FullMethodName          = "/Order/ValidateCart"
+	Order_CreateOrder_FullMethodName           = "/Order/CreateOrder"
+	Order_CreateOrderCom_FullMethodName        = "/Order/CreateOrderCom"
+	Order_SubmitOrder_FullMethodName           = "/Order/SubmitOrder"
+	Order_PreviewOrder_FullMethodName          = "/Order/PreviewOrder"
+	Order_OrderList_FullMethodName             = "/Order/OrderList"
+	Order_OrderDetail_FullMethodName           = "/Order/OrderDetail"
+	Order_UpdateOrderStatus_FullMethodName     = "/Order/UpdateOrderStatus"
+	Order_CancelOrder_FullMethodName           = "/Order/CancelOrder"
+	Order_CloseOrder_FullMethodName            = "/Order/CloseOrder"
+	Order_OrderStatusHistory_FullMethodName    = "/Order/OrderStatusHistory"
+	Order_ApplyRefund_FullMethodName           = "/Order/ApplyRefund"
+	Order_ApproveRefund_FullMethodName         = "/Order/ApproveRefund"
+	Order_RejectRefund_FullMethodName          = "/Order/RejectRefund"
+	Order_RefundList_FullMethodName            = "/Order/RefundList"
+	Order_OrderDetailByOrderSn_FullMethodName  = "/Order/OrderDetailByOrderSn"
+	Order_PayNotify_FullMethodName             = "/Order/PayNotify"
+	Order_PayNotificationList_FullMethodName   = "/Order/PayNotificationList"
+	Order_ReplayPayNotification_FullMethodName = "/Order/ReplayPayNotification"
+	Order_ShipOrder_FullMethodName             = "/Order/ShipOrder"
+	Order_ShipmentDetail_FullMethodName        = "/Order/ShipmentDetail"
 )
 
 // OrderClient is the client API for Order service.
@@ -42,15 +57,33 @@ type OrderClient interface {
 	CreateCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*ShopCartInfoResponse, error)
 	UpdateCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateCart(ctx context.Context, in *ValidateCartRequest, opts ...grpc.CallOption) (*ValidateCartResponse, error)
 	// 订单
 	CreateOrder(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	CreateOrderCom(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateOrderCom(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SubmitOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*SubmitResponse, error)
+	PreviewOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error)
 	OrderList(ctx context.Context, in *OrderFilterRequest, opts ...grpc.CallOption) (*OrderListResponse, error)
 	OrderDetail(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoDetailResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CloseOrder(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	OrderStatusHistory(ctx context.Context, in *OrderStatusHistoryRequest, opts ...grpc.CallOption) (*OrderStatusHistoryResponse, error)
+	// 退款
+	ApplyRefund(ctx context.Context, in *RefundApplyRequest, opts ...grpc.CallOption) (*RefundInfo, error)
+	ApproveRefund(ctx context.Context, in *RefundReviewRequest, opts ...grpc.CallOption) (*RefundInfo, error)
+	RejectRefund(ctx context.Context, in *RefundReviewRequest, opts ...grpc.CallOption) (*RefundInfo, error)
+	RefundList(ctx context.Context, in *RefundFilterRequest, opts ...grpc.CallOption) (*RefundListResponse, error)
 	// OrderDetailByOrderSn 获取订单详情  用于支付宝回调
 	OrderDetailByOrderSn(ctx context.Context, in *AlipayOrderSnRequest, opts ...grpc.CallOption) (*OrderInfoDetailResponse, error)
+	// 支付通知
+	PayNotify(ctx context.Context, in *PayNotification, opts ...grpc.CallOption) (*PayNotificationInfo, error)
+	PayNotificationList(ctx context.Context, in *PayNotificationFilterRequest, opts ...grpc.CallOption) (*PayNotificationListResponse, error)
+	ReplayPayNotification(ctx context.Context, in *PayNotificationReplayRequest, opts ...grpc.CallOption) (*PayNotificationInfo, error)
+	// 发货
+	ShipOrder(ctx context.Context, in *ShipRequest, opts ...grpc.CallOption) (*ShipmentInfo, error)
+	ShipmentDetail(ctx context.Context, in *ShipmentRequest, opts ...grpc.CallOption) (*ShipmentInfo, error)
 }
 
 type orderClient struct {
@@ -101,6 +134,26 @@ func (c *orderClient) DeleteCartItem(ctx context.Context, in *CartItemRequest, o
 	return out, nil
 }
 
+func (c *orderClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Order_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ValidateCart(ctx context.Context, in *ValidateCartRequest, opts ...grpc.CallOption) (*ValidateCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCartResponse)
+	err := c.cc.Invoke(ctx, Order_ValidateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) CreateOrder(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -111,7 +164,7 @@ func (c *orderClient) CreateOrder(ctx context.Context, in *CreateRequest, opts .
 	return out, nil
 }
 
-func (c *orderClient) CreateOrderCom(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *orderClient) CreateOrderCom(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Order_CreateOrderCom_FullMethodName, in, out, cOpts...)
@@ -131,6 +184,16 @@ func (c *orderClient) SubmitOrder(ctx context.Context, in *OrderRequest, opts ..
 	return out, nil
 }
 
+func (c *orderClient) PreviewOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewOrderResponse)
+	err := c.cc.Invoke(ctx, Order_PreviewOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) OrderList(ctx context.Context, in *OrderFilterRequest, opts ...grpc.CallOption) (*OrderListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrderListResponse)
@@ -161,6 +224,76 @@ func (c *orderClient) UpdateOrderStatus(ctx context.Context, in *OrderStatus, op
 	return out, nil
 }
 
+func (c *orderClient) CancelOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Order_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CloseOrder(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Order_CloseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) OrderStatusHistory(ctx context.Context, in *OrderStatusHistoryRequest, opts ...grpc.CallOption) (*OrderStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, Order_OrderStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ApplyRefund(ctx context.Context, in *RefundApplyRequest, opts ...grpc.CallOption) (*RefundInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundInfo)
+	err := c.cc.Invoke(ctx, Order_ApplyRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ApproveRefund(ctx context.Context, in *RefundReviewRequest, opts ...grpc.CallOption) (*RefundInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundInfo)
+	err := c.cc.Invoke(ctx, Order_ApproveRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) RejectRefund(ctx context.Context, in *RefundReviewRequest, opts ...grpc.CallOption) (*RefundInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundInfo)
+	err := c.cc.Invoke(ctx, Order_RejectRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) RefundList(ctx context.Context, in *RefundFilterRequest, opts ...grpc.CallOption) (*RefundListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundListResponse)
+	err := c.cc.Invoke(ctx, Order_RefundList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) OrderDetailByOrderSn(ctx context.Context, in *AlipayOrderSnRequest, opts ...grpc.CallOption) (*OrderInfoDetailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrderInfoDetailResponse)
@@ -171,6 +304,56 @@ func (c *orderClient) OrderDetailByOrderSn(ctx context.Context, in *AlipayOrderS
 	return out, nil
 }
 
+func (c *orderClient) PayNotify(ctx context.Context, in *PayNotification, opts ...grpc.CallOption) (*PayNotificationInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayNotificationInfo)
+	err := c.cc.Invoke(ctx, Order_PayNotify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) PayNotificationList(ctx context.Context, in *PayNotificationFilterRequest, opts ...grpc.CallOption) (*PayNotificationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayNotificationListResponse)
+	err := c.cc.Invoke(ctx, Order_PayNotificationList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ReplayPayNotification(ctx context.Context, in *PayNotificationReplayRequest, opts ...grpc.CallOption) (*PayNotificationInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayNotificationInfo)
+	err := c.cc.Invoke(ctx, Order_ReplayPayNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ShipOrder(ctx context.Context, in *ShipRequest, opts ...grpc.CallOption) (*ShipmentInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentInfo)
+	err := c.cc.Invoke(ctx, Order_ShipOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ShipmentDetail(ctx context.Context, in *ShipmentRequest, opts ...grpc.CallOption) (*ShipmentInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentInfo)
+	err := c.cc.Invoke(ctx, Order_ShipmentDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility.
@@ -180,15 +363,33 @@ type OrderServer interface {
 	CreateCartItem(context.Context, *CartItemRequest) (*ShopCartInfoResponse, error)
 	UpdateCartItem(context.Context, *CartItemRequest) (*emptypb.Empty, error)
 	DeleteCartItem(context.Context, *CartItemRequest) (*emptypb.Empty, error)
+	MergeCart(context.Context, *MergeCartRequest) (*emptypb.Empty, error)
+	ValidateCart(context.Context, *ValidateCartRequest) (*ValidateCartResponse, error)
 	// 订单
 	CreateOrder(context.Context, *CreateRequest) (*emptypb.Empty, error)
-	CreateOrderCom(context.Context, *OrderRequest) (*emptypb.Empty, error)
+	CreateOrderCom(context.Context, *CreateRequest) (*emptypb.Empty, error)
 	SubmitOrder(context.Context, *OrderRequest) (*SubmitResponse, error)
+	PreviewOrder(context.Context, *OrderRequest) (*PreviewOrderResponse, error)
 	OrderList(context.Context, *OrderFilterRequest) (*OrderListResponse, error)
 	OrderDetail(context.Context, *OrderRequest) (*OrderInfoDetailResponse, error)
 	UpdateOrderStatus(context.Context, *OrderStatus) (*emptypb.Empty, error)
+	CancelOrder(context.Context, *OrderRequest) (*emptypb.Empty, error)
+	CloseOrder(context.Context, *OrderStatus) (*emptypb.Empty, error)
+	OrderStatusHistory(context.Context, *OrderStatusHistoryRequest) (*OrderStatusHistoryResponse, error)
+	// 退款
+	ApplyRefund(context.Context, *RefundApplyRequest) (*RefundInfo, error)
+	ApproveRefund(context.Context, *RefundReviewRequest) (*RefundInfo, error)
+	RejectRefund(context.Context, *RefundReviewRequest) (*RefundInfo, error)
+	RefundList(context.Context, *RefundFilterRequest) (*RefundListResponse, error)
 	// OrderDetailByOrderSn 获取订单详情  用于支付宝回调
 	OrderDetailByOrderSn(context.Context, *AlipayOrderSnRequest) (*OrderInfoDetailResponse, error)
+	// 支付通知
+	PayNotify(context.Context, *PayNotification) (*PayNotificationInfo, error)
+	PayNotificationList(context.Context, *PayNotificationFilterRequest) (*PayNotificationListResponse, error)
+	ReplayPayNotification(context.Context, *PayNotificationReplayRequest) (*PayNotificationInfo, error)
+	// 发货
+	ShipOrder(context.Context, *ShipRequest) (*ShipmentInfo, error)
+	ShipmentDetail(context.Context, *ShipmentRequest) (*ShipmentInfo, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -211,15 +412,24 @@ func (UnimplementedOrderServer) UpdateCartItem(context.Context, *CartItemRequest
 func (UnimplementedOrderServer) DeleteCartItem(context.Context, *CartItemRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCartItem not implemented")
 }
+func (UnimplementedOrderServer) MergeCart(context.Context, *MergeCartRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCart not implemented")
+}
+func (UnimplementedOrderServer) ValidateCart(context.Context, *ValidateCartRequest) (*ValidateCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateCart not implemented")
+}
 func (UnimplementedOrderServer) CreateOrder(context.Context, *CreateRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateOrder not implemented")
 }
-func (UnimplementedOrderServer) CreateOrderCom(context.Context, *OrderRequest) (*emptypb.Empty, error) {
+func (UnimplementedOrderServer) CreateOrderCom(context.Context, *CreateRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateOrderCom not implemented")
 }
 func (UnimplementedOrderServer) SubmitOrder(context.Context, *OrderRequest) (*SubmitResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedOrderServer) PreviewOrder(context.Context, *OrderRequest) (*PreviewOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewOrder not implemented")
+}
 func (UnimplementedOrderServer) OrderList(context.Context, *OrderFilterRequest) (*OrderListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method OrderList not implemented")
 }
@@ -229,9 +439,45 @@ func (UnimplementedOrderServer) OrderDetail(context.Context, *OrderRequest) (*Or
 func (UnimplementedOrderServer) UpdateOrderStatus(context.Context, *OrderStatus) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServer) CancelOrder(context.Context, *OrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderServer) CloseOrder(context.Context, *OrderStatus) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseOrder not implemented")
+}
+func (UnimplementedOrderServer) OrderStatusHistory(context.Context, *OrderStatusHistoryRequest) (*OrderStatusHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OrderStatusHistory not implemented")
+}
+func (UnimplementedOrderServer) ApplyRefund(context.Context, *RefundApplyRequest) (*RefundInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyRefund not implemented")
+}
+func (UnimplementedOrderServer) ApproveRefund(context.Context, *RefundReviewRequest) (*RefundInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveRefund not implemented")
+}
+func (UnimplementedOrderServer) RejectRefund(context.Context, *RefundReviewRequest) (*RefundInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectRefund not implemented")
+}
+func (UnimplementedOrderServer) RefundList(context.Context, *RefundFilterRequest) (*RefundListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundList not implemented")
+}
 func (UnimplementedOrderServer) OrderDetailByOrderSn(context.Context, *AlipayOrderSnRequest) (*OrderInfoDetailResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method OrderDetailByOrderSn not implemented")
 }
+func (UnimplementedOrderServer) PayNotify(context.Context, *PayNotification) (*PayNotificationInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method PayNotify not implemented")
+}
+func (UnimplementedOrderServer) PayNotificationList(context.Context, *PayNotificationFilterRequest) (*PayNotificationListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PayNotificationList not implemented")
+}
+func (UnimplementedOrderServer) ReplayPayNotification(context.Context, *PayNotificationReplayRequest) (*PayNotificationInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayPayNotification not implemented")
+}
+func (UnimplementedOrderServer) ShipOrder(context.Context, *ShipRequest) (*ShipmentInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShipOrder not implemented")
+}
+func (UnimplementedOrderServer) ShipmentDetail(context.Context, *ShipmentRequest) (*ShipmentInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShipmentDetail not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 func (UnimplementedOrderServer) testEmbeddedByValue()               {}
 
@@ -325,6 +571,42 @@ func _Order_DeleteCartItem_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ValidateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ValidateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ValidateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ValidateCart(ctx, req.(*ValidateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRequest)
 	if err := dec(in); err != nil {
@@ -344,7 +626,7 @@ func _Order_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 }
 
 func _Order_CreateOrderCom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OrderRequest)
+	in := new(CreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -356,7 +638,7 @@ func _Order_CreateOrderCom_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: Order_CreateOrderCom_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServer).CreateOrderCom(ctx, req.(*OrderRequest))
+		return srv.(OrderServer).CreateOrderCom(ctx, req.(*CreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -379,6 +661,24 @@ func _Order_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_PreviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).PreviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_PreviewOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).PreviewOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_OrderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderFilterRequest)
 	if err := dec(in); err != nil {
@@ -433,6 +733,132 @@ func _Order_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CancelOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CloseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CloseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CloseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CloseOrder(ctx, req.(*OrderStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_OrderStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).OrderStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_OrderStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).OrderStatusHistory(ctx, req.(*OrderStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ApplyRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ApplyRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ApplyRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ApplyRefund(ctx, req.(*RefundApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ApproveRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ApproveRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ApproveRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ApproveRefund(ctx, req.(*RefundReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_RejectRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).RejectRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_RejectRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).RejectRefund(ctx, req.(*RefundReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_RefundList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).RefundList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_RefundList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).RefundList(ctx, req.(*RefundFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_OrderDetailByOrderSn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AlipayOrderSnRequest)
 	if err := dec(in); err != nil {
@@ -451,6 +877,96 @@ func _Order_OrderDetailByOrderSn_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_PayNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayNotification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).PayNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_PayNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).PayNotify(ctx, req.(*PayNotification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_PayNotificationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayNotificationFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).PayNotificationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_PayNotificationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).PayNotificationList(ctx, req.(*PayNotificationFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ReplayPayNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayNotificationReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ReplayPayNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ReplayPayNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ReplayPayNotification(ctx, req.(*PayNotificationReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ShipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ShipOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ShipOrder(ctx, req.(*ShipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ShipmentDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ShipmentDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ShipmentDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ShipmentDetail(ctx, req.(*ShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -474,6 +990,14 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCartItem",
 			Handler:    _Order_DeleteCartItem_Handler,
 		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _Order_MergeCart_Handler,
+		},
+		{
+			MethodName: "ValidateCart",
+			Handler:    _Order_ValidateCart_Handler,
+		},
 		{
 			MethodName: "CreateOrder",
 			Handler:    _Order_CreateOrder_Handler,
@@ -486,6 +1010,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitOrder",
 			Handler:    _Order_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "PreviewOrder",
+			Handler:    _Order_PreviewOrder_Handler,
+		},
 		{
 			MethodName: "OrderList",
 			Handler:    _Order_OrderList_Handler,
@@ -498,10 +1026,58 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _Order_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Order_CancelOrder_Handler,
+		},
+		{
+			MethodName: "CloseOrder",
+			Handler:    _Order_CloseOrder_Handler,
+		},
+		{
+			MethodName: "OrderStatusHistory",
+			Handler:    _Order_OrderStatusHistory_Handler,
+		},
+		{
+			MethodName: "ApplyRefund",
+			Handler:    _Order_ApplyRefund_Handler,
+		},
+		{
+			MethodName: "ApproveRefund",
+			Handler:    _Order_ApproveRefund_Handler,
+		},
+		{
+			MethodName: "RejectRefund",
+			Handler:    _Order_RejectRefund_Handler,
+		},
+		{
+			MethodName: "RefundList",
+			Handler:    _Order_RefundList_Handler,
+		},
 		{
 			MethodName: "OrderDetailByOrderSn",
 			Handler:    _Order_OrderDetailByOrderSn_Handler,
 		},
+		{
+			MethodName: "PayNotify",
+			Handler:    _Order_PayNotify_Handler,
+		},
+		{
+			MethodName: "PayNotificationList",
+			Handler:    _Order_PayNotificationList_Handler,
+		},
+		{
+			MethodName: "ReplayPayNotification",
+			Handler:    _Order_ReplayPayNotification_Handler,
+		},
+		{
+			MethodName: "ShipOrder",
+			Handler:    _Order_ShipOrder_Handler,
+		},
+		{
+			MethodName: "ShipmentDetail",
+			Handler:    _Order_ShipmentDetail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
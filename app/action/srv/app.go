@@ -6,8 +6,10 @@ import (
 	gapp "Advanced_Shop/gnova/app"
 	"Advanced_Shop/pkg/app"
 	"Advanced_Shop/pkg/log"
+	"Advanced_Shop/pkg/storage"
 	"context"
 	"github.com/hashicorp/consul/api"
+	"time"
 
 	_ "Advanced_Shop/app/pkg/code"
 	_ "Advanced_Shop/gnova/code"
@@ -46,6 +48,41 @@ func NewGoodsApp(cfg *config.Config) (*gapp.App, error) {
 	//服务注册
 	register := NewRegistrar(cfg.Registry)
 
+	//连接redis，优惠券领取计数使用
+	redisConfig := &storage.Config{
+		Host:                  cfg.RedisOptions.Host,
+		Port:                  cfg.RedisOptions.Port,
+		Addrs:                 cfg.RedisOptions.Addrs,
+		MasterName:            cfg.RedisOptions.MasterName,
+		Username:              cfg.RedisOptions.Username,
+		Password:              cfg.RedisOptions.Password,
+		Database:              cfg.RedisOptions.Database,
+		MaxIdle:               cfg.RedisOptions.MaxIdle,
+		MaxActive:             cfg.RedisOptions.MaxActive,
+		Timeout:               cfg.RedisOptions.Timeout,
+		EnableCluster:         cfg.RedisOptions.EnableCluster,
+		UseSSL:                cfg.RedisOptions.UseSSL,
+		SSLInsecureSkipVerify: cfg.RedisOptions.SSLInsecureSkipVerify,
+		EnableTracing:         cfg.RedisOptions.EnableTracing,
+	}
+	redisCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	storage.ConnectToRedis(redisCtx, redisConfig)
+
+	// 等待Redis连接就绪
+	for i := 0; i < 10; i++ {
+		if storage.Connected() {
+			log.Info("Redis连接成功")
+			break
+		}
+		log.Warn("等待Redis连接就绪...")
+		time.Sleep(1000 * time.Millisecond)
+	}
+
+	if !storage.Connected() {
+		log.Fatal("Redis连接失败，服务启动失败")
+	}
+
 	//生成rpc服务
 	rpcServer, err := NewActionRPCServer(cfg)
 	if err != nil {
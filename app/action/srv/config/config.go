@@ -13,6 +13,7 @@ type Config struct {
 	Registry     *options.RegistryOptions  `json:"registry" mapstructure:"registry"`
 	Telemetry    *options.TelemetryOptions `json:"telemetry" mapstructure:"telemetry"`
 	MySQLOptions *options.MySQLOptions     `json:"mysql" mapstructure:"mysql"`
+	RedisOptions *options.RedisOptions     `json:"redis" mapstructure:"redis"`
 }
 
 func (c *Config) Validate() []error {
@@ -22,6 +23,7 @@ func (c *Config) Validate() []error {
 	errors = append(errors, c.Registry.Validate()...)
 	errors = append(errors, c.Telemetry.Validate()...)
 	errors = append(errors, c.MySQLOptions.Validate()...)
+	errors = append(errors, c.RedisOptions.Validate()...)
 	return errors
 }
 
@@ -31,6 +33,7 @@ func (c *Config) Flags() (fss cliflag.NamedFlagSets) {
 	c.Registry.AddFlags(fss.FlagSet("registry"))
 	c.Telemetry.AddFlags(fss.FlagSet("telemetry"))
 	c.MySQLOptions.AddFlags(fss.FlagSet("mysql"))
+	c.RedisOptions.AddFlags(fss.FlagSet("redis"))
 	return fss
 }
 
@@ -42,5 +45,6 @@ func New() *Config {
 		Registry:     options.NewRegistryOptions(),
 		Telemetry:    options.NewTelemetryOptions(),
 		MySQLOptions: options.NewMySQLOptions(),
+		RedisOptions: options.NewRedisOptions(),
 	}
 }
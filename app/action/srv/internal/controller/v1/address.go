@@ -14,6 +14,7 @@ type actionServer struct {
 	pb.UnimplementedUserFavServer
 	pb.UnimplementedAddressServer
 	pb.UnimplementedMessageServer
+	pb.UnimplementedCouponServer
 	srv v1.ServiceFactory
 }
 
@@ -0,0 +1,182 @@
+package v1
+
+import (
+	pb "Advanced_Shop/api/action/v1"
+	"Advanced_Shop/app/action/srv/internal/domain/do"
+	"Advanced_Shop/app/action/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	gorm2 "Advanced_Shop/app/pkg/gorm"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+// CreateCouponTemplate 新建优惠券模板
+func (o *actionServer) CreateCouponTemplate(ctx context.Context, request *pb.CouponTemplateRequest) (*pb.CouponTemplateResponse, error) {
+	template, err := o.srv.Coupon().CreateTemplate(ctx, couponTemplateDO(request))
+	if err != nil {
+		return nil, err
+	}
+	return couponTemplateResponse(template), nil
+}
+
+// UpdateCouponTemplate 修改优惠券模板
+func (o *actionServer) UpdateCouponTemplate(ctx context.Context, request *pb.CouponTemplateRequest) (*emptypb.Empty, error) {
+	if err := o.srv.Coupon().UpdateTemplate(ctx, couponTemplateDO(request)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CouponTemplateList 优惠券模板列表
+func (o *actionServer) CouponTemplateList(ctx context.Context, request *pb.CouponTemplateFilter) (*pb.CouponTemplateListResponse, error) {
+	list, err := o.srv.Coupon().TemplateList(ctx, request.Available, metav1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.CouponTemplateListResponse{
+		Total: int32(list.TotalCount),
+		Data:  make([]*pb.CouponTemplateResponse, 0, len(list.Items)),
+	}
+	for _, template := range list.Items {
+		response.Data = append(response.Data, couponTemplateResponse(template))
+	}
+	return response, nil
+}
+
+// ClaimCoupon 领取优惠券
+func (o *actionServer) ClaimCoupon(ctx context.Context, request *pb.ClaimCouponRequest) (*pb.UserCouponResponse, error) {
+	coupon, err := o.srv.Coupon().Claim(ctx, request.UserId, request.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	return userCouponResponse(coupon), nil
+}
+
+// UserCouponList 券包
+func (o *actionServer) UserCouponList(ctx context.Context, request *pb.UserCouponFilter) (*pb.UserCouponListResponse, error) {
+	list, err := o.srv.Coupon().List(ctx, request.UserId, request.Status, metav1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.UserCouponListResponse{
+		Total: int32(list.TotalCount),
+		Data:  make([]*pb.UserCouponResponse, 0, len(list.Items)),
+	}
+	for _, coupon := range list.Items {
+		response.Data = append(response.Data, userCouponResponse(coupon))
+	}
+	return response, nil
+}
+
+// GetUserCoupon 按券码查询用户的优惠券
+func (o *actionServer) GetUserCoupon(ctx context.Context, request *pb.UserCouponRequest) (*pb.UserCouponResponse, error) {
+	coupon, err := o.srv.Coupon().Get(ctx, request.UserId, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	return userCouponResponse(coupon), nil
+}
+
+// RedeemCoupon 订单 Saga 分支，优惠券不可用时返回 Aborted，DTM 不再重试并回滚已执行的分支
+func (o *actionServer) RedeemCoupon(ctx context.Context, request *pb.UserCouponRequest) (*emptypb.Empty, error) {
+	err := o.srv.Coupon().Redeem(ctx, request.UserId, request.Code, request.OrderSn)
+	if err != nil {
+		if errors.IsCode(err, code2.ErrCouponUnavailable) || errors.IsCode(err, code2.ErrUserCouponNotFound) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ReleaseCoupon RedeemCoupon 的补偿
+func (o *actionServer) ReleaseCoupon(ctx context.Context, request *pb.UserCouponRequest) (*emptypb.Empty, error) {
+	if err := o.srv.Coupon().Release(ctx, request.UserId, request.Code, request.OrderSn); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func couponTemplateDO(request *pb.CouponTemplateRequest) *do.CouponTemplateDO {
+	return &do.CouponTemplateDO{
+		Model:     gorm2.Model{ID: request.Id},
+		Name:      request.Name,
+		Type:      request.Type,
+		Category:  request.Category,
+		Threshold: request.Threshold,
+		Amount:    request.Amount,
+		Percent:   request.Percent,
+		Total:     request.Total,
+		PerUser:   request.PerUser,
+		ValidDays: request.ValidDays,
+		StartAt:   unixTime(request.StartAt),
+		EndAt:     unixTime(request.EndAt),
+		Enabled:   request.Enabled,
+	}
+}
+
+func couponTemplateResponse(template *do.CouponTemplateDO) *pb.CouponTemplateResponse {
+	return &pb.CouponTemplateResponse{
+		Id:        template.ID,
+		Name:      template.Name,
+		Type:      template.Type,
+		Category:  template.Category,
+		Threshold: template.Threshold,
+		Amount:    template.Amount,
+		Percent:   template.Percent,
+		Total:     template.Total,
+		PerUser:   template.PerUser,
+		ValidDays: template.ValidDays,
+		StartAt:   unixSeconds(template.StartAt),
+		EndAt:     unixSeconds(template.EndAt),
+		Enabled:   template.Enabled == nil || *template.Enabled,
+		Issued:    template.Issued,
+	}
+}
+
+func userCouponResponse(coupon *dto.UserCouponDTO) *pb.UserCouponResponse {
+	ret := &pb.UserCouponResponse{
+		Id:       coupon.ID,
+		UserId:   coupon.UserId,
+		Code:     coupon.Code,
+		Status:   coupon.Status,
+		OrderSn:  coupon.OrderSn,
+		ExpireAt: unixSeconds(coupon.ExpireAt),
+		UsedAt:   unixSeconds(coupon.UsedAt),
+	}
+	if coupon.Status == do.UserCouponUnused && !coupon.Usable(time.Now()) {
+		ret.Status = do.UserCouponExpired
+	}
+	if coupon.CouponTemplate != nil {
+		ret.Template = couponTemplateResponse(coupon.CouponTemplate)
+	}
+	return ret
+}
+
+func unixTime(sec int64) *time.Time {
+	if sec == 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+func unixSeconds(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+var _ pb.CouponServer = &actionServer{}
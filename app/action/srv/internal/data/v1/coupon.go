@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"Advanced_Shop/app/action/srv/internal/domain/do"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+// CouponTemplateStore 优惠券模板数据访问层接口
+type CouponTemplateStore interface {
+	// Get 根据ID获取模板
+	Get(ctx context.Context, ID int32) (*do.CouponTemplateDO, error)
+
+	// List 分页查询模板，available 为 true 时只返回 now 时刻可以领取的模板
+	List(ctx context.Context, available bool, now time.Time, meta metav1.ListMeta) (*do.CouponTemplateDOList, error)
+
+	// Create 创建模板
+	Create(ctx context.Context, template *do.CouponTemplateDO) error
+
+	// Update 只更新名称、发行量、限领、领取时间和启用状态，优惠规则不能修改
+	Update(ctx context.Context, template *do.CouponTemplateDO) error
+
+	// IncrIssued 领取成功后累加已领取数量
+	IncrIssued(ctx context.Context, txn *gorm.DB, ID int32) error
+}
+
+// UserCouponStore 券包数据访问层接口
+type UserCouponStore interface {
+	// Create 领取优惠券
+	Create(ctx context.Context, txn *gorm.DB, coupon *do.UserCouponDO) error
+
+	// GetByCode 根据券码和用户ID获取优惠券及其模板
+	GetByCode(ctx context.Context, txn *gorm.DB, userID int32, code string) (*do.UserCouponDO, error)
+
+	// List 分页查询用户的券包，status 为空时返回全部
+	List(ctx context.Context, userID int32, status string, now time.Time, meta metav1.ListMeta) (*do.UserCouponDOList, error)
+
+	// Count 统计模板已领取的数量，userID 不为 0 时只统计该用户
+	Count(ctx context.Context, templateID, userID int32) (int64, error)
+
+	// UpdateStatusFrom 只有当前状态为 from 时才更新，返回影响行数
+	UpdateStatusFrom(ctx context.Context, txn *gorm.DB, ID int32, from, to, orderSn string, usedAt *time.Time) (int64, error)
+}
+
+// CouponLimitStore 领取名额的原子计数，保存在 Redis 中
+type CouponLimitStore interface {
+	// Claim 检查发行总量和每人限领并占用一个名额，total 和 perUser 为 0 时不限
+	Claim(ctx context.Context, templateID, userID, total, perUser int32) (do.ClaimResult, error)
+
+	// Seed 计数不存在时用数据库中的数量初始化，已存在时不覆盖
+	Seed(ctx context.Context, templateID, userID int32, issued, claimed int64) error
+
+	// Revert 领取写库失败时归还占用的名额
+	Revert(ctx context.Context, templateID, userID int32) error
+}
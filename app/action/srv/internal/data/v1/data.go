@@ -1,10 +1,18 @@
 package v1
 
-import proto "Advanced_Shop/api/goods/v1"
+import (
+	proto "Advanced_Shop/api/goods/v1"
+	"gorm.io/gorm"
+)
 
 type DataFactory interface {
 	Address() AddressStore
 	Collection() CollectionStore
 	Goods() proto.GoodsClient
 	Messages() MessageStore
+	CouponTemplates() CouponTemplateStore
+	UserCoupons() UserCouponStore
+	CouponLimits() CouponLimitStore
+
+	DB() *gorm.DB
 }
@@ -0,0 +1,187 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/action/srv/internal/data/v1"
+	"Advanced_Shop/app/action/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type couponTemplates struct {
+	db *gorm.DB
+}
+
+func newCouponTemplates(factory *mysqlFactory) *couponTemplates {
+	return &couponTemplates{
+		db: factory.db,
+	}
+}
+
+// Get 根据ID获取模板
+func (ct *couponTemplates) Get(ctx context.Context, ID int32) (*do.CouponTemplateDO, error) {
+	var template do.CouponTemplateDO
+	err := ct.db.WithContext(ctx).Where("id = ?", ID).Take(&template).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code2.ErrCouponTemplateNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return &template, nil
+}
+
+// List 分页查询模板
+func (ct *couponTemplates) List(ctx context.Context, available bool, now time.Time, meta metav1.ListMeta) (*do.CouponTemplateDOList, error) {
+	ret := &do.CouponTemplateDOList{}
+	query := ct.db.WithContext(ctx).Model(&do.CouponTemplateDO{})
+	if available {
+		query = query.Where("enabled = ?", true).
+			Where("start_at IS NULL OR start_at <= ?", now).
+			Where("end_at IS NULL OR end_at > ?", now).
+			Where("total = 0 OR issued < total")
+	}
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	err := query.Order("id desc").Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+// Create 创建模板
+func (ct *couponTemplates) Create(ctx context.Context, template *do.CouponTemplateDO) error {
+	if err := ct.db.WithContext(ctx).Create(template).Error; err != nil {
+		return errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+// Update 只更新允许修改的字段
+func (ct *couponTemplates) Update(ctx context.Context, template *do.CouponTemplateDO) error {
+	result := ct.db.WithContext(ctx).Model(&do.CouponTemplateDO{}).Where("id = ?", template.ID).
+		Select("name", "total", "per_user", "start_at", "end_at", "enabled").
+		Updates(template)
+	if result.Error != nil {
+		return errors.WithCode(code.ErrDatabase, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return errors.WithCode(code2.ErrCouponTemplateNotFound, "优惠券模板%d不存在", template.ID)
+	}
+	return nil
+}
+
+// IncrIssued 累加已领取数量
+func (ct *couponTemplates) IncrIssued(ctx context.Context, txn *gorm.DB, ID int32) error {
+	db := ct.db
+	if txn != nil {
+		db = txn
+	}
+	err := db.WithContext(ctx).Model(&do.CouponTemplateDO{}).Where("id = ?", ID).
+		UpdateColumn("issued", gorm.Expr("issued + 1")).Error
+	if err != nil {
+		return errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+var _ v1.CouponTemplateStore = &couponTemplates{}
+
+type userCoupons struct {
+	db *gorm.DB
+}
+
+func newUserCoupons(factory *mysqlFactory) *userCoupons {
+	return &userCoupons{
+		db: factory.db,
+	}
+}
+
+// Create 领取优惠券
+func (uc *userCoupons) Create(ctx context.Context, txn *gorm.DB, coupon *do.UserCouponDO) error {
+	db := uc.db
+	if txn != nil {
+		db = txn
+	}
+	if err := db.WithContext(ctx).Omit("CouponTemplate").Create(coupon).Error; err != nil {
+		return errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+// GetByCode 根据券码和用户ID获取优惠券及其模板
+func (uc *userCoupons) GetByCode(ctx context.Context, txn *gorm.DB, userID int32, couponCode string) (*do.UserCouponDO, error) {
+	db := uc.db
+	if txn != nil {
+		db = txn
+	}
+	var coupon do.UserCouponDO
+	err := db.WithContext(ctx).Preload("CouponTemplate").
+		Where("code = ? AND user_id = ?", couponCode, userID).Take(&coupon).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code2.ErrUserCouponNotFound, "优惠券%s不存在", couponCode)
+		}
+		return nil, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return &coupon, nil
+}
+
+// List 分页查询券包，未使用的按过期时间排在前面
+func (uc *userCoupons) List(ctx context.Context, userID int32, status string, now time.Time, meta metav1.ListMeta) (*do.UserCouponDOList, error) {
+	ret := &do.UserCouponDOList{}
+	query := uc.db.WithContext(ctx).Model(&do.UserCouponDO{}).Where("user_id = ?", userID)
+	switch status {
+	case do.UserCouponUnused:
+		query = query.Where("status = ?", do.UserCouponUnused).Where("expire_at IS NULL OR expire_at > ?", now)
+	case do.UserCouponExpired:
+		query = query.Where("status = ?", do.UserCouponUnused).Where("expire_at <= ?", now)
+	case do.UserCouponUsed:
+		query = query.Where("status = ?", do.UserCouponUsed)
+	}
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	err := query.Preload("CouponTemplate").Order("status desc, expire_at, id desc").
+		Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+// Count 统计模板已领取的数量
+func (uc *userCoupons) Count(ctx context.Context, templateID, userID int32) (int64, error) {
+	var count int64
+	query := uc.db.WithContext(ctx).Model(&do.UserCouponDO{}).Where("template = ?", templateID)
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, errors.WithCode(code.ErrDatabase, err.Error())
+	}
+	return count, nil
+}
+
+// UpdateStatusFrom 带条件的状态更新，退回券包时同时清空订单编号和核销时间
+func (uc *userCoupons) UpdateStatusFrom(ctx context.Context, txn *gorm.DB, ID int32, from, to, orderSn string, usedAt *time.Time) (int64, error) {
+	db := uc.db
+	if txn != nil {
+		db = txn
+	}
+	result := db.WithContext(ctx).Model(&do.UserCouponDO{}).
+		Where("id = ? AND status = ?", ID, from).
+		Updates(map[string]interface{}{"status": to, "order_sn": orderSn, "used_at": usedAt})
+	if result.Error != nil {
+		return 0, errors.WithCode(code.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+var _ v1.UserCouponStore = &userCoupons{}
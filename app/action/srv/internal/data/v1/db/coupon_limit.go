@@ -0,0 +1,92 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/action/srv/internal/data/v1"
+	"Advanced_Shop/app/action/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// 同一模板的计数 key 共用 {coupon:<id>} hash tag，集群模式下 Lua 脚本才能同时操作
+// 用户计数过期后会从数据库重新初始化，只是为了不让 key 无限增长
+const couponUserKeyTTL = 30 * 24 * time.Hour
+
+// claimCouponScript KEYS[1] 模板已领取数量，KEYS[2] 用户已领取数量；ARGV[1] 发行总量，ARGV[2] 每人限领
+var claimCouponScript = redis.NewScript(`
+local issued = redis.call('GET', KEYS[1])
+local claimed = redis.call('GET', KEYS[2])
+if not issued or not claimed then
+	return -2
+end
+if tonumber(ARGV[1]) > 0 and tonumber(issued) >= tonumber(ARGV[1]) then
+	return 0
+end
+if tonumber(ARGV[2]) > 0 and tonumber(claimed) >= tonumber(ARGV[2]) then
+	return -1
+end
+redis.call('INCR', KEYS[1])
+redis.call('INCR', KEYS[2])
+return 1
+`)
+
+// revertCouponScript 计数存在时才减回，避免在被清空的 key 上减出负数
+var revertCouponScript = redis.NewScript(`
+for i = 1, #KEYS do
+	if redis.call('EXISTS', KEYS[i]) == 1 then
+		redis.call('DECR', KEYS[i])
+	end
+end
+return 1
+`)
+
+type couponLimits struct {
+	client redis.UniversalClient
+}
+
+func newCouponLimits(factory *mysqlFactory) *couponLimits {
+	return &couponLimits{client: factory.redis}
+}
+
+func couponIssuedKey(templateID int32) string {
+	return fmt.Sprintf("{coupon:%d}:issued", templateID)
+}
+
+func couponUserKey(templateID, userID int32) string {
+	return fmt.Sprintf("{coupon:%d}:user:%d", templateID, userID)
+}
+
+// Claim 原子检查并占用领取名额
+func (cl *couponLimits) Claim(ctx context.Context, templateID, userID, total, perUser int32) (do.ClaimResult, error) {
+	keys := []string{couponIssuedKey(templateID), couponUserKey(templateID, userID)}
+	result, err := claimCouponScript.Run(ctx, cl.client, keys, total, perUser).Int()
+	if err != nil {
+		return 0, errors.WithCode(code.ErrRedisLock, "检查优惠券领取名额失败: %v", err)
+	}
+	return do.ClaimResult(result), nil
+}
+
+// Seed 用数据库中的数量初始化计数
+func (cl *couponLimits) Seed(ctx context.Context, templateID, userID int32, issued, claimed int64) error {
+	if err := cl.client.SetNX(ctx, couponIssuedKey(templateID), issued, 0).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "初始化优惠券领取计数失败: %v", err)
+	}
+	if err := cl.client.SetNX(ctx, couponUserKey(templateID, userID), claimed, couponUserKeyTTL).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "初始化优惠券领取计数失败: %v", err)
+	}
+	return nil
+}
+
+// Revert 归还占用的名额
+func (cl *couponLimits) Revert(ctx context.Context, templateID, userID int32) error {
+	keys := []string{couponIssuedKey(templateID), couponUserKey(templateID, userID)}
+	if err := revertCouponScript.Run(ctx, cl.client, keys).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "归还优惠券领取名额失败: %v", err)
+	}
+	return nil
+}
+
+var _ v1.CouponLimitStore = &couponLimits{}
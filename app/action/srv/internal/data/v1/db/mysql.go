@@ -6,7 +6,10 @@ import (
 	"Advanced_Shop/app/pkg/options"
 	code2 "Advanced_Shop/gnova/code"
 	errors2 "Advanced_Shop/pkg/errors"
+	zlog "Advanced_Shop/pkg/log"
+	"Advanced_Shop/pkg/storage"
 	"fmt"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"log"
@@ -23,8 +26,9 @@ var (
 )
 
 type mysqlFactory struct {
-	gc proto.GoodsClient
-	db *gorm.DB
+	gc    proto.GoodsClient
+	db    *gorm.DB
+	redis redis.UniversalClient
 }
 
 func (mf *mysqlFactory) Messages() v1.MessageStore {
@@ -39,10 +43,26 @@ func (mf *mysqlFactory) Collection() v1.CollectionStore {
 	return newCollection(mf)
 }
 
+func (mf *mysqlFactory) CouponTemplates() v1.CouponTemplateStore {
+	return newCouponTemplates(mf)
+}
+
+func (mf *mysqlFactory) UserCoupons() v1.UserCouponStore {
+	return newUserCoupons(mf)
+}
+
+func (mf *mysqlFactory) CouponLimits() v1.CouponLimitStore {
+	return newCouponLimits(mf)
+}
+
 func (mf *mysqlFactory) Goods() proto.GoodsClient {
 	return mf.gc
 }
 
+func (mf *mysqlFactory) DB() *gorm.DB {
+	return mf.db
+}
+
 var _ v1.DataFactory = &mysqlFactory{}
 
 // GetDBFactoryOr 这个方法会返回gorm连接
@@ -85,9 +105,16 @@ func GetDBFactoryOr(mysqlOpts *options.MySQLOptions, registry *options.RegistryO
 
 		//服务发现
 		goodsClient := GetGoodsClient(registry)
+		// 优惠券领取计数复用 storage 层已经连接好的 Redis 客户端
+		redisCluster := &storage.RedisCluster{}
+		redisClient := redisCluster.GetClient()
+		if redisClient == nil {
+			zlog.Fatal("无法从storage层获取Redis客户端（请确保storage.ConnectToRedis已执行）")
+		}
 		dbFactory = &mysqlFactory{
-			db: db,
-			gc: goodsClient,
+			db:    db,
+			gc:    goodsClient,
+			redis: redisClient,
 		}
 
 	})
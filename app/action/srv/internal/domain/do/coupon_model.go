@@ -0,0 +1,108 @@
+package do
+
+import (
+	"Advanced_Shop/app/pkg/gorm"
+	"time"
+)
+
+// 优惠券类型，金额单位为分
+const (
+	CouponTypeFixed     = "fixed"     // 立减券
+	CouponTypePercent   = "percent"   // 折扣券
+	CouponTypeThreshold = "threshold" // 满减券
+)
+
+// 券包中优惠券的状态，过期由 ExpireAt 判断，不单独落库
+const (
+	UserCouponUnused  = "UNUSED"
+	UserCouponUsed    = "USED"
+	UserCouponExpired = "EXPIRED" // 只用于查询
+)
+
+// CouponTemplateDO 优惠券模板，由管理员发布，用户领取后生成 UserCouponDO
+type CouponTemplateDO struct {
+	gorm.Model
+	Name      string     `gorm:"type:varchar(50);comment:优惠券名称"`
+	Type      string     `gorm:"type:varchar(20);comment:类型（fixed/percent/threshold）"`
+	Category  int32      `gorm:"type:int;comment:限定的商品分类ID，0 表示不限"`
+	Threshold int64      `gorm:"comment:满减门槛（分）"`
+	Amount    int64      `gorm:"comment:减免金额（分）"`
+	Percent   int32      `gorm:"type:int;comment:折后百分比，85 表示八五折"`
+	Total     int32      `gorm:"type:int;comment:发行总量，0 表示不限"`
+	PerUser   int32      `gorm:"type:int;comment:每人限领，0 表示不限"`
+	Issued    int32      `gorm:"type:int;comment:已领取数量，领取限制以 Redis 计数为准"`
+	ValidDays int32      `gorm:"type:int;comment:领取后有效天数，0 时有效期到 EndAt"`
+	StartAt   *time.Time `gorm:"comment:开始领取时间"`
+	EndAt     *time.Time `gorm:"comment:结束领取时间"`
+	Enabled   *bool      `gorm:"default:true;comment:是否启用"`
+}
+
+func (CouponTemplateDO) TableName() string {
+	return "coupon_templates"
+}
+
+// Claimable 模板启用且在领取时间内
+func (t *CouponTemplateDO) Claimable(now time.Time) bool {
+	if t.Enabled != nil && !*t.Enabled {
+		return false
+	}
+	if t.StartAt != nil && now.Before(*t.StartAt) {
+		return false
+	}
+	if t.EndAt != nil && !now.Before(*t.EndAt) {
+		return false
+	}
+	return true
+}
+
+// ExpireAt 在 now 领取的优惠券的过期时间，为空表示长期有效
+func (t *CouponTemplateDO) ExpireAt(now time.Time) *time.Time {
+	if t.ValidDays > 0 {
+		expire := now.AddDate(0, 0, int(t.ValidDays))
+		return &expire
+	}
+	return t.EndAt
+}
+
+type CouponTemplateDOList struct {
+	TotalCount int64               `json:"totalCount,omitempty"`
+	Items      []*CouponTemplateDO `json:"items"`
+}
+
+// UserCouponDO 券包中的一张优惠券，券码全局唯一，下单时作为 couponCode 使用
+type UserCouponDO struct {
+	gorm.Model
+	UserId   int32      `gorm:"type:int;index:idx_user_template"`
+	Template int32      `gorm:"type:int;index:idx_user_template;comment:优惠券模板ID"`
+	Code     string     `gorm:"type:varchar(40);uniqueIndex;comment:券码"`
+	Status   string     `gorm:"type:varchar(20);comment:状态（UNUSED/USED）"`
+	OrderSn  string     `gorm:"type:varchar(30);index;comment:核销的订单编号"`
+	ExpireAt *time.Time `gorm:"comment:过期时间，为空表示长期有效"`
+	UsedAt   *time.Time `gorm:"comment:核销时间"`
+
+	CouponTemplate *CouponTemplateDO `gorm:"foreignKey:Template"`
+}
+
+func (UserCouponDO) TableName() string {
+	return "user_coupons"
+}
+
+// Usable 未使用且未过期
+func (c *UserCouponDO) Usable(now time.Time) bool {
+	return c.Status == UserCouponUnused && (c.ExpireAt == nil || now.Before(*c.ExpireAt))
+}
+
+type UserCouponDOList struct {
+	TotalCount int64           `json:"totalCount,omitempty"`
+	Items      []*UserCouponDO `json:"items"`
+}
+
+// ClaimResult 领取名额检查的结果，与 Lua 脚本的返回值对应
+type ClaimResult int
+
+const (
+	ClaimUnseeded ClaimResult = -2 // Redis 中还没有计数，需要先用数据库中的数量初始化
+	ClaimLimited  ClaimResult = -1 // 超过每人限领
+	ClaimSoldOut  ClaimResult = 0  // 已领完
+	ClaimOK       ClaimResult = 1
+)
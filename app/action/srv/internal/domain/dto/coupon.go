@@ -0,0 +1,12 @@
+package dto
+
+import "Advanced_Shop/app/action/srv/internal/domain/do"
+
+type UserCouponDTO struct {
+	do.UserCouponDO
+}
+
+type UserCouponDTOList struct {
+	TotalCount int64            `json:"total_count,omitempty"`
+	Items      []*UserCouponDTO `json:"data"`
+}
@@ -0,0 +1,256 @@
+package v1
+
+import (
+	v1 "Advanced_Shop/app/action/srv/internal/data/v1"
+	"Advanced_Shop/app/action/srv/internal/domain/do"
+	"Advanced_Shop/app/action/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	bgorm "Advanced_Shop/app/pkg/gorm"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"fmt"
+	"github.com/dtm-labs/client/dtmgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"time"
+)
+
+// CouponSrv 优惠券业务逻辑层接口
+// 领取名额在 Redis 中原子计数，核销和退回作为订单 Saga 的分支，由 DTM 屏障保证幂等和防悬挂
+type CouponSrv interface {
+	// CreateTemplate 管理员新建优惠券模板
+	CreateTemplate(ctx context.Context, template *do.CouponTemplateDO) (*do.CouponTemplateDO, error)
+
+	// UpdateTemplate 管理员修改优惠券模板
+	UpdateTemplate(ctx context.Context, template *do.CouponTemplateDO) error
+
+	// TemplateList 模板列表，available 为 true 时只返回当前可以领取的
+	TemplateList(ctx context.Context, available bool, meta metav1.ListMeta) (*do.CouponTemplateDOList, error)
+
+	// Claim 领取优惠券到券包
+	Claim(ctx context.Context, userID, templateID int32) (*dto.UserCouponDTO, error)
+
+	// List 用户的券包
+	List(ctx context.Context, userID int32, status string, meta metav1.ListMeta) (*dto.UserCouponDTOList, error)
+
+	// Get 按券码查询用户的优惠券
+	Get(ctx context.Context, userID int32, code string) (*dto.UserCouponDTO, error)
+
+	// Redeem 下单核销，Saga 正向分支
+	Redeem(ctx context.Context, userID int32, code, orderSn string) error
+
+	// Release Redeem 的补偿，优惠券退回券包
+	Release(ctx context.Context, userID int32, code, orderSn string) error
+}
+
+type couponService struct {
+	data v1.DataFactory
+}
+
+func newCoupon(srv *serviceFactory) CouponSrv {
+	return &couponService{
+		data: srv.data,
+	}
+}
+
+// CreateTemplate 新建优惠券模板
+func (s *couponService) CreateTemplate(ctx context.Context, template *do.CouponTemplateDO) (*do.CouponTemplateDO, error) {
+	switch template.Type {
+	case do.CouponTypeFixed, do.CouponTypeThreshold:
+		if template.Amount <= 0 {
+			return nil, errors.WithCode(code2.ErrInvalidParameter, "优惠券减免金额必须大于0")
+		}
+	case do.CouponTypePercent:
+		if template.Percent <= 0 || template.Percent >= 100 {
+			return nil, errors.WithCode(code2.ErrInvalidParameter, "优惠券折扣必须在1到99之间")
+		}
+	default:
+		return nil, errors.WithCode(code2.ErrInvalidParameter, "不支持的优惠券类型%s", template.Type)
+	}
+
+	if err := s.data.CouponTemplates().Create(ctx, template); err != nil {
+		log.Errorf("创建优惠券模板失败: %v", err)
+		return nil, err
+	}
+	return template, nil
+}
+
+// UpdateTemplate 修改优惠券模板，发行量调小后 Redis 中的计数不需要调整，脚本按新的发行量判断
+func (s *couponService) UpdateTemplate(ctx context.Context, template *do.CouponTemplateDO) error {
+	current, err := s.data.CouponTemplates().Get(ctx, template.ID)
+	if err != nil {
+		return err
+	}
+	if template.Enabled == nil {
+		template.Enabled = current.Enabled
+	}
+	if err := s.data.CouponTemplates().Update(ctx, template); err != nil {
+		log.Errorf("修改优惠券模板失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// TemplateList 模板列表
+func (s *couponService) TemplateList(ctx context.Context, available bool, meta metav1.ListMeta) (*do.CouponTemplateDOList, error) {
+	return s.data.CouponTemplates().List(ctx, available, time.Now(), meta)
+}
+
+// Claim 先在 Redis 中占用名额再写库，写库失败时归还名额
+func (s *couponService) Claim(ctx context.Context, userID, templateID int32) (*dto.UserCouponDTO, error) {
+	template, err := s.data.CouponTemplates().Get(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if !template.Claimable(now) {
+		return nil, errors.WithCode(code2.ErrCouponNotClaimable, "优惠券%s当前不可领取", template.Name)
+	}
+
+	result, err := s.data.CouponLimits().Claim(ctx, templateID, userID, template.Total, template.PerUser)
+	if err != nil {
+		return nil, err
+	}
+	if result == do.ClaimUnseeded {
+		// Redis 中没有计数（第一次领取或计数过期），用数据库中的数量初始化后重试
+		if err := s.seed(ctx, templateID, userID); err != nil {
+			return nil, err
+		}
+		result, err = s.data.CouponLimits().Claim(ctx, templateID, userID, template.Total, template.PerUser)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch result {
+	case do.ClaimSoldOut:
+		return nil, errors.WithCode(code2.ErrCouponSoldOut, "优惠券%s已领完", template.Name)
+	case do.ClaimLimited:
+		return nil, errors.WithCode(code2.ErrCouponClaimLimit, "优惠券%s每人限领%d张", template.Name, template.PerUser)
+	case do.ClaimUnseeded:
+		return nil, errors.WithCode(code2.ErrRedisLock, "优惠券%d领取计数初始化失败", templateID)
+	}
+
+	coupon := &do.UserCouponDO{
+		UserId:   userID,
+		Template: templateID,
+		Code:     fmt.Sprintf("CP%d%d", now.UnixNano(), userID),
+		Status:   do.UserCouponUnused,
+		ExpireAt: template.ExpireAt(now),
+	}
+	err = s.data.DB().Transaction(func(tx *gorm.DB) error {
+		if err := s.data.UserCoupons().Create(ctx, tx, coupon); err != nil {
+			return err
+		}
+		return s.data.CouponTemplates().IncrIssued(ctx, tx, templateID)
+	})
+	if err != nil {
+		log.Errorf("用户%d领取优惠券%d写库失败: %v", userID, templateID, err)
+		if rerr := s.data.CouponLimits().Revert(ctx, templateID, userID); rerr != nil {
+			log.Errorf("归还优惠券%d领取名额失败: %v", templateID, rerr)
+		}
+		return nil, err
+	}
+	log.Infof("用户%d领取优惠券%d，券码%s", userID, templateID, coupon.Code)
+
+	coupon.CouponTemplate = template
+	return &dto.UserCouponDTO{UserCouponDO: *coupon}, nil
+}
+
+// seed 用数据库中已领取的数量初始化 Redis 计数
+func (s *couponService) seed(ctx context.Context, templateID, userID int32) error {
+	issued, err := s.data.UserCoupons().Count(ctx, templateID, 0)
+	if err != nil {
+		return err
+	}
+	claimed, err := s.data.UserCoupons().Count(ctx, templateID, userID)
+	if err != nil {
+		return err
+	}
+	return s.data.CouponLimits().Seed(ctx, templateID, userID, issued, claimed)
+}
+
+// List 用户的券包
+func (s *couponService) List(ctx context.Context, userID int32, status string, meta metav1.ListMeta) (*dto.UserCouponDTOList, error) {
+	coupons, err := s.data.UserCoupons().List(ctx, userID, status, time.Now(), meta)
+	if err != nil {
+		log.Errorf("获取券包失败: %v", err)
+		return nil, err
+	}
+	ret := &dto.UserCouponDTOList{
+		TotalCount: coupons.TotalCount,
+		Items:      make([]*dto.UserCouponDTO, 0, len(coupons.Items)),
+	}
+	for _, coupon := range coupons.Items {
+		ret.Items = append(ret.Items, &dto.UserCouponDTO{UserCouponDO: *coupon})
+	}
+	return ret, nil
+}
+
+// Get 按券码查询用户的优惠券
+func (s *couponService) Get(ctx context.Context, userID int32, code string) (*dto.UserCouponDTO, error) {
+	coupon, err := s.data.UserCoupons().GetByCode(ctx, nil, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.UserCouponDTO{UserCouponDO: *coupon}, nil
+}
+
+// Redeem 未使用且未过期的优惠券置为已使用，不可用时返回业务错误，由控制层转为 Aborted 让 Saga 回滚
+func (s *couponService) Redeem(ctx context.Context, userID int32, code, orderSn string) error {
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "创建屏障失败: %v", err)
+	}
+
+	return bgorm.CallWithGorm(barrier, s.data.DB(), func(tx *gorm.DB) error {
+		coupon, err := s.data.UserCoupons().GetByCode(ctx, tx, userID, code)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if !coupon.Usable(now) {
+			return errors.WithCode(code2.ErrCouponUnavailable, "优惠券%s已使用或已过期", code)
+		}
+		rows, err := s.data.UserCoupons().UpdateStatusFrom(ctx, tx, coupon.ID, do.UserCouponUnused, do.UserCouponUsed, orderSn, &now)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return errors.WithCode(code2.ErrCouponUnavailable, "优惠券%s已被其他订单使用", code)
+		}
+		log.Infof("订单%s核销优惠券%s", orderSn, code)
+		return nil
+	})
+}
+
+// Release 只退回被这个订单核销的优惠券，Redeem 没有执行过时屏障直接跳过（空补偿）
+func (s *couponService) Release(ctx context.Context, userID int32, code, orderSn string) error {
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "创建屏障失败: %v", err)
+	}
+
+	return bgorm.CallWithGorm(barrier, s.data.DB(), func(tx *gorm.DB) error {
+		coupon, err := s.data.UserCoupons().GetByCode(ctx, tx, userID, code)
+		if err != nil {
+			if errors.IsCode(err, code2.ErrUserCouponNotFound) {
+				return nil
+			}
+			return err
+		}
+		if coupon.Status != do.UserCouponUsed || coupon.OrderSn != orderSn {
+			log.Infof("优惠券%s不是被订单%s使用的，跳过退回", code, orderSn)
+			return nil
+		}
+		if _, err := s.data.UserCoupons().UpdateStatusFrom(ctx, tx, coupon.ID, do.UserCouponUsed, do.UserCouponUnused, "", nil); err != nil {
+			return err
+		}
+		log.Infof("订单%s的优惠券%s已退回券包", orderSn, code)
+		return nil
+	})
+}
+
+var _ CouponSrv = &couponService{}
@@ -6,6 +6,7 @@ type ServiceFactory interface {
 	Address() AddressSrv
 	Collection() CollectionSrv
 	Message() MessageSrv
+	Coupon() CouponSrv
 }
 
 type serviceFactory struct {
@@ -29,3 +30,7 @@ func (s *serviceFactory) Collection() CollectionSrv {
 func (s *serviceFactory) Message() MessageSrv {
 	return newMessage(s)
 }
+
+func (s *serviceFactory) Coupon() CouponSrv {
+	return newCoupon(s)
+}
@@ -36,6 +36,7 @@ func NewActionRPCServer(cfg *config.Config) (*rpcserver.Server, error) {
 	apb.RegisterUserFavServer(grpcServer.Server, actionServer)
 	apb.RegisterMessageServer(grpcServer.Server, actionServer)
 	apb.RegisterAddressServer(grpcServer.Server, actionServer)
+	apb.RegisterCouponServer(grpcServer.Server, actionServer)
 
 	return grpcServer, nil
 }
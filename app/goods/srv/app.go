@@ -6,8 +6,10 @@ import (
 	gapp "Advanced_Shop/gnova/app"
 	"Advanced_Shop/pkg/app"
 	"Advanced_Shop/pkg/log"
+	"Advanced_Shop/pkg/storage"
 	"context"
 	"github.com/hashicorp/consul/api"
+	"time"
 
 	_ "Advanced_Shop/app/pkg/code"
 	_ "Advanced_Shop/gnova/code"
@@ -21,6 +23,7 @@ func NewApp(basename string) *app.App {
 		basename,
 		app.WithOptions(cfg),
 		app.WithRunFunc(run(cfg)),
+		app.WithCommands(newReindexCommand(cfg), newCheckIndexCommand(cfg)),
 		//app.WithNoConfig(), //设置不读取配置文件
 	)
 	return appl
@@ -38,7 +41,7 @@ func NewRegistrar(registry *options.RegistryOptions) registry.Registrar {
 	return r
 }
 
-func NewGoodsApp(cfg *config.Config) (*gapp.App, error) {
+func NewGoodsApp(cfg *config.Config, ctx context.Context) (*gapp.App, error) {
 	//初始化log
 	log.Init(cfg.Log)
 	defer log.Flush()
@@ -46,8 +49,45 @@ func NewGoodsApp(cfg *config.Config) (*gapp.App, error) {
 	//服务注册
 	register := NewRegistrar(cfg.Registry)
 
+	//统计热搜词时连接redis
+	if cfg.Search.HotEnable {
+		redisConfig := &storage.Config{
+			Host:                  cfg.RedisOptions.Host,
+			Port:                  cfg.RedisOptions.Port,
+			Addrs:                 cfg.RedisOptions.Addrs,
+			MasterName:            cfg.RedisOptions.MasterName,
+			Username:              cfg.RedisOptions.Username,
+			Password:              cfg.RedisOptions.Password,
+			Database:              cfg.RedisOptions.Database,
+			MaxIdle:               cfg.RedisOptions.MaxIdle,
+			MaxActive:             cfg.RedisOptions.MaxActive,
+			Timeout:               cfg.RedisOptions.Timeout,
+			EnableCluster:         cfg.RedisOptions.EnableCluster,
+			UseSSL:                cfg.RedisOptions.UseSSL,
+			SSLInsecureSkipVerify: cfg.RedisOptions.SSLInsecureSkipVerify,
+			EnableTracing:         cfg.RedisOptions.EnableTracing,
+		}
+		redisCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		storage.ConnectToRedis(redisCtx, redisConfig)
+
+		// 等待Redis连接就绪
+		for i := 0; i < 10; i++ {
+			if storage.Connected() {
+				log.Info("Redis连接成功")
+				break
+			}
+			log.Warn("等待Redis连接就绪...")
+			time.Sleep(1000 * time.Millisecond)
+		}
+
+		if !storage.Connected() {
+			log.Fatal("Redis连接失败，服务启动失败")
+		}
+	}
+
 	//生成rpc服务
-	rpcServer, err := NewGoodsRPCServer(cfg)
+	rpcServer, err := NewGoodsRPCServer(cfg, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +101,11 @@ func NewGoodsApp(cfg *config.Config) (*gapp.App, error) {
 
 func run(cfg *config.Config) app.RunFunc {
 	return func(baseName string, ctx context.Context) error {
-		goodsApp, err := NewGoodsApp(cfg)
+		// 服务停止时结束发件箱转发、商品变更和库存告警的消费
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		goodsApp, err := NewGoodsApp(cfg, ctx)
 		if err != nil {
 			return err
 		}
@@ -16,6 +16,9 @@ type Config struct {
 	MySQLOptions *options.MySQLOptions     `json:"mysql" mapstructure:"mysql"`
 	CanalOpts    *options.CanalOptions     `json:"canal" mapstructure:"canal"`
 	MqOpts       *options.RocketMQOptions  `json:"mq" mapstructure:"mq"`
+	Outbox       *options.OutboxOptions    `json:"outbox" mapstructure:"outbox"`
+	Search       *options.SearchOptions    `json:"search" mapstructure:"search"`
+	RedisOptions *options.RedisOptions     `json:"redis" mapstructure:"redis"`
 }
 
 func (c *Config) Validate() []error {
@@ -26,8 +29,15 @@ func (c *Config) Validate() []error {
 	errors = append(errors, c.Telemetry.Validate()...)
 	errors = append(errors, c.MySQLOptions.Validate()...)
 	errors = append(errors, c.EsOptions.Validate()...)
-	errors = append(errors, c.CanalOpts.Validate()...)
+	if !c.Outbox.Enable {
+		errors = append(errors, c.CanalOpts.Validate()...)
+	}
 	errors = append(errors, c.MqOpts.Validate()...)
+	errors = append(errors, c.Outbox.Validate()...)
+	errors = append(errors, c.Search.Validate()...)
+	if c.Search.HotEnable {
+		errors = append(errors, c.RedisOptions.Validate()...)
+	}
 	return errors
 }
 
@@ -40,6 +50,9 @@ func (c *Config) Flags() (fss cliflag.NamedFlagSets) {
 	c.EsOptions.AddFlags(fss.FlagSet("es"))
 	c.CanalOpts.AddFlags(fss.FlagSet("canal"))
 	c.MqOpts.AddFlags(fss.FlagSet("rabbitmq"))
+	c.Outbox.AddFlags(fss.FlagSet("outbox"))
+	c.Search.AddFlags(fss.FlagSet("search"))
+	c.RedisOptions.AddFlags(fss.FlagSet("redis"))
 	return fss
 }
 
@@ -54,5 +67,8 @@ func New() *Config {
 		EsOptions:    options.NewEsOptions(),
 		CanalOpts:    options.NewCanalOptions(),
 		MqOpts:       options.NewRocketMQOptions(),
+		Outbox:       options.NewOutboxOptions(),
+		Search:       options.NewSearchOptions(),
+		RedisOptions: options.NewRedisOptions(),
 	}
 }
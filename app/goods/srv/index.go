@@ -0,0 +1,90 @@
+package srv
+
+import (
+	"Advanced_Shop/app/goods/srv/config"
+	data "Advanced_Shop/app/goods/srv/internal/data/v1/realize"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/es"
+	v1 "Advanced_Shop/app/goods/srv/internal/service/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/app"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"fmt"
+)
+
+// newReindexCommand 从 MySQL 全量重建商品索引，写完并校验数量后切换 goods_index 别名
+func newReindexCommand(cfg *config.Config) *app.Command {
+	return app.NewCommand("reindex",
+		"Rebuild the goods search index from MySQL into a new index and switch the alias to it",
+		app.WithCommandOptions(cfg),
+		app.WithCommandRunFunc(func(args []string) error {
+			indexSrv, err := newIndexService(cfg)
+			if err != nil {
+				return err
+			}
+			defer log.Flush()
+
+			ret, err := indexSrv.Reindex(context.Background())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("index:     %s\n", ret.Index)
+			fmt.Printf("indexed:   %d\n", ret.Indexed)
+			fmt.Printf("caught up: %d\n", ret.CaughtUp)
+			fmt.Printf("previous:  %v\n", ret.Previous)
+			fmt.Printf("deleted:   %v\n", ret.Deleted)
+			fmt.Printf("elapsed:   %s\n", ret.Elapsed)
+			return nil
+		}),
+	)
+}
+
+// newCheckIndexCommand 抽样对比 MySQL 和 ES 中的商品，有不一致时以非零状态退出
+func newCheckIndexCommand(cfg *config.Config) *app.Command {
+	return app.NewCommand("check-index",
+		"Compare a sample of goods in MySQL with the search index and report drift",
+		app.WithCommandOptions(cfg),
+		app.WithCommandRunFunc(func(args []string) error {
+			indexSrv, err := newIndexService(cfg)
+			if err != nil {
+				return err
+			}
+			defer log.Flush()
+
+			ret, err := indexSrv.Check(context.Background())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("mysql count: %d\n", ret.MysqlCount)
+			fmt.Printf("es count:    %d\n", ret.EsCount)
+			fmt.Printf("sampled:     %d\n", ret.Sampled)
+			fmt.Printf("missing:     %v\n", ret.Missing)
+			for _, drift := range ret.Stale {
+				fmt.Printf("stale:       %d %v\n", drift.ID, drift.Fields)
+			}
+			if ret.Drifted() {
+				return errors.WithCode(code.ErrReindexFailed, "商品索引和 MySQL 不一致，可以执行 reindex 命令重建索引")
+			}
+			return nil
+		}),
+	)
+}
+
+func newIndexService(cfg *config.Config) (v1.IndexSrv, error) {
+	log.Init(cfg.Log)
+
+	// 维护索引不需要统计热搜词，不连接 Redis
+	searchOpts := *cfg.Search
+	searchOpts.HotEnable = false
+
+	dataFactory := data.NewDataStore(cfg.MySQLOptions, cfg.MqOpts, cfg.CanalOpts, cfg.Registry)
+	searchFactory, err := es.GetSearchFactoryOr(cfg.EsOptions, cfg.MqOpts, cfg.CanalOpts, &searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	if err := searchFactory.EnsureIndex(context.Background()); err != nil {
+		return nil, err
+	}
+	return v1.NewService(dataFactory, searchFactory, cfg.MqOpts, cfg.Outbox, &searchOpts).Index(), nil
+}
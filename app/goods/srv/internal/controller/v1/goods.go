@@ -58,7 +58,18 @@ func GoodInfoFunction(goods *dto.GoodsDTO) *proto.GoodsInfoResponse {
 	response.FavNum = goods.FavNum
 	response.MarketPrice = goods.MarketPrice
 	response.ShopPrice = goods.ShopPrice
+	response.MaxPrice = goods.ShopPrice
+	if goods.MaxPrice > goods.ShopPrice {
+		response.MaxPrice = goods.MaxPrice
+	}
+	response.HasSku = goods.HasSku != nil && *goods.HasSku
 	response.GoodsBrief = goods.GoodsBrief
+	response.NameHighlight = goods.NameHighlight
+	response.BriefHighlight = goods.BriefHighlight
+	response.MerchantId = goods.Merchant
+	if goods.PurchaseLimit != nil {
+		response.PurchaseLimit = *goods.PurchaseLimit
+	}
 	response.GoodsFrontImage = firstImage
 	response.DescImages = descImages
 	response.Images = otherImages
@@ -85,9 +96,51 @@ func (gs *goodsServer) GoodsList(ctx context.Context, request *proto.GoodsFilter
 	for _, item := range list.Items {
 		ret.Data = append(ret.Data, GoodInfoFunction(item))
 	}
+	if list.Facets != nil {
+		ret.Facets = searchFacetsResponse(list.Facets)
+	}
 	return &ret, nil
 }
 
+// SuggestGoods 搜索建议，没有输入关键词时返回热搜词
+func (gs *goodsServer) SuggestGoods(ctx context.Context, request *proto.SuggestRequest) (*proto.SuggestResponse, error) {
+	suggest, err := gs.srv.Goods().Suggest(ctx, request.KeyWords, int(request.Size))
+	if err != nil {
+		log.Errorf("suggest goods error: %v", err.Error())
+		return nil, err
+	}
+	ret := proto.SuggestResponse{Suggestions: suggest.Suggestions}
+	for _, term := range suggest.HotTerms {
+		ret.HotTerms = append(ret.HotTerms, &proto.HotTermInfo{
+			Term:  term.Term,
+			Count: term.Count,
+		})
+	}
+	return &ret, nil
+}
+
+func searchFacetsResponse(facets *do.GoodsFacets) *proto.SearchFacets {
+	return &proto.SearchFacets{
+		Brands:        facetBucketsResponse(facets.Brands),
+		Categories:    facetBucketsResponse(facets.Categories),
+		Prices:        facetBucketsResponse(facets.Prices),
+		PriceInterval: facets.PriceInterval,
+		ShipFree:      facetBucketsResponse(facets.ShipFree),
+	}
+}
+
+func facetBucketsResponse(buckets []*do.FacetBucket) []*proto.FacetBucket {
+	var ret []*proto.FacetBucket
+	for _, bucket := range buckets {
+		ret = append(ret, &proto.FacetBucket{
+			Key:   bucket.Key,
+			Name:  bucket.Name,
+			Count: bucket.Count,
+		})
+	}
+	return ret
+}
+
 func (gs *goodsServer) BatchGetGoods(ctx context.Context, info *proto.BatchGoodsIdInfo) (*proto.GoodsListResponse, error) {
 	var ids []uint64
 	for _, id := range info.Id {
@@ -115,10 +168,13 @@ func (gs *goodsServer) CreateGoods(ctx context.Context, info *proto.CreateGoodsI
 		MarketPrice: info.MarketPrice,
 		ShopPrice:   info.ShopPrice,
 		GoodsBrief:  info.GoodsBrief,
+		Merchant:    info.MerchantId,
 		ShipFree:    info.ShipFree,
 		IsNew:       info.IsNew,
 		IsHot:       info.IsHot,
 		OnSale:      info.OnSale,
+
+		PurchaseLimit: info.PurchaseLimit,
 	}
 
 	request := good.GoodsInfo{
@@ -170,10 +226,13 @@ func (gs *goodsServer) UpdateGoods(ctx context.Context, info *proto.CreateGoodsI
 		MarketPrice: info.MarketPrice,
 		ShopPrice:   info.ShopPrice,
 		GoodsBrief:  info.GoodsBrief,
+		Merchant:    info.MerchantId,
 		ShipFree:    info.ShipFree,
 		IsNew:       info.IsNew,
 		IsHot:       info.IsHot,
 		OnSale:      info.OnSale,
+
+		PurchaseLimit: info.PurchaseLimit,
 	}
 
 	request := good.GoodsInfo{
@@ -203,8 +262,18 @@ func (gs *goodsServer) GetGoodsDetail(ctx context.Context, request *proto.GoodIn
 		return nil, err
 	}
 
+	response := GoodInfoFunction(goodsDTO)
+	if response.HasSku {
+		skus, err := gs.srv.Sku().ListByGoods(ctx, uint64(request.Id))
+		if err != nil {
+			log.Errorf("get goods skus error, id: %d, err: %v", request.Id, err.Error())
+			return nil, err
+		}
+		response.Skus = skuListResponse(skus).Data
+	}
+
 	log.Infof("get goods detail success, id: %d", request.Id)
-	return GoodInfoFunction(goodsDTO), nil
+	return response, nil
 }
 
 func NewGoodsServer(srv v1.ServiceFactory) *goodsServer {
@@ -0,0 +1,160 @@
+package v1
+
+import (
+	proto "Advanced_Shop/api/goods/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/gorm"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func specificationResponse(spec *do.SpecificationDO) *proto.SpecificationResponse {
+	return &proto.SpecificationResponse{
+		Id:         spec.ID,
+		CategoryId: spec.CategoryID,
+		Name:       spec.Name,
+		Values:     spec.Values,
+		Sort:       spec.Sort,
+	}
+}
+
+func SkuInfoFunction(sku *do.GoodsSkuDO) *proto.SkuInfoResponse {
+	response := &proto.SkuInfoResponse{
+		Id:          sku.ID,
+		GoodsId:     sku.GoodsID,
+		SkuSn:       sku.SkuSn,
+		Barcode:     sku.Barcode,
+		Price:       sku.Price,
+		MarketPrice: sku.MarketPrice,
+		OnSale:      sku.OnSale == nil || *sku.OnSale,
+		Image:       sku.Image,
+		SpecText:    sku.Specs.Text(),
+	}
+	if sku.PurchaseLimit != nil {
+		response.PurchaseLimit = *sku.PurchaseLimit
+	}
+	for _, spec := range sku.Specs {
+		response.Specs = append(response.Specs, &proto.SkuSpec{Name: spec.Name, Value: spec.Value})
+	}
+	return response
+}
+
+func skuListResponse(list *do.GoodsSkuDOList) *proto.SkuListResponse {
+	ret := &proto.SkuListResponse{Total: int32(list.TotalCount)}
+	for _, sku := range list.Items {
+		ret.Data = append(ret.Data, SkuInfoFunction(sku))
+	}
+	return ret
+}
+
+func skuFromRequest(request *proto.SkuInfoRequest) *do.GoodsSkuDO {
+	sku := &do.GoodsSkuDO{
+		Model:       gorm.Model{ID: request.Id},
+		GoodsID:     request.GoodsId,
+		SkuSn:       request.SkuSn,
+		Barcode:     request.Barcode,
+		Price:       request.Price,
+		MarketPrice: request.MarketPrice,
+		OnSale:      request.OnSale,
+		Image:       request.Image,
+
+		PurchaseLimit: request.PurchaseLimit,
+	}
+	for _, spec := range request.Specs {
+		sku.Specs = append(sku.Specs, do.SkuSpec{Name: spec.Name, Value: spec.Value})
+	}
+	return sku
+}
+
+// SpecificationList 分类下的规格
+func (gs *goodsServer) SpecificationList(ctx context.Context, request *proto.SpecificationFilterRequest) (*proto.SpecificationListResponse, error) {
+	list, err := gs.srv.Sku().Specifications(ctx, request.CategoryId)
+	if err != nil {
+		log.Errorf("get specification list error, category: %d, err: %v", request.CategoryId, err.Error())
+		return nil, err
+	}
+	ret := &proto.SpecificationListResponse{Total: int32(list.TotalCount)}
+	for _, spec := range list.Items {
+		ret.Data = append(ret.Data, specificationResponse(spec))
+	}
+	return ret, nil
+}
+
+// CreateSpecification 创建规格
+func (gs *goodsServer) CreateSpecification(ctx context.Context, request *proto.SpecificationRequest) (*proto.SpecificationResponse, error) {
+	spec := &do.SpecificationDO{
+		CategoryID: request.CategoryId,
+		Name:       request.Name,
+		Values:     request.Values,
+		Sort:       request.Sort,
+	}
+	err := gs.srv.Sku().CreateSpecification(ctx, spec)
+	if err != nil {
+		log.Errorf("create specification error: %v", err.Error())
+		return nil, err
+	}
+	return specificationResponse(spec), nil
+}
+
+// UpdateSpecification 更新规格，不能修改所属分类
+func (gs *goodsServer) UpdateSpecification(ctx context.Context, request *proto.SpecificationRequest) (*emptypb.Empty, error) {
+	spec := &do.SpecificationDO{
+		Model:  gorm.Model{ID: request.Id},
+		Name:   request.Name,
+		Values: request.Values,
+		Sort:   request.Sort,
+	}
+	err := gs.srv.Sku().UpdateSpecification(ctx, spec)
+	if err != nil {
+		log.Errorf("update specification error, id: %d, err: %v", request.Id, err.Error())
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GoodsSkuList 商品的所有 SKU
+func (gs *goodsServer) GoodsSkuList(ctx context.Context, request *proto.GoodInfoRequest) (*proto.SkuListResponse, error) {
+	list, err := gs.srv.Sku().ListByGoods(ctx, uint64(request.Id))
+	if err != nil {
+		log.Errorf("get goods sku list error, goods: %d, err: %v", request.Id, err.Error())
+		return nil, err
+	}
+	return skuListResponse(list), nil
+}
+
+// BatchGetSkus 批量查询 SKU，不存在的 SKU 不返回
+func (gs *goodsServer) BatchGetSkus(ctx context.Context, info *proto.BatchSkuIdInfo) (*proto.SkuListResponse, error) {
+	var ids []uint64
+	for _, id := range info.Id {
+		ids = append(ids, uint64(id))
+	}
+	list, err := gs.srv.Sku().BatchGet(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return skuListResponse(list), nil
+}
+
+// CreateSku 创建 SKU
+func (gs *goodsServer) CreateSku(ctx context.Context, request *proto.SkuInfoRequest) (*proto.SkuInfoResponse, error) {
+	sku := skuFromRequest(request)
+	err := gs.srv.Sku().Create(ctx, sku)
+	if err != nil {
+		log.Errorf("create sku error, goods: %d, err: %v", request.GoodsId, err.Error())
+		return nil, err
+	}
+	return SkuInfoFunction(sku), nil
+}
+
+// UpdateSku 更新 SKU，不能修改所属商品
+func (gs *goodsServer) UpdateSku(ctx context.Context, request *proto.SkuInfoRequest) (*emptypb.Empty, error) {
+	sku := skuFromRequest(request)
+	sku.GoodsID = 0
+	err := gs.srv.Sku().Update(ctx, sku)
+	if err != nil {
+		log.Errorf("update sku error, id: %d, err: %v", request.Id, err.Error())
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
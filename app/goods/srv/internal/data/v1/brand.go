@@ -14,4 +14,5 @@ type BrandsStore interface {
 	Update(ctx context.Context, txn *gorm.DB, brands *do.BrandsDO) error
 	Delete(ctx context.Context, ID uint64) error
 	Get(ctx context.Context, ID uint64) (*do.BrandsDO, error)
+	ListByIDs(ctx context.Context, ids []uint64) ([]*do.BrandsDO, error)
 }
@@ -8,6 +8,7 @@ import (
 type CategoryStore interface {
 	Get(ctx context.Context, ID uint64) (*do.CategoryDO, error)
 	ListAll(ctx context.Context, orderby []string) (*do.CategoryDOList, error)
+	ListByIDs(ctx context.Context, ids []uint64) ([]*do.CategoryDO, error)
 	Create(ctx context.Context, goods *do.CategoryDO) error
 	Update(ctx context.Context, goods *do.CategoryDO) error
 	Delete(ctx context.Context, ID uint64) error
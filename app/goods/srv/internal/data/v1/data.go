@@ -1,6 +1,7 @@
 package v1
 
 import (
+	ipbv1 "Advanced_Shop/api/inventory/v1"
 	"context"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	pbe "github.com/withlin/canal-go/protocol/entry"
@@ -13,7 +14,10 @@ type MysqlFactory interface {
 	Brands() BrandsStore
 	Banners() BannerStore
 	CategoryBrands() GoodsCategoryBrandStore
+	Specifications() SpecificationStore
+	Skus() SkuStore
 	Begin() *gorm.DB
+	DB() *gorm.DB
 }
 
 type CanalFactory interface {
@@ -28,5 +32,7 @@ type DataFactory interface {
 	NewMysql() MysqlFactory
 	NewCanal() CanalFactory
 	NewMQ() MQFactory
+	// Inventorys 库存服务的客户端，第一次调用时连接
+	Inventorys() ipbv1.InventoryClient
 	StartCanalListener(context.Context)
 }
@@ -40,6 +40,18 @@ func (b *brands) Get(ctx context.Context, ID uint64) (*do.BrandsDO, error) {
 	return &brandModel, nil
 }
 
+// ListByIDs 批量查询品牌，不存在的ID直接忽略
+func (b *brands) ListByIDs(ctx context.Context, ids []uint64) ([]*do.BrandsDO, error) {
+	var brandModels []*do.BrandsDO
+	if len(ids) == 0 {
+		return brandModels, nil
+	}
+	if err := b.db.Where("id in ?", ids).Find(&brandModels).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return brandModels, nil
+}
+
 func (b *brands) List(ctx context.Context, opts metav1.ListMeta, orderby []string) (*do.BrandsDOList, error) {
 	var brandModels []*do.BrandsDO
 
@@ -37,6 +37,18 @@ func (c *categorys) Get(ctx context.Context, ID uint64) (*do.CategoryDO, error)
 	return category, nil
 }
 
+// ListByIDs 批量查询分类，不加载子分类，不存在的ID直接忽略
+func (c *categorys) ListByIDs(ctx context.Context, ids []uint64) ([]*do.CategoryDO, error) {
+	var categorys []*do.CategoryDO
+	if len(ids) == 0 {
+		return categorys, nil
+	}
+	if err := c.db.Where("id in ?", ids).Find(&categorys).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return categorys, nil
+}
+
 func (c *categorys) ListAll(ctx context.Context, orderby []string) (*do.CategoryDOList, error) {
 	ret := &do.CategoryDOList{}
 	query := c.db
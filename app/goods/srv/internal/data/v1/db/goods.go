@@ -12,6 +12,7 @@ import (
 	"Advanced_Shop/pkg/log"
 	"context"
 	"gorm.io/gorm"
+	"time"
 )
 
 type goods struct {
@@ -31,7 +32,7 @@ func newGoods(factory *mysqlFactory) *goods {
 
 func (g *goods) CreateInTxn(ctx context.Context, txn *gorm.DB, goods *v1.GoodsInfo) error {
 	// 商品表
-	tx := txn.Create(goods.GoodsDO)
+	tx := txn.Create(&goods.GoodsDO)
 	if tx.Error != nil {
 		log.Errorf("mysql create goods error: %v", tx.Error)
 		return errors.WithCode(code2.ErrDatabase, tx.Error.Error())
@@ -194,6 +195,14 @@ func (g *goods) UpdateInTxn(ctx context.Context, txn *gorm.DB, goods *v1.GoodsIn
 		OnSale:      goods.GoodsDO.OnSale,
 		CategoryId:  goods.GoodsDO.CategoryID,
 		Brand:       goods.GoodsDO.BrandsID,
+		Merchant:    goods.GoodsDO.Merchant,
+
+		PurchaseLimit: goods.GoodsDO.PurchaseLimit,
+	}
+	if StructMap.OnSale != nil {
+		// 人工修改上下架状态后，补货不再自动上架
+		soldOut := false
+		StructMap.SoldOut = &soldOut
 	}
 
 	toMap := struct_to_map.StructToMap(StructMap)
@@ -256,6 +265,19 @@ func (g *goods) Get(ctx context.Context, ID uint64) (*do.GoodsDO, error) {
 	return good, nil
 }
 
+func (g *goods) GetInTxn(ctx context.Context, txn *gorm.DB, ID uint64) (*do.GoodsDO, error) {
+	good := &do.GoodsDO{}
+	err := txn.First(good, ID).Error
+	if err != nil {
+		log.Errorf("mysql query error: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrGoodsNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return good, nil
+}
+
 func (g *goods) ListByIDs(ctx context.Context, ids []uint64, orderby []string) (*do.GoodsDOList, error) {
 	//实现gorm查询
 	ret := &do.GoodsDOList{}
@@ -292,6 +314,62 @@ func (g *goods) Update(ctx context.Context, goods *v1.GoodsInfo) error {
 	return nil
 }
 
+func (g *goods) UpdateOnSale(ctx context.Context, ID uint64, onSale bool) error {
+	err := g.db.Model(&do.GoodsDO{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"on_sale":  onSale,
+		"sold_out": false,
+	}).Error
+	if err != nil {
+		log.Errorf("mysql update on_sale error: %v", err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (g *goods) UpdateOnSaleInTxn(ctx context.Context, txn *gorm.DB, ID uint64, onSale bool) error {
+	err := txn.Model(&do.GoodsDO{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"on_sale":  onSale,
+		"sold_out": false,
+	}).Error
+	if err != nil {
+		log.Errorf("mysql update on_sale error: %v", err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (g *goods) UpdateSoldOutInTxn(ctx context.Context, txn *gorm.DB, ID uint64, soldOut bool) (int64, error) {
+	// 下架只处理在售的商品，上架只处理售罄下架的商品，人工下架的商品补货后保持下架
+	query := txn.Model(&do.GoodsDO{}).Where("id = ?", ID)
+	if soldOut {
+		query = query.Where("on_sale = ?", true)
+	} else {
+		query = query.Where("sold_out = ?", true)
+	}
+	result := query.Updates(map[string]interface{}{
+		"on_sale":  !soldOut,
+		"sold_out": soldOut,
+	})
+	if result.Error != nil {
+		log.Errorf("mysql update sold_out error: %v", result.Error)
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+func (g *goods) UpdatePriceInTxn(ctx context.Context, txn *gorm.DB, ID uint64, minPrice, maxPrice float32) error {
+	err := txn.Model(&do.GoodsDO{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"shop_price": minPrice,
+		"max_price":  maxPrice,
+		"has_sku":    true,
+	}).Error
+	if err != nil {
+		log.Errorf("mysql update goods price error: %v", err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
 func (g *goods) Delete(ctx context.Context, ID uint64) error {
 	err := g.db.Where("id = ?", ID).Delete(&do.GoodsDO{}).Error
 	if err != nil {
@@ -301,4 +379,45 @@ func (g *goods) Delete(ctx context.Context, ID uint64) error {
 	return err
 }
 
+func (g *goods) ListAfter(ctx context.Context, afterID uint64, size int) ([]*do.GoodsDO, error) {
+	var items []*do.GoodsDO
+	err := g.db.WithContext(ctx).Where("id > ?", afterID).Order("id").Limit(size).Find(&items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return items, nil
+}
+
+func (g *goods) ListChangedSince(ctx context.Context, since time.Time, afterID uint64, size int) ([]*do.GoodsDO, error) {
+	var items []*do.GoodsDO
+	// 软删除的商品 update_time 不变，按 deleted_at 判断
+	err := g.db.WithContext(ctx).Unscoped().
+		Where("id > ? AND (update_time >= ? OR deleted_at >= ?)", afterID, since, since).
+		Order("id").Limit(size).Find(&items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return items, nil
+}
+
+func (g *goods) Count(ctx context.Context) (int64, error) {
+	var count int64
+	if err := g.db.WithContext(ctx).Model(&do.GoodsDO{}).Count(&count).Error; err != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return count, nil
+}
+
+func (g *goods) IDRange(ctx context.Context) (minID, maxID uint64, err error) {
+	var ret struct {
+		MinID uint64
+		MaxID uint64
+	}
+	err = g.db.WithContext(ctx).Model(&do.GoodsDO{}).Select("MIN(id) AS min_id, MAX(id) AS max_id").Scan(&ret).Error
+	if err != nil {
+		return 0, 0, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret.MinID, ret.MaxID, nil
+}
+
 var _ v1.GoodsStore = &goods{}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	ipbv1 "Advanced_Shop/api/inventory/v1"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/gnova/registry/consul"
+	"Advanced_Shop/gnova/server/rpcserver"
+	"Advanced_Shop/gnova/server/rpcserver/clientinterceptors"
+	"context"
+	cosulAPI "github.com/hashicorp/consul/api"
+
+	"Advanced_Shop/gnova/registry"
+)
+
+const inventoryserviceName = "discovery:///xshop-inventory-srv"
+
+func NewDiscovery(opts *options.RegistryOptions) registry.Discovery {
+	c := cosulAPI.DefaultConfig()
+	c.Address = opts.Address
+	c.Scheme = opts.Scheme
+	cli, err := cosulAPI.NewClient(c)
+	if err != nil {
+		panic(err)
+	}
+	r := consul.New(cli, consul.WithHealthCheck(true))
+	return r
+}
+
+// GetInventoryClient 库存告警只通知变化，处理前通过库存服务确认当前的可售库存
+func GetInventoryClient(opts *options.RegistryOptions) ipbv1.InventoryClient {
+	discovery := NewDiscovery(opts)
+	return NewInventoryServiceClient(discovery)
+}
+
+func NewInventoryServiceClient(r registry.Discovery) ipbv1.InventoryClient {
+	conn, err := rpcserver.DialInsecure(
+		context.Background(),
+		rpcserver.WithEndpoint(inventoryserviceName),
+		rpcserver.WithDiscovery(r),
+		rpcserver.WithClientUnaryInterceptor(clientinterceptors.UnaryTracingInterceptor),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return ipbv1.NewInventoryClient(conn)
+}
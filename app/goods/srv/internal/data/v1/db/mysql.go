@@ -29,6 +29,10 @@ func (mf *mysqlFactory) Begin() *gorm.DB {
 	return mf.db.Begin()
 }
 
+func (mf *mysqlFactory) DB() *gorm.DB {
+	return mf.db
+}
+
 func (mf *mysqlFactory) Goods() v1.GoodsStore {
 	return newGoods(mf)
 }
@@ -49,6 +53,14 @@ func (m *mysqlFactory) CategoryBrands() v1.GoodsCategoryBrandStore {
 	return NewCategoryBrands(m)
 }
 
+func (mf *mysqlFactory) Specifications() v1.SpecificationStore {
+	return newSpecifications(mf)
+}
+
+func (mf *mysqlFactory) Skus() v1.SkuStore {
+	return newSkus(mf)
+}
+
 var _ v1.MysqlFactory = &mysqlFactory{}
 
 // NewMySQLDataFactory 这个方法会返回gorm连接
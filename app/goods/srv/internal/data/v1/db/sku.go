@@ -0,0 +1,170 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/goods/srv/internal/domain/service"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/struct_to_map"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"gorm.io/gorm"
+)
+
+type specifications struct {
+	db *gorm.DB
+}
+
+func newSpecifications(factory *mysqlFactory) *specifications {
+	return &specifications{
+		db: factory.db,
+	}
+}
+
+func (s *specifications) List(ctx context.Context, categoryID int32) (*do.SpecificationDOList, error) {
+	ret := &do.SpecificationDOList{}
+	err := s.db.Where("category_id = ?", categoryID).Order("sort, id").Find(&ret.Items).Error
+	if err != nil {
+		log.Errorf("mysql query specification list error: %v", err)
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	ret.TotalCount = int64(len(ret.Items))
+	return ret, nil
+}
+
+func (s *specifications) Get(ctx context.Context, ID uint64) (*do.SpecificationDO, error) {
+	var spec do.SpecificationDO
+	err := s.db.Take(&spec, ID).Error
+	if err != nil {
+		log.Errorf("specification not found, ID: %d, error: %v", ID, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrSpecificationNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &spec, nil
+}
+
+func (s *specifications) Create(ctx context.Context, spec *do.SpecificationDO) error {
+	err := s.db.Create(spec).Error
+	if err != nil {
+		log.Errorf("mysql create specification error, name: %s, error: %v", spec.Name, err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (s *specifications) Update(ctx context.Context, spec *do.SpecificationDO) error {
+	var model do.SpecificationDO
+	err := s.db.Take(&model, spec.ID).Error
+	if err != nil {
+		log.Errorf("specification not found, ID: %d, error: %v", spec.ID, err)
+		return errors.WithCode(code.ErrSpecificationNotFound, err.Error())
+	}
+
+	toMap := struct_to_map.StructToMap(service.SpecificationUpdateServiceMap{
+		Name: spec.Name,
+		Sort: spec.Sort,
+	})
+	if spec.Values != nil {
+		toMap["values"] = spec.Values
+	}
+	if len(toMap) == 0 {
+		return nil
+	}
+	err = s.db.Model(&model).Updates(toMap).Error
+	if err != nil {
+		log.Errorf("mysql update specification error, ID: %d, error: %v", spec.ID, err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+var _ v1.SpecificationStore = &specifications{}
+
+type skus struct {
+	db *gorm.DB
+}
+
+func newSkus(factory *mysqlFactory) *skus {
+	return &skus{
+		db: factory.db,
+	}
+}
+
+func (s *skus) Get(ctx context.Context, ID uint64) (*do.GoodsSkuDO, error) {
+	var sku do.GoodsSkuDO
+	err := s.db.Take(&sku, ID).Error
+	if err != nil {
+		log.Errorf("sku not found, ID: %d, error: %v", ID, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrSkuNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &sku, nil
+}
+
+func (s *skus) ListByIDs(ctx context.Context, ids []uint64) (*do.GoodsSkuDOList, error) {
+	ret := &do.GoodsSkuDOList{}
+	err := s.db.Where("id in ?", ids).Find(&ret.Items).Error
+	if err != nil {
+		log.Errorf("mysql query sku error: %v", err)
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	ret.TotalCount = int64(len(ret.Items))
+	return ret, nil
+}
+
+func (s *skus) ListByGoods(ctx context.Context, txn *gorm.DB, goodsID uint64) (*do.GoodsSkuDOList, error) {
+	db := s.db
+	if txn != nil {
+		db = txn
+	}
+	ret := &do.GoodsSkuDOList{}
+	err := db.Where("goods_id = ?", goodsID).Order("id").Find(&ret.Items).Error
+	if err != nil {
+		log.Errorf("mysql query goods sku error: %v", err)
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	ret.TotalCount = int64(len(ret.Items))
+	return ret, nil
+}
+
+func (s *skus) CreateInTxn(ctx context.Context, txn *gorm.DB, sku *do.GoodsSkuDO) error {
+	err := txn.Create(sku).Error
+	if err != nil {
+		log.Errorf("mysql create sku error, sn: %s, error: %v", sku.SkuSn, err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (s *skus) UpdateInTxn(ctx context.Context, txn *gorm.DB, sku *do.GoodsSkuDO) error {
+	toMap := struct_to_map.StructToMap(service.SkuUpdateServiceMap{
+		SkuSn:       sku.SkuSn,
+		Barcode:     sku.Barcode,
+		Price:       sku.Price,
+		MarketPrice: sku.MarketPrice,
+		OnSale:      sku.OnSale,
+		Image:       sku.Image,
+
+		PurchaseLimit: sku.PurchaseLimit,
+	})
+	if len(sku.Specs) > 0 {
+		toMap["specs"] = sku.Specs
+	}
+	if len(toMap) == 0 {
+		return nil
+	}
+	err := txn.Model(&do.GoodsSkuDO{}).Where("id = ?", sku.ID).Updates(toMap).Error
+	if err != nil {
+		log.Errorf("mysql update sku error, ID: %d, error: %v", sku.ID, err)
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+var _ v1.SkuStore = &skus{}
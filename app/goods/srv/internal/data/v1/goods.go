@@ -5,6 +5,7 @@ import (
 	metav1 "Advanced_Shop/pkg/common/meta/v1"
 	"context"
 	"gorm.io/gorm"
+	"time"
 )
 
 type GoodsInfo struct {
@@ -16,6 +17,8 @@ type GoodsInfo struct {
 
 type GoodsStore interface {
 	Get(ctx context.Context, ID uint64) (*do.GoodsDO, error)
+	// GetInTxn 在事务中读取商品，读到的是事务内未提交的最新数据
+	GetInTxn(ctx context.Context, txn *gorm.DB, ID uint64) (*do.GoodsDO, error)
 	ListByIDs(ctx context.Context, ids []uint64, orderby []string) (*do.GoodsDOList, error)
 	List(ctx context.Context, orderby []string, opts metav1.ListMeta) (*do.GoodsDOList, error)
 	Create(ctx context.Context, goods *GoodsInfo) error
@@ -23,7 +26,22 @@ type GoodsStore interface {
 	Update(ctx context.Context, goods *GoodsInfo) error
 	UpdateInTxn(ctx context.Context, txn *gorm.DB, goods *GoodsInfo) error
 	Delete(ctx context.Context, ID uint64) error
+	// UpdateOnSale 人工上下架，同时清除售罄标记，补货后不再自动上架
+	UpdateOnSale(ctx context.Context, ID uint64, onSale bool) error
+	UpdateOnSaleInTxn(ctx context.Context, txn *gorm.DB, ID uint64, onSale bool) error
+	// UpdateSoldOutInTxn 售罄自动下架或补货后重新上架，返回影响行数，0 表示商品状态不需要变化
+	UpdateSoldOutInTxn(ctx context.Context, txn *gorm.DB, ID uint64, soldOut bool) (int64, error)
 	DeleteInTxn(ctx context.Context, txn *gorm.DB, ID uint64) error
+	// UpdatePriceInTxn SKU 变更后回写商品的价格区间，售价为最低价
+	UpdatePriceInTxn(ctx context.Context, txn *gorm.DB, ID uint64, minPrice, maxPrice float32) error
+
+	// ListAfter 按ID顺序分批读取商品，用于全量重建索引
+	ListAfter(ctx context.Context, afterID uint64, size int) ([]*do.GoodsDO, error)
+	// ListChangedSince 按ID顺序分批读取 since 之后修改过的商品，包括已删除的商品
+	ListChangedSince(ctx context.Context, since time.Time, afterID uint64, size int) ([]*do.GoodsDO, error)
+	Count(ctx context.Context) (int64, error)
+	// IDRange 商品ID的范围，用于随机抽样
+	IDRange(ctx context.Context) (minID, maxID uint64, err error)
 
 	Begin() *gorm.DB
 }
@@ -0,0 +1,67 @@
+package mq
+
+import (
+	"Advanced_Shop/app/pkg/message"
+	"Advanced_Shop/app/pkg/options"
+	code2 "Advanced_Shop/gnova/code"
+	errors2 "Advanced_Shop/pkg/errors"
+	zlog "Advanced_Shop/pkg/log"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+)
+
+// StockAlertHandler 处理单条库存告警，返回错误时消息会重试
+type StockAlertHandler func(ctx context.Context, msg *message.StockAlertMessage) error
+
+// ListenStockAlert 订阅库存服务的告警 Topic
+// 与 ES 同步的消费者订阅的是不同的 Topic，所以使用单独的消费组
+func ListenStockAlert(ctx context.Context, mqOpts *options.RocketMQOptions, handler StockAlertHandler) error {
+	consumerIns, err := rocketmq.NewPushConsumer(
+		consumer.WithNameServer([]string{mqOpts.Addr()}),
+		consumer.WithGroupName(mqOpts.ConsumerGroupName+"_stock_alert"),
+		consumer.WithMaxReconsumeTimes(int32(mqOpts.MaxRetryTimes)),
+		consumer.WithConsumeMessageBatchMaxSize(1),
+	)
+	if err != nil {
+		return errors2.WithCode(code2.ErrConnectMQ, fmt.Sprintf("创建库存告警消费者失败: %v", err))
+	}
+
+	err = consumerIns.Subscribe(mqOpts.StockAlertTopic, consumer.MessageSelector{},
+		func(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+			for _, msg := range msgs {
+				var alert message.StockAlertMessage
+				if err := json.Unmarshal(msg.Body, &alert); err != nil {
+					// 消息体损坏，重试无意义
+					zlog.Errorf("库存告警消息解析失败, msgID: %s, err: %v", msg.MsgId, err)
+					continue
+				}
+				if err := handler(ctx, &alert); err != nil {
+					zlog.Errorf("库存告警处理失败, msgID: %s, goods: %d, err: %v", msg.MsgId, alert.GoodsId, err)
+					return consumer.ConsumeRetryLater, nil
+				}
+			}
+			return consumer.ConsumeSuccess, nil
+		})
+	if err != nil {
+		_ = consumerIns.Shutdown()
+		return errors2.WithCode(code2.ErrConnectMQ, fmt.Sprintf("订阅库存告警Topic失败: %v", err))
+	}
+
+	if err = consumerIns.Start(); err != nil {
+		_ = consumerIns.Shutdown()
+		return errors2.WithCode(code2.ErrConnectMQ, fmt.Sprintf("启动库存告警消费者失败: %v", err))
+	}
+	zlog.Infof("库存告警消费者启动成功, topic: %v", mqOpts.StockAlertTopic)
+
+	go func() {
+		<-ctx.Done()
+		if err := consumerIns.Shutdown(); err != nil {
+			zlog.Errorf("库存告警消费者关闭失败: %v", err)
+		}
+	}()
+	return nil
+}
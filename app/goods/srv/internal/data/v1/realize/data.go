@@ -1,6 +1,7 @@
 package realize
 
 import (
+	ipbv1 "Advanced_Shop/api/inventory/v1"
 	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
 	"Advanced_Shop/app/goods/srv/internal/data/v1/canal"
 	"Advanced_Shop/app/goods/srv/internal/data/v1/db"
@@ -10,23 +11,30 @@ import (
 	"context"
 	pbe "github.com/withlin/canal-go/protocol/entry"
 	"google.golang.org/protobuf/proto"
+	"sync"
 	"time"
 )
 
 type DataStore struct {
-	mysqlOpts *options.MySQLOptions
-	mqOpts    *options.RocketMQOptions
-	canalOpts *options.CanalOptions
+	mysqlOpts    *options.MySQLOptions
+	mqOpts       *options.RocketMQOptions
+	canalOpts    *options.CanalOptions
+	registryOpts *options.RegistryOptions
+
+	invOnce   sync.Once
+	invClient ipbv1.InventoryClient
 }
 
 func NewDataStore(
 	mysqlOpts *options.MySQLOptions,
 	mqOpts *options.RocketMQOptions,
-	canalOpts *options.CanalOptions) v1.DataFactory {
+	canalOpts *options.CanalOptions,
+	registryOpts *options.RegistryOptions) v1.DataFactory {
 	return &DataStore{
-		mysqlOpts: mysqlOpts,
-		mqOpts:    mqOpts,
-		canalOpts: canalOpts,
+		mysqlOpts:    mysqlOpts,
+		mqOpts:       mqOpts,
+		canalOpts:    canalOpts,
+		registryOpts: registryOpts,
 	}
 }
 
@@ -52,6 +60,14 @@ func (store *DataStore) NewMQ() v1.MQFactory {
 	return factory
 }
 
+// Inventorys 只有处理库存告警时才需要，重建索引等命令不连接库存服务
+func (store *DataStore) Inventorys() ipbv1.InventoryClient {
+	store.invOnce.Do(func() {
+		store.invClient = db.GetInventoryClient(store.registryOpts)
+	})
+	return store.invClient
+}
+
 func (store *DataStore) StartCanalListener(ctx context.Context) {
 	go func() {
 		zlog.Info("Canal监听器启动成功，开始监听商品表binlog")
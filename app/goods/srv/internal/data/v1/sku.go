@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"context"
+	"gorm.io/gorm"
+)
+
+type SpecificationStore interface {
+	// List 按展示顺序返回分类下的规格
+	List(ctx context.Context, categoryID int32) (*do.SpecificationDOList, error)
+	Get(ctx context.Context, ID uint64) (*do.SpecificationDO, error)
+	Create(ctx context.Context, spec *do.SpecificationDO) error
+	Update(ctx context.Context, spec *do.SpecificationDO) error
+}
+
+type SkuStore interface {
+	Get(ctx context.Context, ID uint64) (*do.GoodsSkuDO, error)
+	ListByIDs(ctx context.Context, ids []uint64) (*do.GoodsSkuDOList, error)
+	// ListByGoods txn 不为空时在事务中读取，用于 SKU 变更后重新计算价格区间
+	ListByGoods(ctx context.Context, txn *gorm.DB, goodsID uint64) (*do.GoodsSkuDOList, error)
+	CreateInTxn(ctx context.Context, txn *gorm.DB, sku *do.GoodsSkuDO) error
+	UpdateInTxn(ctx context.Context, txn *gorm.DB, sku *do.GoodsSkuDO) error
+}
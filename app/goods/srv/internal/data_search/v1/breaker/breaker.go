@@ -0,0 +1,109 @@
+package breaker
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/options"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+
+	"github.com/alibaba/sentinel-golang/api"
+	"github.com/alibaba/sentinel-golang/core/circuitbreaker"
+)
+
+// sentinel 中 ES 搜索的资源名
+const searchResource = "goods_search_es"
+
+// dataSearch 搜索经过熔断器访问主后端（ES），出错或熔断时改用降级后端（MySQL）
+// 写入、索引维护、消费商品变更消息都只走主后端，降级后端直接查询数据源不需要同步
+type dataSearch struct {
+	v1.SearchFactory
+	fallback v1.SearchFactory
+}
+
+// NewSearchFactory 按错误比例熔断，熔断 BreakerRetryTimeout 之后放行一个请求探测主后端是否恢复
+func NewSearchFactory(primary, fallback v1.SearchFactory, opts *options.SearchOptions) (v1.SearchFactory, error) {
+	_, err := circuitbreaker.LoadRulesOfResource(searchResource, []*circuitbreaker.Rule{{
+		Resource:         searchResource,
+		Strategy:         circuitbreaker.ErrorRatio,
+		RetryTimeoutMs:   uint32(opts.BreakerRetryTimeout.Milliseconds()),
+		MinRequestAmount: opts.BreakerMinRequests,
+		StatIntervalMs:   uint32(opts.BreakerStatInterval.Milliseconds()),
+		Threshold:        opts.BreakerErrorRatio,
+	}})
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrValidation, "加载搜索熔断规则失败: %v", err)
+	}
+	circuitbreaker.RegisterStateChangeListeners(&stateListener{})
+	return &dataSearch{SearchFactory: primary, fallback: fallback}, nil
+}
+
+func (ds *dataSearch) Goods() v1.GoodsStore {
+	return &goods{GoodsStore: ds.SearchFactory.Goods(), fallback: ds.fallback.Goods()}
+}
+
+type goods struct {
+	v1.GoodsStore
+	fallback v1.GoodsStore
+}
+
+func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.GoodsSearchDOList, error) {
+	var ret *do.GoodsSearchDOList
+	degraded, err := guard(ctx, func() (err error) {
+		ret, err = g.GoodsStore.Search(ctx, req)
+		return err
+	})
+	if degraded {
+		return g.fallback.Search(ctx, req)
+	}
+	return ret, err
+}
+
+func (g *goods) Suggest(ctx context.Context, prefix string, size int) ([]string, error) {
+	var ret []string
+	degraded, err := guard(ctx, func() (err error) {
+		ret, err = g.GoodsStore.Suggest(ctx, prefix, size)
+		return err
+	})
+	if degraded {
+		return g.fallback.Suggest(ctx, prefix, size)
+	}
+	return ret, err
+}
+
+// guard 经过熔断器调用主后端，熔断中或主后端出错时返回 degraded，由调用方改用降级后端
+// 参数错误和请求被取消不是主后端的问题，不计入错误比例，也不降级
+func guard(ctx context.Context, call func() error) (degraded bool, err error) {
+	entry, blockErr := api.Entry(searchResource)
+	if blockErr != nil {
+		return true, nil
+	}
+	defer entry.Exit()
+
+	err = call()
+	if err == nil || ctx.Err() != nil || errors.IsCode(err, code2.ErrValidation) {
+		return false, err
+	}
+	api.TraceError(entry, err)
+	log.Warnf("search goods from es err, degrade to fallback: %v", err)
+	return true, nil
+}
+
+// stateListener 熔断器状态变化时打日志，商品服务中只有搜索一个熔断资源
+type stateListener struct{}
+
+func (l *stateListener) OnTransformToClosed(prev circuitbreaker.State, rule circuitbreaker.Rule) {
+	log.Infof("%s 熔断器关闭，搜索恢复使用 ES", rule.Resource)
+}
+
+func (l *stateListener) OnTransformToOpen(prev circuitbreaker.State, rule circuitbreaker.Rule, snapshot interface{}) {
+	log.Warnf("%s 熔断器打开，错误比例 %v，搜索降级到 MySQL", rule.Resource, snapshot)
+}
+
+func (l *stateListener) OnTransformToHalfOpen(prev circuitbreaker.State, rule circuitbreaker.Rule) {
+	log.Infof("%s 熔断器半开，放行请求探测 ES 是否恢复", rule.Resource)
+}
+
+var _ v1.SearchFactory = &dataSearch{}
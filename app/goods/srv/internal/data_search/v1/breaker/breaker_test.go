@@ -0,0 +1,155 @@
+package breaker
+
+import (
+	proto "Advanced_Shop/api/goods/v1"
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/memory"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/options"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"testing"
+	"time"
+)
+
+// failingSearch 主后端，搜索和补全固定返回 err，并记录被调用的次数
+type failingSearch struct {
+	v1.SearchFactory
+	goods *failingGoods
+}
+
+func (fs *failingSearch) Goods() v1.GoodsStore {
+	return fs.goods
+}
+
+type failingGoods struct {
+	v1.GoodsStore
+	err   error
+	calls int
+}
+
+func (g *failingGoods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.GoodsSearchDOList, error) {
+	g.calls++
+	if g.err != nil {
+		return nil, g.err
+	}
+	return g.GoodsStore.Search(ctx, req)
+}
+
+func (g *failingGoods) Suggest(ctx context.Context, prefix string, size int) ([]string, error) {
+	g.calls++
+	if g.err != nil {
+		return nil, g.err
+	}
+	return g.GoodsStore.Suggest(ctx, prefix, size)
+}
+
+// newSearch 主后端和降级后端都是进程内索引，降级后端中有一个商品
+func newSearch(t *testing.T, opts *options.SearchOptions, err error) (v1.SearchFactory, *failingGoods) {
+	t.Helper()
+	primary, e := memory.NewSearchFactory(opts)
+	if e != nil {
+		t.Fatal(e)
+	}
+	fallback, e := memory.NewSearchFactory(opts)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if e := fallback.Goods().Create(context.Background(), &do.GoodsSearchDO{ID: 1, Name: "降级商品", OnSale: true}); e != nil {
+		t.Fatal(e)
+	}
+
+	failing := &failingGoods{GoodsStore: primary.Goods(), err: err}
+	factory, e := NewSearchFactory(&failingSearch{SearchFactory: primary, goods: failing}, fallback, opts)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return factory, failing
+}
+
+func searchOptions(minRequests uint64, retryTimeout time.Duration) *options.SearchOptions {
+	opts := options.NewSearchOptions()
+	opts.BreakerMinRequests = minRequests
+	opts.BreakerStatInterval = time.Second
+	opts.BreakerRetryTimeout = retryTimeout
+	return opts
+}
+
+func TestGoodsSearch(t *testing.T) {
+	// 请求数达不到熔断的下限，只验证单次请求是否降级
+	opts := searchOptions(1000, time.Second)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		err       error
+		wantTotal int64
+		wantCode  int
+	}{
+		{name: "主后端正常", ctx: context.Background(), wantTotal: 0},
+		{name: "主后端出错降级", ctx: context.Background(), err: errors.New("es unavailable"), wantTotal: 1},
+		{name: "参数错误不降级", ctx: context.Background(), err: errors.WithCode(code2.ErrValidation, "bad sort"), wantCode: code2.ErrValidation},
+		{name: "请求取消不降级", ctx: canceled, err: context.Canceled, wantCode: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, failing := newSearch(t, opts, tt.err)
+			ret, err := factory.Goods().Search(tt.ctx, &v1.GoodsFilterRequest{GoodsFilterRequest: &proto.GoodsFilterRequest{KeyWords: "商品"}})
+			if failing.calls != 1 {
+				t.Fatalf("primary calls = %d, want 1", failing.calls)
+			}
+			if tt.wantCode != 0 {
+				if err == nil {
+					t.Fatal("want error, got nil")
+				}
+				if tt.wantCode > 0 && !errors.IsCode(err, tt.wantCode) {
+					t.Fatalf("err = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ret.TotalCount != tt.wantTotal {
+				t.Fatalf("total = %d, want %d", ret.TotalCount, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestGoodsSuggestDegrade(t *testing.T) {
+	factory, _ := newSearch(t, searchOptions(1000, time.Second), errors.New("es unavailable"))
+	names, err := factory.Goods().Suggest(context.Background(), "降级", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "降级商品" {
+		t.Fatalf("names = %v, want [降级商品]", names)
+	}
+}
+
+func TestBreakerOpen(t *testing.T) {
+	const minRequests = 3
+	opts := searchOptions(minRequests, time.Minute)
+	// 统计周期相同时 sentinel 会沿用旧规则的统计数据，换一个周期避免前面用例的请求计入
+	opts.BreakerStatInterval = 2 * time.Second
+	factory, failing := newSearch(t, opts, errors.New("es unavailable"))
+	req := &v1.GoodsFilterRequest{GoodsFilterRequest: &proto.GoodsFilterRequest{KeyWords: "商品"}}
+
+	// 错误比例超过阈值后熔断，之后的请求不再访问主后端，直接走降级后端
+	for i := 0; i < minRequests+2; i++ {
+		ret, err := factory.Goods().Search(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ret.TotalCount != 1 {
+			t.Fatalf("request %d: total = %d, want 1", i, ret.TotalCount)
+		}
+	}
+	if failing.calls != minRequests {
+		t.Fatalf("primary calls = %d, want %d", failing.calls, minRequests)
+	}
+}
@@ -2,13 +2,18 @@ package v1
 
 import (
 	"context"
-	"github.com/apache/rocketmq-client-go/v2/consumer"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 )
 
+// SearchFactory 商品搜索后端，按配置选择 ES、MySQL 或进程内索引
 type SearchFactory interface {
 	Goods() GoodsStore
+	// HotTerms 未开启热搜词统计时返回 nil
+	HotTerms() HotTermStore
+	// EnsureIndex 写入商品索引模板，索引不存在时按模板创建
+	EnsureIndex(ctx context.Context) error
+	// Indices 只有 ES 后端有索引，其他后端返回 nil
+	Indices() IndexManager
+	// Listen 消费商品变更消息同步到搜索后端，不需要同步的后端直接返回
 	Listen(ctx context.Context) error
-	SyncGoodsToES(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error)
 	Close() error
 }
@@ -2,6 +2,7 @@ package es
 
 import (
 	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/hot"
 	"Advanced_Shop/app/goods/srv/internal/domain/do"
 	"Advanced_Shop/app/pkg/options"
 	"Advanced_Shop/gnova/code"
@@ -22,6 +23,7 @@ import (
 
 var (
 	searchFactory v1.SearchFactory
+	initErr       error // 初始化失败的原因，只初始化一次
 	once          sync.Once
 )
 
@@ -30,15 +32,25 @@ type dataSearch struct {
 	consumer  rocketmq.PushConsumer
 	mqOpts    *options.RocketMQOptions
 	canalOpts *options.CanalOptions
-	isRunning bool // 标记消费者是否运行中
-	runLock   sync.RWMutex
+
+	searchOpts *options.SearchOptions
+	features   indexFeatures // EnsureIndex 之后才有值，ES 启动时不可用会在后台重试
+	featuresMu sync.RWMutex
+	hotTerms   v1.HotTermStore // 未开启热搜词统计时为 nil
+	isRunning  bool            // 标记消费者是否运行中
+	runLock    sync.RWMutex
 }
 
 func (ds *dataSearch) Goods() v1.GoodsStore {
 	return newGoods(ds)
 }
 
-func GetSearchFactoryOr(opts *options.EsOptions, mqOpts *options.RocketMQOptions, canalOpts *options.CanalOptions) (v1.SearchFactory, error) {
+func (ds *dataSearch) HotTerms() v1.HotTermStore {
+	return ds.hotTerms
+}
+
+func GetSearchFactoryOr(opts *options.EsOptions, mqOpts *options.RocketMQOptions, canalOpts *options.CanalOptions,
+	searchOpts *options.SearchOptions) (v1.SearchFactory, error) {
 	if opts == nil && searchFactory == nil {
 		return nil, errors.New("failed to get es client")
 	}
@@ -47,15 +59,31 @@ func GetSearchFactoryOr(opts *options.EsOptions, mqOpts *options.RocketMQOptions
 		esOpt := db.EsOptions{
 			Host: opts.Host,
 			Port: opts.Port,
+			// 可以降级时 ES 不可用也要能启动，由熔断器探测恢复
+			NoHealthcheck: searchOpts.Backend == options.SearchBackendES && searchOpts.Fallback,
 		}
 		esClient, err := db.NewEsClient(&esOpt)
 		if err != nil {
 			return
 		}
+		hotTerms, err := hot.NewHotTermsOr(searchOpts)
+		if err != nil {
+			initErr = err
+			return
+		}
 
-		searchFactory = &dataSearch{esClient: esClient, mqOpts: mqOpts, canalOpts: canalOpts}
+		searchFactory = &dataSearch{
+			esClient:   esClient,
+			mqOpts:     mqOpts,
+			canalOpts:  canalOpts,
+			searchOpts: searchOpts,
+			hotTerms:   hotTerms,
+		}
 	})
 	if searchFactory == nil {
+		if initErr != nil {
+			return nil, initErr
+		}
 		return nil, errors.New("failed to get es client")
 	}
 	return searchFactory, nil
@@ -187,14 +215,18 @@ func (c *dataSearch) SyncGoodsToES(ctx context.Context, msgs ...*primitive.Messa
 			return consumer.ConsumeRetryLater, errors.WithCode(code.ErrDecodingJSON, errMsg)
 		}
 
-		// 过滤非商品表消息（防御性校验）
-		if msgBody.Table != c.canalOpts.TableName {
+		// 过滤非商品表消息（防御性校验），只用发件箱时可以不配置 Canal 的表名
+		tableName := c.canalOpts.TableName
+		if tableName == "" {
+			tableName = do.GoodsDO{}.TableName()
+		}
+		if msgBody.Table != tableName {
 			zlog.Warnf("非商品表消息，忽略 table: %v", msgBody.Table)
 			continue
 		}
 
 		// 转换为ES的GoodsSearchDO
-		goodsSearchDO, err := convertToGoodsSearchDO(msgBody.Goods)
+		goodsSearchDO, err := convertToGoodsSearchDO(msgBody.Goods, msgBody.Timestamp)
 		if err != nil {
 			errMsg := fmt.Sprintf("转换商品数据失败, msgID=%s, err=%v", msg.MsgId, err)
 			zlog.Error(errMsg)
@@ -241,7 +273,7 @@ func (c *dataSearch) SyncGoodsToES(ctx context.Context, msgs ...*primitive.Messa
 }
 
 // convertToGoodsSearchDO 将MQ消息中的商品map转换为ES的GoodsSearchDO
-func convertToGoodsSearchDO(goodsMap map[string]interface{}) (*do.GoodsSearchDO, error) {
+func convertToGoodsSearchDO(goodsMap map[string]interface{}, timestamp int64) (*do.GoodsSearchDO, error) {
 	goodsDO := &do.GoodsSearchDO{}
 
 	// 解析ID（必传字段）
@@ -278,6 +310,12 @@ func convertToGoodsSearchDO(goodsMap map[string]interface{}) (*do.GoodsSearchDO,
 		goodsDO.ClickNum = int32(clickNum)
 	}
 
+	// 解析销量
+	if soldNumStr, ok := goodsMap["sold_num"].(string); ok {
+		soldNum, _ := strconv.ParseInt(soldNumStr, 10, 64)
+		goodsDO.SoldNum = int32(soldNum)
+	}
+
 	// 解析收藏数
 	if favNumStr, ok := goodsMap["fav_num"].(string); ok {
 		favNum, _ := strconv.ParseInt(favNumStr, 10, 64)
@@ -296,6 +334,15 @@ func convertToGoodsSearchDO(goodsMap map[string]interface{}) (*do.GoodsSearchDO,
 		goodsDO.ShopPrice = float32(shopPrice)
 	}
 
+	// 解析价格区间，没有 SKU 的商品 max_price 为 0，区间就是店铺价
+	goodsDO.MinPrice, goodsDO.MaxPrice = goodsDO.ShopPrice, goodsDO.ShopPrice
+	if maxPriceStr, ok := goodsMap["max_price"].(string); ok {
+		maxPrice, _ := strconv.ParseFloat(maxPriceStr, 64)
+		if float32(maxPrice) > goodsDO.MaxPrice {
+			goodsDO.MaxPrice = float32(maxPrice)
+		}
+	}
+
 	// 解析商品简介
 	if goodsBrief, ok := goodsMap["goods_brief"].(string); ok {
 		goodsDO.GoodsBrief = goodsBrief
@@ -325,8 +372,15 @@ func convertToGoodsSearchDO(goodsMap map[string]interface{}) (*do.GoodsSearchDO,
 		goodsDO.IsHot = isHot
 	}
 
-	// 时间戳
-	goodsDO.Timestamp = goodsMap["timestamp"].(int64)
+	// 解析上架时间，canal 的 datetime 格式为 2006-01-02 15:04:05
+	if addTimeStr, ok := goodsMap["add_time"].(string); ok {
+		if addTime, err := time.ParseInLocation("2006-01-02 15:04:05", addTimeStr, time.Local); err == nil {
+			goodsDO.AddTime = addTime.Unix()
+		}
+	}
+
+	// 时间戳在消息体顶层，不在商品字段里
+	goodsDO.Timestamp = timestamp
 
 	return goodsDO, nil
 }
@@ -2,6 +2,7 @@ package es
 
 import (
 	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
 	"Advanced_Shop/pkg/errors"
 	"context"
 	"encoding/json"
@@ -14,10 +15,23 @@ import (
 
 type goods struct {
 	esClient *elastic.Client
+	features indexFeatures
 }
 
 func newGoods(ds *dataSearch) *goods {
-	return &goods{esClient: ds.esClient}
+	ds.featuresMu.RLock()
+	defer ds.featuresMu.RUnlock()
+	return &goods{esClient: ds.esClient, features: ds.features}
+}
+
+// withSuggest 按商品名称生成搜索建议，销量作为权重
+func withSuggest(goods *do.GoodsSearchDO) *do.GoodsSearchDO {
+	weight := goods.SoldNum
+	if weight < 0 {
+		weight = 0
+	}
+	goods.Suggest = &do.GoodsSuggest{Input: []string{goods.Name}, Weight: weight}
+	return goods
 }
 
 func NewGoods(esClient *elastic.Client) *goods {
@@ -25,6 +39,7 @@ func NewGoods(esClient *elastic.Client) *goods {
 }
 
 func (g *goods) Create(ctx context.Context, goods *do.GoodsSearchDO) error {
+	withSuggest(goods)
 	_, err := g.esClient.Index().
 		Index(goods.GetIndexName()).
 		Id(strconv.Itoa(int(goods.ID))).
@@ -32,15 +47,22 @@ func (g *goods) Create(ctx context.Context, goods *do.GoodsSearchDO) error {
 		Version(goods.Timestamp). // 传入时间戳版本号
 		VersionType("external").
 		Do(context.TODO())
+	if elastic.IsConflict(err) {
+		return nil // 重复投递的消息，已经写入过
+	}
 	return err
 }
 
 func (g *goods) Delete(ctx context.Context, ID uint64) error {
 	_, err := g.esClient.Delete().Index(do.GoodsSearchDO{}.GetIndexName()).Id(strconv.Itoa(int(ID))).Refresh("true").Do(ctx)
+	if elastic.IsNotFound(err) {
+		return nil // 重复投递的消息，已经删除过
+	}
 	return err
 }
 
 func (g *goods) Update(ctx context.Context, goods *do.GoodsSearchDO) error {
+	withSuggest(goods)
 	_, err := g.esClient.Index().
 		Index(goods.GetIndexName()).
 		Id(strconv.Itoa(int(goods.ID))).
@@ -55,11 +77,35 @@ func (g *goods) Update(ctx context.Context, goods *do.GoodsSearchDO) error {
 	return err
 }
 
+// UpdateOnSale 局部更新上下架状态，不需要整条文档
+func (g *goods) UpdateOnSale(ctx context.Context, ID uint64, onSale bool) error {
+	_, err := g.esClient.Update().
+		Index(do.GoodsSearchDO{}.GetIndexName()).
+		Id(strconv.Itoa(int(ID))).
+		Doc(map[string]interface{}{"on_sale": onSale}).
+		Do(ctx)
+	if elastic.IsNotFound(err) {
+		return nil // 还没同步到 ES，等 canal 同步
+	}
+	return err
+}
+
+// 价格直方图的区间宽度
+const priceFacetInterval = 100
+
 func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.GoodsSearchDOList, error) {
 	//match bool 复合查询
 	q := elastic.NewBoolQuery()
+	// 商城前台只查在售商品，下架（包括售罄自动下架）的不出现在搜索结果中；后台列表不限
+	if req.OnSale != nil {
+		q = q.Filter(elastic.NewTermQuery("on_sale", req.GetOnSale()))
+	}
 	if req.KeyWords != "" {
-		q = q.Must(elastic.NewMultiMatchQuery(req.KeyWords, "name", "goods_brief"))
+		fields := []string{"name", "goods_brief"}
+		if g.features.pinyin {
+			fields = append(fields, "name.pinyin")
+		}
+		q = q.Must(elastic.NewMultiMatchQuery(req.KeyWords, fields...))
 	}
 	if req.IsHot {
 		q = q.Filter(elastic.NewTermQuery("is_hot", req.IsHot))
@@ -68,19 +114,33 @@ func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.Goo
 		q = q.Filter(elastic.NewTermQuery("is_new", req.IsNew))
 	}
 
-	if req.PriceMin > 0 {
-		q = q.Filter(elastic.NewRangeQuery("shop_price").Gte(req.PriceMin))
-	}
-	if req.PriceMax > 0 {
-		q = q.Filter(elastic.NewRangeQuery("shop_price").Lte(req.PriceMax))
+	if req.TopCategoryID > 0 {
+		q = q.Filter(elastic.NewTermsQuery("category_id", req.CategoryIDs...))
 	}
 
+	// 品牌、价格、包邮是筛选项，放到 post_filter 中，聚合时每个筛选项只受其他筛选项影响，
+	// 选中一个品牌后其他品牌仍然会出现在品牌筛选项里
+	facetFilters := map[string]elastic.Query{}
 	if req.BrandID > 0 {
-		q = q.Filter(elastic.NewTermQuery("brands_id", req.BrandID))
+		facetFilters["brands"] = elastic.NewTermQuery("brands_id", req.BrandID)
+	}
+	if req.PriceMin > 0 || req.PriceMax > 0 {
+		price := elastic.NewRangeQuery("shop_price")
+		if req.PriceMin > 0 {
+			price = price.Gte(req.PriceMin)
+		}
+		if req.PriceMax > 0 {
+			price = price.Lte(req.PriceMax)
+		}
+		facetFilters["prices"] = price
+	}
+	if req.ShipFree != nil {
+		facetFilters["ship_free"] = elastic.NewTermQuery("ship_free", req.GetShipFree())
 	}
 
-	if req.TopCategoryID > 0 {
-		q = q.Filter(elastic.NewTermsQuery("category_id", req.CategoryIDs...))
+	sorters, err := searchSorters(req.Sort)
+	if err != nil {
+		return nil, err
 	}
 
 	//分页
@@ -95,10 +155,38 @@ func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.Goo
 		req.PagePerNums = 10
 	}
 
-	res, err := g.esClient.Search().Index(do.GoodsSearchDO{}.
+	search := g.esClient.Search().Index(do.GoodsSearchDO{}.
 		GetIndexName()).Query(q).
+		PostFilter(facetFilter(facetFilters, "")).
+		SortBy(sorters...).
 		From(int(req.Pages-1) * int(req.PagePerNums)).
-		Size(int(req.PagePerNums)).Do(ctx)
+		Size(int(req.PagePerNums))
+
+	search = search.Aggregation("brands", elastic.NewFilterAggregation().
+		Filter(facetFilter(facetFilters, "brands")).
+		SubAggregation("buckets", elastic.NewTermsAggregation().Field("brands_id").Size(20)))
+	search = search.Aggregation("categories", elastic.NewFilterAggregation().
+		Filter(facetFilter(facetFilters, "")).
+		SubAggregation("buckets", elastic.NewTermsAggregation().Field("category_id").Size(20)))
+	search = search.Aggregation("prices", elastic.NewFilterAggregation().
+		Filter(facetFilter(facetFilters, "prices")).
+		SubAggregation("buckets", elastic.NewHistogramAggregation().Field("shop_price").
+			Interval(priceFacetInterval).MinDocCount(1)))
+	search = search.Aggregation("ship_free", elastic.NewFilterAggregation().
+		Filter(facetFilter(facetFilters, "ship_free")).
+		SubAggregation("buckets", elastic.NewTermsAggregation().Field("ship_free")))
+
+	if req.KeyWords != "" {
+		search = search.Highlight(elastic.NewHighlight().
+			Fields(elastic.NewHighlighterField("name"), elastic.NewHighlighterField("goods_brief")).
+			NumOfFragments(0). // 返回整段文本而不是片段
+			PreTags("<em>").PostTags("</em>"))
+	}
+
+	res, err := search.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	var ret do.GoodsSearchDOList
 	ret.TotalCount = res.Hits.TotalHits.Value
@@ -108,9 +196,136 @@ func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.Goo
 		if err != nil {
 			return nil, errors.WithCode(code.ErrEsUnmarshal, err.Error())
 		}
+		if fragments := value.Highlight["name"]; len(fragments) > 0 {
+			goods.NameHighlight = fragments[0]
+		}
+		if fragments := value.Highlight["goods_brief"]; len(fragments) > 0 {
+			goods.BriefHighlight = fragments[0]
+		}
 		ret.Items = append(ret.Items, &goods)
 	}
-	return &ret, err
+	ret.Facets = searchFacets(res.Aggregations)
+	return &ret, nil
+}
+
+// Suggest 中文前缀和拼音前缀各补全一次，合并去重后只保留在售商品
+func (g *goods) Suggest(ctx context.Context, prefix string, size int) ([]string, error) {
+	if !g.features.suggest {
+		return nil, nil
+	}
+	// 下架的商品在结果中过滤，多取一些
+	suggesters := map[string]string{"name": "suggest"}
+	if g.features.pinyin {
+		suggesters["pinyin"] = "suggest.pinyin"
+	}
+	search := g.esClient.Search().Index(do.GoodsSearchDO{}.GetIndexName()).Size(0).
+		FetchSourceContext(elastic.NewFetchSourceContext(true).Include("on_sale"))
+	for name, field := range suggesters {
+		search = search.Suggester(elastic.NewCompletionSuggester(name).
+			Prefix(prefix).Field(field).Size(size * 3).SkipDuplicates(true))
+	}
+	res, err := search.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	seen := map[string]bool{}
+	for _, name := range []string{"name", "pinyin"} {
+		for _, suggestion := range res.Suggest[name] {
+			for _, option := range suggestion.Options {
+				var source struct {
+					OnSale bool `json:"on_sale"`
+				}
+				if err := json.Unmarshal(option.Source, &source); err != nil {
+					return nil, errors.WithCode(code.ErrEsUnmarshal, err.Error())
+				}
+				if !source.OnSale || seen[option.Text] {
+					continue
+				}
+				seen[option.Text] = true
+				ret = append(ret, option.Text)
+				if len(ret) >= size {
+					return ret, nil
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// searchSorters 排序条件，最后按ID排序保证分页稳定
+func searchSorters(sort string) ([]elastic.Sorter, error) {
+	var sorter elastic.Sorter
+	switch sort {
+	case "", v1.SortRelevance:
+		sorter = elastic.NewScoreSort()
+	case v1.SortSales:
+		sorter = elastic.NewFieldSort("sold_num").Desc()
+	case v1.SortPrice:
+		sorter = elastic.NewFieldSort("shop_price").Asc()
+	case v1.SortPriceDesc:
+		sorter = elastic.NewFieldSort("shop_price").Desc()
+	case v1.SortNewest:
+		// 旧文档没有上架时间，排在最后
+		sorter = elastic.NewFieldSort("add_time").Desc().Missing("_last")
+	default:
+		return nil, errors.WithCode(code2.ErrValidation, "不支持的排序方式: %s", sort)
+	}
+	return []elastic.Sorter{sorter, elastic.NewFieldSort("id").Desc()}, nil
+}
+
+// facetFilter 除 exclude 外所有筛选项的组合，exclude 为空时组合全部筛选项
+func facetFilter(filters map[string]elastic.Query, exclude string) elastic.Query {
+	q := elastic.NewBoolQuery()
+	for name, filter := range filters {
+		if name != exclude {
+			q = q.Filter(filter)
+		}
+	}
+	return q
+}
+
+func searchFacets(aggs elastic.Aggregations) *do.GoodsFacets {
+	facets := &do.GoodsFacets{PriceInterval: priceFacetInterval}
+	facets.Brands = termsBuckets(aggs, "brands")
+	facets.Categories = termsBuckets(aggs, "categories")
+	facets.ShipFree = termsBuckets(aggs, "ship_free")
+	if filter, ok := aggs.Filter("prices"); ok {
+		if histogram, ok := filter.Histogram("buckets"); ok {
+			for _, bucket := range histogram.Buckets {
+				facets.Prices = append(facets.Prices, &do.FacetBucket{
+					Key:   int64(bucket.Key),
+					Count: bucket.DocCount,
+				})
+			}
+		}
+	}
+	return facets
+}
+
+func termsBuckets(aggs elastic.Aggregations, name string) []*do.FacetBucket {
+	var buckets []*do.FacetBucket
+	filter, ok := aggs.Filter(name)
+	if !ok {
+		return buckets
+	}
+	terms, ok := filter.Terms("buckets")
+	if !ok {
+		return buckets
+	}
+	for _, bucket := range terms.Buckets {
+		// 数值和布尔字段的 key 解析出来都是 float64，布尔值为 1/0
+		key, ok := bucket.Key.(float64)
+		if !ok {
+			continue
+		}
+		buckets = append(buckets, &do.FacetBucket{
+			Key:   int64(key),
+			Count: bucket.DocCount,
+		})
+	}
+	return buckets
 }
 
 var _ v1.GoodsStore = &goods{}
@@ -0,0 +1,192 @@
+package es
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+)
+
+type indices struct {
+	esClient *elastic.Client
+}
+
+func newIndices(ds *dataSearch) *indices {
+	return &indices{esClient: ds.esClient}
+}
+
+func (ds *dataSearch) Indices() v1.IndexManager {
+	return newIndices(ds)
+}
+
+// versionPrefix 实际索引名为别名加创建时间，例如 goods_index_v20261018150405，也能匹配索引模板
+func versionPrefix() string {
+	return do.GoodsSearchDO{}.GetIndexName() + "_v"
+}
+
+func (i *indices) Create(ctx context.Context) (string, error) {
+	index := versionPrefix() + time.Now().Format("20060102150405")
+	if _, err := i.esClient.CreateIndex(index).Do(ctx); err != nil {
+		return "", errors.WithCode(code2.ErrDatabase, "创建索引 %s 失败: %v", index, err)
+	}
+	return index, nil
+}
+
+func (i *indices) BulkIndex(ctx context.Context, index string, goods []*do.GoodsSearchDO) error {
+	if len(goods) == 0 {
+		return nil
+	}
+	bulk := i.esClient.Bulk()
+	for _, item := range goods {
+		bulk.Add(elastic.NewBulkIndexRequest().
+			Index(index).
+			Id(strconv.Itoa(int(item.ID))).
+			Doc(withSuggest(item)).
+			Version(item.Timestamp). // 和增量同步一样用外部版本号，不会覆盖更新的数据
+			VersionType("external"))
+	}
+	res, err := bulk.Do(ctx)
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, "批量写入索引 %s 失败: %v", index, err)
+	}
+	return bulkError(index, res, "")
+}
+
+func (i *indices) BulkDelete(ctx context.Context, index string, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	bulk := i.esClient.Bulk()
+	for _, id := range ids {
+		bulk.Add(elastic.NewBulkDeleteRequest().Index(index).Id(strconv.FormatUint(id, 10)))
+	}
+	res, err := bulk.Do(ctx)
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, "批量删除索引 %s 的文档失败: %v", index, err)
+	}
+	return bulkError(index, res, "not_found")
+}
+
+// bulkError 汇总批量操作中失败的文档，版本冲突说明已经有更新的数据，ignore 为其他可以忽略的结果
+func bulkError(index string, res *elastic.BulkResponse, ignore string) error {
+	var failed []string
+	for _, item := range res.Failed() {
+		if item.Error == nil {
+			if item.Result == ignore {
+				continue
+			}
+			failed = append(failed, item.Id+": "+item.Result)
+			continue
+		}
+		if item.Error.Type == "version_conflict_engine_exception" {
+			continue
+		}
+		failed = append(failed, item.Id+": "+item.Error.Reason)
+	}
+	if len(failed) > 0 {
+		return errors.WithCode(code.ErrReindexFailed, "索引 %s 有 %d 条文档写入失败: %s", index, len(failed), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func (i *indices) Count(ctx context.Context, index string) (int64, error) {
+	if _, err := i.esClient.Refresh(index).Do(ctx); err != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, "刷新索引 %s 失败: %v", index, err)
+	}
+	count, err := i.esClient.Count(index).Do(ctx)
+	if err != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, "统计索引 %s 失败: %v", index, err)
+	}
+	return count, nil
+}
+
+func (i *indices) SwapAlias(ctx context.Context, index string) ([]string, error) {
+	alias := do.GoodsSearchDO{}.GetIndexName()
+	exists, err := i.esClient.IndexExists(alias).Do(ctx)
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, "查询索引 %s 失败: %v", alias, err)
+	}
+
+	actions := []elastic.AliasAction{elastic.NewAliasAddAction(alias).Index(index)}
+	var previous []string
+	if exists {
+		res, err := i.esClient.Aliases().Index(alias).Do(ctx)
+		if err != nil {
+			return nil, errors.WithCode(code2.ErrDatabase, "查询别名 %s 失败: %v", alias, err)
+		}
+		previous = res.IndicesByAlias(alias)
+		if len(previous) == 0 {
+			// 使用别名之前按别名的名字直接创建的索引，删除后别名才能生效
+			// 索引已经删除，不能用于回滚，所以不作为原索引返回
+			actions = append(actions, elastic.NewAliasRemoveIndexAction(alias))
+		} else {
+			actions = append(actions, elastic.NewAliasRemoveAction(alias).Index(previous...))
+		}
+	}
+
+	if _, err := i.esClient.Alias().Action(actions...).Do(ctx); err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, "切换别名 %s 到 %s 失败: %v", alias, index, err)
+	}
+	return previous, nil
+}
+
+func (i *indices) Delete(ctx context.Context, index string) error {
+	_, err := i.esClient.DeleteIndex(index).Do(ctx)
+	if err != nil && !elastic.IsNotFound(err) {
+		return errors.WithCode(code2.ErrDatabase, "删除索引 %s 失败: %v", index, err)
+	}
+	return nil
+}
+
+func (i *indices) Versions(ctx context.Context) ([]string, error) {
+	names, err := i.esClient.IndexNames()
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, "查询索引列表失败: %v", err)
+	}
+	var versions []string
+	for _, name := range names {
+		if strings.HasPrefix(name, versionPrefix()) {
+			versions = append(versions, name)
+		}
+	}
+	// 版本号是时间，按名字排序就是按创建时间排序
+	sort.Strings(versions)
+	return versions, nil
+}
+
+func (i *indices) MultiGet(ctx context.Context, ids []uint64) (map[int32]*do.GoodsSearchDO, error) {
+	ret := make(map[int32]*do.GoodsSearchDO, len(ids))
+	if len(ids) == 0 {
+		return ret, nil
+	}
+	mget := i.esClient.Mget()
+	for _, id := range ids {
+		mget.Add(elastic.NewMultiGetItem().Index(do.GoodsSearchDO{}.GetIndexName()).Id(strconv.FormatUint(id, 10)))
+	}
+	res, err := mget.Do(ctx)
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, "批量读取商品文档失败: %v", err)
+	}
+	for _, doc := range res.Docs {
+		if !doc.Found {
+			continue
+		}
+		goods := &do.GoodsSearchDO{}
+		if err := json.Unmarshal(doc.Source, goods); err != nil {
+			return nil, errors.WithCode(code.ErrEsUnmarshal, err.Error())
+		}
+		ret[goods.ID] = goods
+	}
+	return ret, nil
+}
+
+var _ v1.IndexManager = &indices{}
@@ -0,0 +1,220 @@
+package es
+
+import (
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/options"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	zlog "Advanced_Shop/pkg/log"
+	"context"
+)
+
+const (
+	goodsTemplateName = "goods_template"
+	// 模板有变化时加一，旧版本模板创建的索引需要重建
+	goodsTemplateVersion = 1
+)
+
+// 中文分词和拼音需要的 ES 插件，所有节点都安装了才使用
+var pinyinPlugins = []string{"analysis-ik", "analysis-pinyin"}
+
+// indexFeatures 当前商品索引支持的功能，从索引 mapping 的 _meta 中读取
+// 没有按模板创建的旧索引没有 completion 字段，不能提供搜索建议
+type indexFeatures struct {
+	suggest bool
+	pinyin  bool
+}
+
+// EnsureIndex 写入商品索引模板，索引不存在时按模板创建
+// 已存在的索引不会因为模板变化而修改 mapping，需要执行 reindex 命令重建索引后才能使用新的分词器
+func (ds *dataSearch) EnsureIndex(ctx context.Context) error {
+	pinyin, err := ds.pinyinAvailable(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = ds.esClient.IndexPutTemplate(goodsTemplateName).BodyJson(goodsIndexTemplate(pinyin)).Do(ctx)
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, "写入商品索引模板失败: %v", err)
+	}
+
+	index := do.GoodsSearchDO{}.GetIndexName()
+	exists, err := ds.esClient.IndexExists(index).Do(ctx)
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, "查询商品索引失败: %v", err)
+	}
+	if !exists {
+		// 第一次启动时创建第一个版本的索引，goods_index 作为别名
+		version, err := ds.Indices().Create(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := ds.Indices().SwapAlias(ctx, version); err != nil {
+			return err
+		}
+		zlog.Infof("按模板创建商品索引 %s, 别名 %s, 拼音分词: %v", version, index, pinyin)
+		ds.setFeatures(indexFeatures{suggest: true, pinyin: pinyin})
+		return nil
+	}
+
+	mappings, err := ds.esClient.GetMapping().Index(index).Do(ctx)
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, "查询商品索引 mapping 失败: %v", err)
+	}
+	features := featuresOf(mappings)
+	ds.setFeatures(features)
+	if !features.suggest {
+		zlog.Warnf("商品索引 %s 不是按模板创建的，搜索建议和拼音搜索不可用，需要执行 reindex 命令重建索引", index)
+	}
+	return nil
+}
+
+func (ds *dataSearch) setFeatures(features indexFeatures) {
+	ds.featuresMu.Lock()
+	defer ds.featuresMu.Unlock()
+	ds.features = features
+}
+
+// pinyinAvailable auto 模式下检查所有节点是否都安装了 ik 和 pinyin 插件
+func (ds *dataSearch) pinyinAvailable(ctx context.Context) (bool, error) {
+	switch ds.searchOpts.Analyzer {
+	case options.SearchAnalyzerIK:
+		return true, nil
+	case options.SearchAnalyzerStandard:
+		return false, nil
+	}
+
+	info, err := ds.esClient.NodesInfo().Metric("plugins").Do(ctx)
+	if err != nil {
+		return false, errors.WithCode(code2.ErrDatabase, "查询 ES 插件失败: %v", err)
+	}
+	for _, node := range info.Nodes {
+		installed := map[string]bool{}
+		for _, plugin := range node.Plugins {
+			installed[plugin.Name] = true
+		}
+		for _, name := range pinyinPlugins {
+			if !installed[name] {
+				zlog.Warnf("ES 节点 %s 没有安装 %s 插件，商品索引使用内置的 cjk 分词", node.Name, name)
+				return false, nil
+			}
+		}
+	}
+	return len(info.Nodes) > 0, nil
+}
+
+func featuresOf(mappings map[string]interface{}) indexFeatures {
+	// 索引可能是别名，返回的 key 是实际的索引名
+	for _, value := range mappings {
+		index, _ := value.(map[string]interface{})
+		mapping, _ := index["mappings"].(map[string]interface{})
+		meta, _ := mapping["_meta"].(map[string]interface{})
+		if meta == nil {
+			return indexFeatures{}
+		}
+		pinyin, _ := meta["pinyin"].(bool)
+		return indexFeatures{suggest: true, pinyin: pinyin}
+	}
+	return indexFeatures{}
+}
+
+// goodsIndexTemplate 商品索引模板，pinyin 为 false 时只使用 ES 内置的分词器
+func goodsIndexTemplate(pinyin bool) map[string]interface{} {
+	analyzer := map[string]interface{}{
+		// 搜索建议按整个商品名称做前缀匹配
+		"goods_suggest": map[string]interface{}{
+			"type":      "custom",
+			"tokenizer": "keyword",
+			"filter":    []string{"lowercase"},
+		},
+	}
+	analysis := map[string]interface{}{"analyzer": analyzer}
+
+	name := map[string]interface{}{"type": "text", "analyzer": "goods_text"}
+	suggest := map[string]interface{}{
+		"type":                         "completion",
+		"analyzer":                     "goods_suggest",
+		"preserve_separators":          false,
+		"preserve_position_increments": true,
+	}
+
+	if pinyin {
+		analyzer["goods_text"] = map[string]interface{}{"type": "custom", "tokenizer": "ik_max_word"}
+		analyzer["goods_search"] = map[string]interface{}{"type": "custom", "tokenizer": "ik_smart"}
+		analyzer["goods_pinyin"] = map[string]interface{}{
+			"type":      "custom",
+			"tokenizer": "ik_max_word",
+			"filter":    []string{"goods_pinyin"},
+		}
+		analyzer["goods_suggest_pinyin"] = map[string]interface{}{
+			"type":      "custom",
+			"tokenizer": "keyword",
+			"filter":    []string{"goods_pinyin"},
+		}
+		// 全拼连写和首字母，例如 手机 -> shouji、sj
+		analysis["filter"] = map[string]interface{}{
+			"goods_pinyin": map[string]interface{}{
+				"type":                      "pinyin",
+				"keep_full_pinyin":          false,
+				"keep_joined_full_pinyin":   true,
+				"keep_first_letter":         true,
+				"keep_original":             false,
+				"limit_first_letter_length": 16,
+				"lowercase":                 true,
+				"remove_duplicated_term":    true,
+			},
+		}
+		name["search_analyzer"] = "goods_search"
+		name["fields"] = map[string]interface{}{
+			"pinyin": map[string]interface{}{"type": "text", "analyzer": "goods_pinyin"},
+		}
+		suggest["fields"] = map[string]interface{}{
+			"pinyin": map[string]interface{}{"type": "completion", "analyzer": "goods_suggest_pinyin"},
+		}
+	} else {
+		// 没有插件时中文按二元组切分，效果不如 ik 但不需要额外安装
+		analyzer["goods_text"] = map[string]interface{}{
+			"type":      "custom",
+			"tokenizer": "standard",
+			"filter":    []string{"cjk_width", "lowercase", "cjk_bigram"},
+		}
+	}
+
+	text := map[string]interface{}{"type": "text", "analyzer": "goods_text"}
+	if pinyin {
+		text["search_analyzer"] = "goods_search"
+	}
+
+	return map[string]interface{}{
+		"index_patterns": []string{do.GoodsSearchDO{}.GetIndexName() + "*"},
+		"settings": map[string]interface{}{
+			"analysis": analysis,
+		},
+		"mappings": map[string]interface{}{
+			"_meta": map[string]interface{}{
+				"template_version": goodsTemplateVersion,
+				"pinyin":           pinyin,
+			},
+			"properties": map[string]interface{}{
+				"id":           map[string]interface{}{"type": "long"},
+				"category_id":  map[string]interface{}{"type": "integer"},
+				"brands_id":    map[string]interface{}{"type": "integer"},
+				"on_sale":      map[string]interface{}{"type": "boolean"},
+				"ship_free":    map[string]interface{}{"type": "boolean"},
+				"is_new":       map[string]interface{}{"type": "boolean"},
+				"is_hot":       map[string]interface{}{"type": "boolean"},
+				"name":         name,
+				"goods_brief":  text,
+				"click_num":    map[string]interface{}{"type": "integer"},
+				"sold_num":     map[string]interface{}{"type": "integer"},
+				"fav_num":      map[string]interface{}{"type": "integer"},
+				"market_price": map[string]interface{}{"type": "float"},
+				"shop_price":   map[string]interface{}{"type": "float"},
+				"min_price":    map[string]interface{}{"type": "float"},
+				"max_price":    map[string]interface{}{"type": "float"},
+				"add_time":     map[string]interface{}{"type": "long"},
+				"timestamp":    map[string]interface{}{"type": "long"},
+				"suggest":      suggest,
+			},
+		},
+	}
+}
@@ -7,6 +7,15 @@ import (
 	"Advanced_Shop/app/goods/srv/internal/domain/do"
 )
 
+// 搜索的排序方式，空字符串按相关度排序
+const (
+	SortRelevance = "relevance"
+	SortSales     = "sales"
+	SortPrice     = "price"
+	SortPriceDesc = "price_desc"
+	SortNewest    = "newest"
+)
+
 type GoodsFilterRequest struct {
 	*proto.GoodsFilterRequest
 	CategoryIDs []interface{}
@@ -16,5 +25,14 @@ type GoodsStore interface {
 	Create(ctx context.Context, goods *do.GoodsSearchDO) error
 	Delete(ctx context.Context, ID uint64) error
 	Update(ctx context.Context, goods *do.GoodsSearchDO) error
+	UpdateOnSale(ctx context.Context, ID uint64, onSale bool) error
 	Search(ctx context.Context, request *GoodsFilterRequest) (*do.GoodsSearchDOList, error)
+	// Suggest 按前缀补全在售商品的名称，安装了拼音插件时也可以输入拼音或首字母
+	Suggest(ctx context.Context, prefix string, size int) ([]string, error)
+}
+
+// HotTermStore 热搜词统计
+type HotTermStore interface {
+	Incr(ctx context.Context, term string) error
+	Top(ctx context.Context, size int) ([]*do.HotTermDO, error)
 }
@@ -0,0 +1,90 @@
+package hot
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/options"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/storage"
+	"context"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// redisTerms 热搜词按天计数，每天一个有序集合，key 为前缀加日期
+// 热搜榜合并最近几天的计数后缓存一段时间，过期后重新合并
+// 默认前缀带 hash tag，集群模式下所有 key 在同一个槽，才能用 ZUNIONSTORE 合并
+type redisTerms struct {
+	client redis.UniversalClient
+	opts   *options.SearchOptions
+}
+
+func NewRedisTerms(client redis.UniversalClient, opts *options.SearchOptions) v1.HotTermStore {
+	return &redisTerms{client: client, opts: opts}
+}
+
+// NewHotTermsOr 未开启热搜词统计时返回 nil，开启时需要先连接 redis
+func NewHotTermsOr(opts *options.SearchOptions) (v1.HotTermStore, error) {
+	if !opts.HotEnable {
+		return nil, nil
+	}
+	client := (&storage.RedisCluster{}).GetClient()
+	if client == nil {
+		return nil, errors.WithCode(code2.ErrConnectDB, "热搜词统计需要先连接redis")
+	}
+	return NewRedisTerms(client, opts), nil
+}
+
+func (rt *redisTerms) dayKey(day time.Time) string {
+	return rt.opts.HotKeyPrefix + day.Format("20060102")
+}
+
+func (rt *redisTerms) Incr(ctx context.Context, term string) error {
+	key := rt.dayKey(time.Now())
+	pipe := rt.client.TxPipeline()
+	pipe.ZIncrBy(ctx, key, 1, term)
+	// 多保留一天，窗口内最早的一天在合并时还在
+	pipe.Expire(ctx, key, time.Duration(rt.opts.HotWindow+1)*24*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.WithCode(code2.ErrDatabase, "记录热搜词失败: %v", err)
+	}
+	return nil
+}
+
+func (rt *redisTerms) Top(ctx context.Context, size int) ([]*do.HotTermDO, error) {
+	topKey := rt.opts.HotKeyPrefix + "top"
+	exists, err := rt.client.Exists(ctx, topKey).Result()
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, "查询热搜榜失败: %v", err)
+	}
+	if exists == 0 {
+		now := time.Now()
+		keys := make([]string, 0, rt.opts.HotWindow)
+		for i := 0; i < rt.opts.HotWindow; i++ {
+			keys = append(keys, rt.dayKey(now.AddDate(0, 0, -i)))
+		}
+		pipe := rt.client.TxPipeline()
+		pipe.ZUnionStore(ctx, topKey, &redis.ZStore{Keys: keys})
+		pipe.Expire(ctx, topKey, rt.opts.HotCacheTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, errors.WithCode(code2.ErrDatabase, "合并热搜词失败: %v", err)
+		}
+	}
+
+	values, err := rt.client.ZRevRangeWithScores(ctx, topKey, 0, int64(size-1)).Result()
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, "查询热搜榜失败: %v", err)
+	}
+	ret := make([]*do.HotTermDO, 0, len(values))
+	for _, value := range values {
+		term, ok := value.Member.(string)
+		if !ok {
+			continue
+		}
+		ret = append(ret, &do.HotTermDO{Term: term, Count: int64(value.Score)})
+	}
+	return ret, nil
+}
+
+var _ v1.HotTermStore = &redisTerms{}
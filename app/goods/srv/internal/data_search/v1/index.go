@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"context"
+)
+
+// IndexManager 商品索引的版本管理
+// 搜索和同步都通过别名 goods_index 访问，别名指向带版本号的实际索引，重建索引后原子切换
+type IndexManager interface {
+	// Create 按模板创建一个新版本的索引，返回索引名
+	Create(ctx context.Context) (string, error)
+	// BulkIndex 批量写入，索引中已有更新版本的文档不算失败
+	BulkIndex(ctx context.Context, index string, goods []*do.GoodsSearchDO) error
+	BulkDelete(ctx context.Context, index string, ids []uint64) error
+	// Count 刷新后统计文档数
+	Count(ctx context.Context, index string) (int64, error)
+	// SwapAlias 原子地把别名切换到 index，返回别名原来指向的索引
+	// 别名原来是一个实际索引（没有使用别名之前创建的）时，在同一个操作中删除该索引，这时没有可以回滚的原索引，返回空
+	SwapAlias(ctx context.Context, index string) ([]string, error)
+	Delete(ctx context.Context, index string) error
+	// Versions 所有版本的索引，旧的在前
+	Versions(ctx context.Context) ([]string, error)
+	// MultiGet 按ID通过别名读取文档，不存在的不返回
+	MultiGet(ctx context.Context, ids []uint64) (map[int32]*do.GoodsSearchDO, error)
+}
@@ -0,0 +1,382 @@
+package memory
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// 和 ES 后端保持一致：价格直方图的区间宽度，品牌和分类筛选项的最大个数
+const (
+	priceFacetInterval = 100
+	termsFacetSize     = 20
+)
+
+// 筛选项的名字，统计某个筛选项时不使用它自己的筛选条件
+const (
+	facetBrands   = "brands"
+	facetPrices   = "prices"
+	facetShipFree = "ship_free"
+)
+
+// 名称中的词比简介中的词更相关
+const (
+	nameWeight  = 2
+	briefWeight = 1
+)
+
+// goods 商品文档和倒排索引，词 -> 商品ID -> 权重
+type goods struct {
+	mu    sync.RWMutex
+	docs  map[int32]*do.GoodsSearchDO
+	terms map[string]map[int32]float64
+}
+
+func newGoods() *goods {
+	return &goods{
+		docs:  map[int32]*do.GoodsSearchDO{},
+		terms: map[string]map[int32]float64{},
+	}
+}
+
+// tokenize 英文和数字按单词切分，中文按单字切分，都转成小写
+func tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func (g *goods) index(doc *do.GoodsSearchDO) {
+	add := func(text string, weight float64) {
+		for _, token := range tokenize(text) {
+			postings, ok := g.terms[token]
+			if !ok {
+				postings = map[int32]float64{}
+				g.terms[token] = postings
+			}
+			postings[doc.ID] += weight
+		}
+	}
+	add(doc.Name, nameWeight)
+	add(doc.GoodsBrief, briefWeight)
+	g.docs[doc.ID] = doc
+}
+
+func (g *goods) unindex(doc *do.GoodsSearchDO) {
+	for _, token := range tokenize(doc.Name + " " + doc.GoodsBrief) {
+		postings := g.terms[token]
+		delete(postings, doc.ID)
+		if len(postings) == 0 {
+			delete(g.terms, token)
+		}
+	}
+	delete(g.docs, doc.ID)
+}
+
+func (g *goods) Create(ctx context.Context, goods *do.GoodsSearchDO) error {
+	return g.Update(ctx, goods)
+}
+
+func (g *goods) Delete(ctx context.Context, ID uint64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if doc, ok := g.docs[int32(ID)]; ok {
+		g.unindex(doc)
+	}
+	return nil
+}
+
+func (g *goods) Update(ctx context.Context, goods *do.GoodsSearchDO) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if old, ok := g.docs[goods.ID]; ok {
+		// 和 ES 的外部版本号一样，旧消息不覆盖更新的数据
+		if goods.Timestamp < old.Timestamp {
+			return nil
+		}
+		g.unindex(old)
+	}
+	doc := *goods
+	g.index(&doc)
+	return nil
+}
+
+func (g *goods) UpdateOnSale(ctx context.Context, ID uint64, onSale bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if doc, ok := g.docs[int32(ID)]; ok {
+		doc.OnSale = onSale
+	}
+	return nil
+}
+
+type hit struct {
+	doc   *do.GoodsSearchDO
+	score float64
+}
+
+func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.GoodsSearchDOList, error) {
+	sortKey, desc, err := searchSort(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	//分页
+	if req.Pages == 0 {
+		req.Pages = 1
+	}
+
+	switch {
+	case req.PagePerNums > 100:
+		req.PagePerNums = 100
+	case req.PagePerNums <= 0:
+		req.PagePerNums = 10
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	// 搜索条件，商城前台只查在售商品，后台列表不限
+	categories := map[int64]bool{}
+	for _, id := range req.CategoryIDs {
+		if value, ok := toInt64(id); ok {
+			categories[value] = true
+		}
+	}
+	var matched []*hit
+	for id, score := range g.match(req.KeyWords) {
+		doc := g.docs[id]
+		if (req.OnSale != nil && doc.OnSale != req.GetOnSale()) || (req.IsHot && !doc.IsHot) || (req.IsNew && !doc.IsNew) {
+			continue
+		}
+		if req.TopCategoryID > 0 && !categories[int64(doc.CategoryID)] {
+			continue
+		}
+		matched = append(matched, &hit{doc: doc, score: score})
+	}
+
+	// 品牌、价格、包邮是筛选项，统计筛选项时不使用它自己的筛选条件
+	facetFilters := map[string]func(doc *do.GoodsSearchDO) bool{}
+	if req.BrandID > 0 {
+		facetFilters[facetBrands] = func(doc *do.GoodsSearchDO) bool { return doc.BrandsID == req.BrandID }
+	}
+	if req.PriceMin > 0 || req.PriceMax > 0 {
+		facetFilters[facetPrices] = func(doc *do.GoodsSearchDO) bool {
+			return (req.PriceMin <= 0 || doc.ShopPrice >= float32(req.PriceMin)) &&
+				(req.PriceMax <= 0 || doc.ShopPrice <= float32(req.PriceMax))
+		}
+	}
+	if req.ShipFree != nil {
+		facetFilters[facetShipFree] = func(doc *do.GoodsSearchDO) bool { return doc.ShipFree == req.GetShipFree() }
+	}
+
+	var hits []*hit
+	for _, h := range matched {
+		if passFilters(h.doc, facetFilters, "") {
+			hits = append(hits, h)
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := sortKey(hits[i]), sortKey(hits[j])
+		if a != b {
+			return (a > b) == desc
+		}
+		return hits[i].doc.ID > hits[j].doc.ID
+	})
+
+	var ret do.GoodsSearchDOList
+	ret.TotalCount = int64(len(hits))
+	from := int(req.Pages-1) * int(req.PagePerNums)
+	for i := from; i < len(hits) && i < from+int(req.PagePerNums); i++ {
+		// 返回副本，调用方修改不影响索引
+		doc := *hits[i].doc
+		ret.Items = append(ret.Items, &doc)
+	}
+
+	ret.Facets = &do.GoodsFacets{
+		PriceInterval: priceFacetInterval,
+		Brands: termsBuckets(facetCounts(matched, facetFilters, facetBrands, func(doc *do.GoodsSearchDO) int64 {
+			return int64(doc.BrandsID)
+		}), termsFacetSize),
+		Categories: termsBuckets(facetCounts(matched, facetFilters, "", func(doc *do.GoodsSearchDO) int64 {
+			return int64(doc.CategoryID)
+		}), termsFacetSize),
+		Prices: histogramBuckets(facetCounts(matched, facetFilters, facetPrices, func(doc *do.GoodsSearchDO) int64 {
+			return int64(math.Floor(float64(doc.ShopPrice)/priceFacetInterval)) * priceFacetInterval
+		})),
+		ShipFree: termsBuckets(facetCounts(matched, facetFilters, facetShipFree, func(doc *do.GoodsSearchDO) int64 {
+			if doc.ShipFree {
+				return 1
+			}
+			return 0
+		}), 0),
+	}
+	return &ret, nil
+}
+
+// match 没有关键词时返回所有商品，有关键词时每个词都要出现，得分为各个词的权重之和
+func (g *goods) match(keyWords string) map[int32]float64 {
+	scores := map[int32]float64{}
+	if strings.TrimSpace(keyWords) == "" {
+		for id := range g.docs {
+			scores[id] = 0
+		}
+		return scores
+	}
+	tokens := tokenize(keyWords)
+	if len(tokens) == 0 {
+		return scores
+	}
+	for id, weight := range g.terms[tokens[0]] {
+		scores[id] = weight
+	}
+	for _, token := range tokens[1:] {
+		postings := g.terms[token]
+		for id := range scores {
+			weight, ok := postings[id]
+			if !ok {
+				delete(scores, id)
+				continue
+			}
+			scores[id] += weight
+		}
+	}
+	return scores
+}
+
+// searchSort 排序字段和方向，相同时按ID倒序保证分页稳定
+func searchSort(sort string) (func(h *hit) float64, bool, error) {
+	switch sort {
+	case "", v1.SortRelevance:
+		return func(h *hit) float64 { return h.score }, true, nil
+	case v1.SortSales:
+		return func(h *hit) float64 { return float64(h.doc.SoldNum) }, true, nil
+	case v1.SortPrice:
+		return func(h *hit) float64 { return float64(h.doc.ShopPrice) }, false, nil
+	case v1.SortPriceDesc:
+		return func(h *hit) float64 { return float64(h.doc.ShopPrice) }, true, nil
+	case v1.SortNewest:
+		return func(h *hit) float64 { return float64(h.doc.AddTime) }, true, nil
+	}
+	return nil, false, errors.WithCode(code2.ErrValidation, "不支持的排序方式: %s", sort)
+}
+
+// passFilters 是否满足除 exclude 外的所有筛选项，exclude 为空时检查全部筛选项
+func passFilters(doc *do.GoodsSearchDO, filters map[string]func(doc *do.GoodsSearchDO) bool, exclude string) bool {
+	for name, filter := range filters {
+		if name != exclude && !filter(doc) {
+			return false
+		}
+	}
+	return true
+}
+
+func facetCounts(hits []*hit, filters map[string]func(doc *do.GoodsSearchDO) bool, exclude string,
+	key func(doc *do.GoodsSearchDO) int64) map[int64]int64 {
+	counts := map[int64]int64{}
+	for _, h := range hits {
+		if passFilters(h.doc, filters, exclude) {
+			counts[key(h.doc)]++
+		}
+	}
+	return counts
+}
+
+// termsBuckets 按数量从多到少，size 为 0 时不限个数
+func termsBuckets(counts map[int64]int64, size int) []*do.FacetBucket {
+	buckets := histogramBuckets(counts)
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return buckets[i].Count > buckets[j].Count
+	})
+	if size > 0 && len(buckets) > size {
+		buckets = buckets[:size]
+	}
+	return buckets
+}
+
+// histogramBuckets 按 key 从小到大
+func histogramBuckets(counts map[int64]int64) []*do.FacetBucket {
+	var buckets []*do.FacetBucket
+	for key, count := range counts {
+		buckets = append(buckets, &do.FacetBucket{Key: key, Count: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Key < buckets[j].Key
+	})
+	return buckets
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case uint64:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// Suggest 按名称前缀补全在售商品，销量高的排在前面
+func (g *goods) Suggest(ctx context.Context, prefix string, size int) ([]string, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	prefix = strings.ToLower(prefix)
+	var docs []*do.GoodsSearchDO
+	for _, doc := range g.docs {
+		if doc.OnSale && strings.HasPrefix(strings.ToLower(doc.Name), prefix) {
+			docs = append(docs, doc)
+		}
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		if docs[i].SoldNum != docs[j].SoldNum {
+			return docs[i].SoldNum > docs[j].SoldNum
+		}
+		return docs[i].ID > docs[j].ID
+	})
+
+	var ret []string
+	seen := map[string]bool{}
+	for _, doc := range docs {
+		if seen[doc.Name] {
+			continue
+		}
+		seen[doc.Name] = true
+		ret = append(ret, doc.Name)
+		if len(ret) >= size {
+			break
+		}
+	}
+	return ret, nil
+}
+
+var _ v1.GoodsStore = &goods{}
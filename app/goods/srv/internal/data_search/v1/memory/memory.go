@@ -0,0 +1,53 @@
+package memory
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/hot"
+	"Advanced_Shop/app/pkg/options"
+	"context"
+)
+
+// dataSearch 进程内的倒排索引，只用于测试和本地开发
+// 数据只来自商品服务自己的写入，不消费商品变更消息，重启后为空
+type dataSearch struct {
+	goods    *goods
+	hotTerms v1.HotTermStore
+}
+
+func NewSearchFactory(searchOpts *options.SearchOptions) (v1.SearchFactory, error) {
+	hotTerms, err := hot.NewHotTermsOr(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &dataSearch{
+		goods:    newGoods(),
+		hotTerms: hotTerms,
+	}, nil
+}
+
+// Goods 索引保存在 goods 中，每次返回同一个实例
+func (ds *dataSearch) Goods() v1.GoodsStore {
+	return ds.goods
+}
+
+func (ds *dataSearch) HotTerms() v1.HotTermStore {
+	return ds.hotTerms
+}
+
+func (ds *dataSearch) EnsureIndex(ctx context.Context) error {
+	return nil
+}
+
+func (ds *dataSearch) Indices() v1.IndexManager {
+	return nil
+}
+
+func (ds *dataSearch) Listen(ctx context.Context) error {
+	return nil
+}
+
+func (ds *dataSearch) Close() error {
+	return nil
+}
+
+var _ v1.SearchFactory = &dataSearch{}
@@ -0,0 +1,217 @@
+package mysql
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 和 ES 后端保持一致：价格直方图的区间宽度，品牌和分类筛选项的最大个数
+const (
+	priceFacetInterval = 100
+	termsFacetSize     = 20
+)
+
+// 筛选项的名字，统计某个筛选项时不使用它自己的筛选条件
+const (
+	facetBrands   = "brands"
+	facetPrices   = "prices"
+	facetShipFree = "ship_free"
+)
+
+type goods struct {
+	db       *gorm.DB
+	fulltext bool
+}
+
+func newGoods(ds *dataSearch) *goods {
+	return &goods{db: ds.db, fulltext: ds.searchOpts.MysqlFulltext}
+}
+
+// Create 商品表就是数据源，写入、删除、上下架都不需要同步
+func (g *goods) Create(ctx context.Context, goods *do.GoodsSearchDO) error {
+	return nil
+}
+
+func (g *goods) Delete(ctx context.Context, ID uint64) error {
+	return nil
+}
+
+func (g *goods) Update(ctx context.Context, goods *do.GoodsSearchDO) error {
+	return nil
+}
+
+func (g *goods) UpdateOnSale(ctx context.Context, ID uint64, onSale bool) error {
+	return nil
+}
+
+func (g *goods) Search(ctx context.Context, req *v1.GoodsFilterRequest) (*do.GoodsSearchDOList, error) {
+	order, err := g.searchOrder(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//分页
+	if req.Pages == 0 {
+		req.Pages = 1
+	}
+
+	switch {
+	case req.PagePerNums > 100:
+		req.PagePerNums = 100
+	case req.PagePerNums <= 0:
+		req.PagePerNums = 10
+	}
+
+	var ret do.GoodsSearchDOList
+	if err := g.where(ctx, req, "").Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+
+	var items []*do.GoodsDO
+	err = g.where(ctx, req, "").Clauses(order).Offset(int(req.Pages-1) * int(req.PagePerNums)).Limit(int(req.PagePerNums)).Find(&items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	for _, item := range items {
+		ret.Items = append(ret.Items, do.NewGoodsSearchDO(item))
+	}
+
+	ret.Facets, err = g.searchFacets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// where 搜索条件加上除 exclude 外的筛选项，exclude 为空时加上全部筛选项
+// 和 ES 后端的 post_filter 一样，选中一个品牌后其他品牌仍然会出现在品牌筛选项里
+func (g *goods) where(ctx context.Context, req *v1.GoodsFilterRequest, exclude string) *gorm.DB {
+	// 商城前台只查在售商品，下架（包括售罄自动下架）的不出现在搜索结果中；后台列表不限
+	query := g.db.WithContext(ctx).Model(&do.GoodsDO{})
+	if req.OnSale != nil {
+		query = query.Where("on_sale = ?", req.GetOnSale())
+	}
+	if req.KeyWords != "" {
+		if g.fulltext {
+			query = query.Where("MATCH(name, goods_brief) AGAINST(? IN NATURAL LANGUAGE MODE)", req.KeyWords)
+		} else {
+			// 没有分词，每个词都要出现在名称或简介中
+			for _, word := range strings.Fields(req.KeyWords) {
+				like := "%" + escapeLike(word) + "%"
+				query = query.Where("(name LIKE ? OR goods_brief LIKE ?)", like, like)
+			}
+		}
+	}
+	if req.IsHot {
+		query = query.Where("is_hot = ?", true)
+	}
+	if req.IsNew {
+		query = query.Where("is_new = ?", true)
+	}
+	if req.TopCategoryID > 0 {
+		query = query.Where("category_id IN ?", req.CategoryIDs)
+	}
+
+	if req.BrandID > 0 && exclude != facetBrands {
+		query = query.Where("brands_id = ?", req.BrandID)
+	}
+	if exclude != facetPrices {
+		if req.PriceMin > 0 {
+			query = query.Where("shop_price >= ?", req.PriceMin)
+		}
+		if req.PriceMax > 0 {
+			query = query.Where("shop_price <= ?", req.PriceMax)
+		}
+	}
+	if req.ShipFree != nil && exclude != facetShipFree {
+		query = query.Where("ship_free = ?", req.GetShipFree())
+	}
+	return query
+}
+
+// searchOrder 排序条件，最后按ID排序保证分页稳定
+// 用 LIKE 匹配时没有相关度，按相关度排序时改为按销量排序
+func (g *goods) searchOrder(req *v1.GoodsFilterRequest) (clause.OrderBy, error) {
+	var order clause.Expr
+	switch req.Sort {
+	case "", v1.SortRelevance:
+		order.SQL = "sold_num DESC"
+		if g.fulltext && req.KeyWords != "" {
+			order.SQL = "MATCH(name, goods_brief) AGAINST(? IN NATURAL LANGUAGE MODE) DESC"
+			order.Vars = []interface{}{req.KeyWords}
+		}
+	case v1.SortSales:
+		order.SQL = "sold_num DESC"
+	case v1.SortPrice:
+		order.SQL = "shop_price ASC"
+	case v1.SortPriceDesc:
+		order.SQL = "shop_price DESC"
+	case v1.SortNewest:
+		order.SQL = "add_time DESC"
+	default:
+		return clause.OrderBy{}, errors.WithCode(code2.ErrValidation, "不支持的排序方式: %s", req.Sort)
+	}
+	order.SQL += ", id DESC"
+	order.WithoutParentheses = true
+	return clause.OrderBy{Expression: order}, nil
+}
+
+func (g *goods) searchFacets(ctx context.Context, req *v1.GoodsFilterRequest) (*do.GoodsFacets, error) {
+	facets := &do.GoodsFacets{PriceInterval: priceFacetInterval}
+	groups := []struct {
+		buckets *[]*do.FacetBucket
+		exclude string
+		key     string
+		order   string
+		limit   int
+	}{
+		{&facets.Brands, facetBrands, "brands_id", "count DESC", termsFacetSize},
+		{&facets.Categories, "", "category_id", "count DESC", termsFacetSize},
+		{&facets.Prices, facetPrices, fmt.Sprintf("CAST(FLOOR(shop_price / %d) * %d AS SIGNED)", priceFacetInterval, priceFacetInterval), "`key`", -1},
+		{&facets.ShipFree, facetShipFree, "ship_free", "count DESC", -1},
+	}
+	for _, group := range groups {
+		err := g.where(ctx, req, group.exclude).
+			Select(group.key + " AS `key`, COUNT(*) AS count").
+			Group("`key`").
+			Order(group.order).
+			Limit(group.limit).
+			Scan(group.buckets).Error
+		if err != nil {
+			return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+		}
+	}
+	return facets, nil
+}
+
+// Suggest 按名称前缀补全在售商品，销量高的排在前面
+func (g *goods) Suggest(ctx context.Context, prefix string, size int) ([]string, error) {
+	var names []string
+	err := g.db.WithContext(ctx).Model(&do.GoodsDO{}).
+		Where("on_sale = ? AND name LIKE ?", true, escapeLike(prefix)+"%").
+		Group("name").
+		Order("MAX(sold_num) DESC").
+		Limit(size).
+		Pluck("name", &names).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return names, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike 关键词中的通配符按普通字符匹配
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+var _ v1.GoodsStore = &goods{}
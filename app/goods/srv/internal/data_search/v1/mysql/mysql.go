@@ -0,0 +1,56 @@
+package mysql
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/hot"
+	"Advanced_Shop/app/pkg/options"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// dataSearch 直接查询商品表的搜索后端，MySQL 本身就是数据源，不需要同步和维护索引
+// 没有分词和拼音，只用于 ES 不可用时降级或不部署 ES 的环境
+type dataSearch struct {
+	db         *gorm.DB
+	searchOpts *options.SearchOptions
+	hotTerms   v1.HotTermStore
+}
+
+func NewSearchFactory(db *gorm.DB, searchOpts *options.SearchOptions) (v1.SearchFactory, error) {
+	hotTerms, err := hot.NewHotTermsOr(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &dataSearch{
+		db:         db,
+		searchOpts: searchOpts,
+		hotTerms:   hotTerms,
+	}, nil
+}
+
+func (ds *dataSearch) Goods() v1.GoodsStore {
+	return newGoods(ds)
+}
+
+func (ds *dataSearch) HotTerms() v1.HotTermStore {
+	return ds.hotTerms
+}
+
+func (ds *dataSearch) EnsureIndex(ctx context.Context) error {
+	return nil
+}
+
+func (ds *dataSearch) Indices() v1.IndexManager {
+	return nil
+}
+
+func (ds *dataSearch) Listen(ctx context.Context) error {
+	return nil
+}
+
+func (ds *dataSearch) Close() error {
+	return nil
+}
+
+var _ v1.SearchFactory = &dataSearch{}
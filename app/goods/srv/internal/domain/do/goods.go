@@ -23,16 +23,82 @@ type GoodsSearchDO struct {
 	MarketPrice float32 `json:"market_price"`
 	GoodsBrief  string  `json:"goods_brief"`
 	ShopPrice   float32 `json:"shop_price"`
+	MinPrice    float32 `json:"min_price"` // 所有 SKU 的价格区间，没有 SKU 时都等于 ShopPrice
+	MaxPrice    float32 `json:"max_price"`
+	AddTime     int64   `json:"add_time"`  // 上架时间（秒），按最新排序用
 	Timestamp   int64   `json:"timestamp"` // MySQL执行时间戳=版本号
+
+	Suggest *GoodsSuggest `json:"suggest,omitempty"` // 搜索建议，写入 ES 时按商品名称生成
+
+	// 搜索时的关键词高亮，不写入索引
+	NameHighlight  string `json:"-"`
+	BriefHighlight string `json:"-"`
 }
 
 func (GoodsSearchDO) GetIndexName() string {
 	return "goods_index"
 }
 
+// NewGoodsSearchDO 全量重建索引时由 MySQL 的商品生成 ES 文档，版本号和发件箱事件一样用 update_time
+func NewGoodsSearchDO(goods *GoodsDO) *GoodsSearchDO {
+	searchDO := &GoodsSearchDO{
+		ID:          goods.ID,
+		CategoryID:  goods.CategoryID,
+		BrandsID:    goods.BrandsID,
+		OnSale:      goods.OnSale != nil && *goods.OnSale,
+		ShipFree:    goods.ShipFree != nil && *goods.ShipFree,
+		IsNew:       goods.IsNew != nil && *goods.IsNew,
+		IsHot:       goods.IsHot != nil && *goods.IsHot,
+		Name:        goods.Name,
+		ClickNum:    goods.ClickNum,
+		SoldNum:     goods.SoldNum,
+		FavNum:      goods.FavNum,
+		MarketPrice: goods.MarketPrice,
+		GoodsBrief:  goods.GoodsBrief,
+		ShopPrice:   goods.ShopPrice,
+		MinPrice:    goods.ShopPrice,
+		MaxPrice:    goods.ShopPrice,
+		AddTime:     goods.CreatedAt.Unix(),
+		Timestamp:   goods.UpdatedAt.UnixMilli(),
+	}
+	if goods.MaxPrice > searchDO.MaxPrice {
+		searchDO.MaxPrice = goods.MaxPrice
+	}
+	return searchDO
+}
+
+// GoodsSuggest ES completion 字段，销量高的商品排在前面
+type GoodsSuggest struct {
+	Input  []string `json:"input"`
+	Weight int32    `json:"weight"`
+}
+
+// HotTermDO 热搜词和最近几天的搜索次数
+type HotTermDO struct {
+	Term  string `json:"term"`
+	Count int64  `json:"count"`
+}
+
 type GoodsSearchDOList struct {
 	TotalCount int64            `json:"totalCount,omitempty"`
 	Items      []*GoodsSearchDO `json:"items"`
+	Facets     *GoodsFacets     `json:"facets,omitempty"`
+}
+
+// FacetBucket 搜索结果的聚合桶，Name 由服务层按 Key 补全
+type FacetBucket struct {
+	Key   int64  `json:"key"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// GoodsFacets 搜索结果的筛选项，统计的是命中的全部商品，不只是当前页
+type GoodsFacets struct {
+	Brands        []*FacetBucket `json:"brands"`
+	Categories    []*FacetBucket `json:"categories"`
+	Prices        []*FacetBucket `json:"prices"` // Key 为价格区间下限
+	PriceInterval int64          `json:"price_interval"`
+	ShipFree      []*FacetBucket `json:"ship_free"` // Key 为 1 包邮，0 不包邮
 }
 
 type GoodsDO struct {
@@ -44,7 +110,9 @@ type GoodsDO struct {
 	BrandsID int32     `gorm:"type:int;not null;comment:品牌ID（逻辑外键）;index:idx_goods_brand"`
 	Brands   *BrandsDO `gorm:"foreignKey:BrandsID;references:ID;constraint:<-:false,foreignKey:no action"`
 
-	OnSale      *bool   `gorm:"default:false;not null;comment:是否上架"`
+	OnSale *bool `gorm:"default:false;not null;comment:是否上架"`
+	// 售罄时由库存告警自动下架的商品，补货后只重新上架这些商品，人工修改上下架状态时清除
+	SoldOut     *bool   `gorm:"default:false;not null;comment:是否售罄自动下架"`
 	ShipFree    *bool   `gorm:"default:false;not null;comment:是否包邮"`
 	IsNew       *bool   `gorm:"default:false;not null;comment:是否新品"`
 	IsHot       *bool   `gorm:"default:false;not null;comment:是否热销"`
@@ -56,6 +124,12 @@ type GoodsDO struct {
 	MarketPrice float32 `gorm:"not null;comment:市场价"`
 	ShopPrice   float32 `gorm:"not null;comment:售价;index:idx_goods_price"`
 	GoodsBrief  string  `gorm:"type:varchar(100);not null;comment:商品简介"`
+	Merchant    int32   `gorm:"type:int;default:0;not null;comment:所属商家ID，0 为自营;index:idx_goods_merchant"`
+	// 每单限购数量，0 为不限购；指针区分更新时没有传和改为不限购
+	PurchaseLimit *int32 `gorm:"type:int;default:0;not null;comment:每单限购数量，0 为不限购"`
+	// 有 SKU 时 ShopPrice 为可售 SKU 的最低价，由 SKU 变更时维护
+	HasSku   *bool   `gorm:"default:false;not null;comment:是否有SKU，有SKU时按SKU下单"`
+	MaxPrice float32 `gorm:"default:0;not null;comment:SKU最高售价，没有SKU时为0"`
 
 	// 方便查询商品的所有图片（Gorm虚拟字段，不存数据库）
 	Images []*GoodsImageModel `gorm:"foreignKey:GoodsID;references:ID;constraint:<-:false,foreignKey:no action"`
@@ -0,0 +1,98 @@
+package do
+
+import (
+	gorm2 "Advanced_Shop/app/pkg/gorm"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SpecificationDO 分类下的规格属性，例如 颜色: [红, 蓝]，同一分类的商品共用
+type SpecificationDO struct {
+	gorm2.Model
+	CategoryID int32    `gorm:"type:int;not null;comment:分类ID（逻辑外键）;index:idx_spec_category"`
+	Name       string   `gorm:"type:varchar(20);not null;comment:规格名称"`
+	Values     GormList `gorm:"type:varchar(1000);not null;comment:可选值"`
+	Sort       int32    `gorm:"type:int;default:0;not null;comment:展示顺序"`
+}
+
+func (SpecificationDO) TableName() string {
+	return "specification_models"
+}
+
+// Has 判断 value 是否是规格的可选值
+func (s *SpecificationDO) Has(value string) bool {
+	for _, v := range s.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type SpecificationDOList struct {
+	TotalCount int64              `json:"totalCount,omitempty"`
+	Items      []*SpecificationDO `json:"items"`
+}
+
+// SkuSpec SKU 在一个规格属性上的取值
+type SkuSpec struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SkuSpecs 按规格的展示顺序排列
+type SkuSpecs []SkuSpec
+
+func (s SkuSpecs) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan 规格列可能为 NULL，驱动也可能把 json 列返回为字符串
+func (s *SkuSpecs) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("不支持的规格取值类型 %T", value)
+	}
+}
+
+// Text 规格描述，例如 "颜色:红 尺码:L"，同一商品下不能重复
+func (s SkuSpecs) Text() string {
+	parts := make([]string, 0, len(s))
+	for _, spec := range s {
+		parts = append(parts, spec.Name+":"+spec.Value)
+	}
+	return strings.Join(parts, " ")
+}
+
+// GoodsSkuDO 商品的一个销售规格，有自己的售价和条码，库存由库存服务按规格记录
+type GoodsSkuDO struct {
+	gorm2.Model
+	GoodsID     int32    `gorm:"type:int;not null;comment:商品ID（逻辑外键）;index:idx_sku_goods"`
+	SkuSn       string   `gorm:"type:varchar(50);not null;comment:SKU编号;uniqueIndex:idx_sku_sn"`
+	Barcode     string   `gorm:"type:varchar(50);default:'';not null;comment:条形码;index:idx_sku_barcode"`
+	Specs       SkuSpecs `gorm:"type:json;comment:规格取值"`
+	Price       float32  `gorm:"not null;comment:售价"`
+	MarketPrice float32  `gorm:"default:0;not null;comment:市场价"`
+	OnSale      *bool    `gorm:"default:true;not null;comment:是否可售"`
+	Image       string   `gorm:"type:varchar(200);default:'';not null;comment:规格图片"`
+	// 规格的限购数量，0 时按商品的限购数量
+	PurchaseLimit *int32 `gorm:"type:int;default:0;not null;comment:每单限购数量，0 时按商品的限购数量"`
+}
+
+func (GoodsSkuDO) TableName() string {
+	return "goods_sku_models"
+}
+
+type GoodsSkuDOList struct {
+	TotalCount int64         `json:"totalCount,omitempty"`
+	Items      []*GoodsSkuDO `json:"items"`
+}
@@ -0,0 +1,33 @@
+package do
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkuSpecsScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    SkuSpecs
+		wantErr bool
+	}{
+		{name: "NULL", value: nil, want: nil},
+		{name: "字节", value: []byte(`[{"name":"颜色","value":"红"}]`), want: SkuSpecs{{Name: "颜色", Value: "红"}}},
+		{name: "字符串", value: `[{"name":"尺码","value":"L"}]`, want: SkuSpecs{{Name: "尺码", Value: "L"}}},
+		{name: "不支持的类型", value: 1, wantErr: true},
+		{name: "损坏的 json", value: []byte(`[{`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specs := SkuSpecs{{Name: "旧", Value: "值"}}
+			err := specs.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(specs, tt.want) {
+				t.Fatalf("specs = %+v, want %+v", specs, tt.want)
+			}
+		})
+	}
+}
@@ -4,9 +4,19 @@ import "Advanced_Shop/app/goods/srv/internal/domain/do"
 
 type GoodsDTO struct {
 	do.GoodsDO
+	// 搜索关键词高亮，只有搜索结果有
+	NameHighlight  string
+	BriefHighlight string
+}
+
+// SuggestDTO 搜索框的下拉提示，没有输入时只返回热搜词
+type SuggestDTO struct {
+	Suggestions []string
+	HotTerms    []*do.HotTermDO
 }
 
 type GoodsDTOList struct {
-	TotalCount int         `json:"total_count,omitempty"`
-	Items      []*GoodsDTO `json:"data"`
+	TotalCount int             `json:"total_count,omitempty"`
+	Items      []*GoodsDTO     `json:"data"`
+	Facets     *do.GoodsFacets `json:"facets,omitempty"`
 }
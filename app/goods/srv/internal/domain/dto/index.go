@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// ReindexDTO 全量重建索引的结果
+type ReindexDTO struct {
+	Index    string        // 新索引
+	Indexed  int64         // 全量写入的商品数
+	CaughtUp int64         // 重建期间有修改、切换别名后补写的商品数
+	Previous []string      // 别名原来指向的索引，保留用于回滚；第一次从没有别名的旧索引切换时旧索引已删除，为空
+	Deleted  []string      // 清理掉的更早版本的索引
+	Elapsed  time.Duration // 耗时
+}
+
+// GoodsDrift 抽样商品在 ES 中和 MySQL 不一致的字段
+type GoodsDrift struct {
+	ID     int32
+	Fields []string
+}
+
+// IndexCheckDTO MySQL 和 ES 的一致性检查结果
+type IndexCheckDTO struct {
+	MysqlCount int64
+	EsCount    int64
+	Sampled    int
+	Missing    []int32 // MySQL 中有，ES 中没有
+	Stale      []*GoodsDrift
+}
+
+// Drifted 数量不一致或抽样中有不一致的商品
+func (c *IndexCheckDTO) Drifted() bool {
+	return c.MysqlCount != c.EsCount || len(c.Missing) > 0 || len(c.Stale) > 0
+}
@@ -27,6 +27,27 @@ type GoodUpdateServiceMap struct {
 	IsNew       *bool   `structs:"is_new"`
 	IsHot       *bool   `structs:"is_hot"`
 	OnSale      *bool   `structs:"on_sale"`
+	SoldOut     *bool   `structs:"sold_out"`
 	CategoryId  int32   `structs:"category_id"`
 	Brand       int32   `structs:"brands_id"`
+	Merchant    int32   `structs:"merchant"`
+	// 指针类型，改为 0（不限购）时也会更新
+	PurchaseLimit *int32 `structs:"purchase_limit"`
+}
+
+// SpecificationUpdateServiceMap 可选值单独更新，需要按 json 存储
+type SpecificationUpdateServiceMap struct {
+	Name string `structs:"name"`
+	Sort int32  `structs:"sort"`
+}
+
+type SkuUpdateServiceMap struct {
+	SkuSn       string  `structs:"sku_sn"`
+	Barcode     string  `structs:"barcode"`
+	Price       float32 `structs:"price"`
+	MarketPrice float32 `structs:"market_price"`
+	OnSale      *bool   `structs:"on_sale"`
+	Image       string  `structs:"image"`
+	// 指针类型，改为 0（按商品限购）时也会更新
+	PurchaseLimit *int32 `structs:"purchase_limit"`
 }
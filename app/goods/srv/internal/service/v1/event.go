@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/outbox"
+	"context"
+	"fmt"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+)
+
+// 商品变更事件的消息格式和 Canal 转发的 binlog 消息一致，ES 消费者不需要区分消息来源
+// 开启发件箱后事件和商品数据在同一个事务中写入，可以不再部署 Canal
+
+const (
+	goodsEventInsert = "INSERT"
+	goodsEventUpdate = "UPDATE"
+	goodsEventDelete = "DELETE"
+)
+
+// goodsEvent 构建商品变更事件，timestamp 作为 ES 的外部版本号
+func goodsEvent(topic, eventType string, goods *do.GoodsDO) outbox.Event {
+	timestamp := goods.UpdatedAt.UnixMilli()
+	if eventType == goodsEventDelete || goods.UpdatedAt.IsZero() {
+		timestamp = time.Now().UnixMilli()
+	}
+	return outbox.Event{
+		Topic: topic,
+		Type:  eventType,
+		Key:   fmt.Sprintf("goods_%d", goods.ID),
+		Payload: map[string]interface{}{
+			"event_type": eventType,
+			"table":      goods.TableName(),
+			"goods":      goodsColumns(goods),
+			"timestamp":  timestamp,
+		},
+	}
+}
+
+// goodsColumns 和 Canal 一样把列值都转为字符串
+func goodsColumns(goods *do.GoodsDO) map[string]string {
+	return map[string]string{
+		"id":           strconv.Itoa(int(goods.ID)),
+		"category_id":  strconv.Itoa(int(goods.CategoryID)),
+		"brands_id":    strconv.Itoa(int(goods.BrandsID)),
+		"name":         goods.Name,
+		"click_num":    strconv.Itoa(int(goods.ClickNum)),
+		"sold_num":     strconv.Itoa(int(goods.SoldNum)),
+		"fav_num":      strconv.Itoa(int(goods.FavNum)),
+		"market_price": strconv.FormatFloat(float64(goods.MarketPrice), 'f', -1, 32),
+		"shop_price":   strconv.FormatFloat(float64(goods.ShopPrice), 'f', -1, 32),
+		"max_price":    strconv.FormatFloat(float64(goods.MaxPrice), 'f', -1, 32),
+		"goods_brief":  goods.GoodsBrief,
+		"on_sale":      formatBool(goods.OnSale),
+		"ship_free":    formatBool(goods.ShipFree),
+		"is_new":       formatBool(goods.IsNew),
+		"is_hot":       formatBool(goods.IsHot),
+		"add_time":     goods.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func formatBool(b *bool) string {
+	return strconv.FormatBool(b != nil && *b)
+}
+
+// writeEvent 在事务中读取商品的最新数据并写入发件箱，未开启发件箱时不写
+func (gs *goodsService) writeEvent(ctx context.Context, txn *gorm.DB, eventType string, ID uint64) error {
+	if !gs.events.Enabled() {
+		return nil
+	}
+	goods, err := gs.data.NewMysql().Goods().GetInTxn(ctx, txn, ID)
+	if err != nil {
+		return err
+	}
+	return gs.events.Write(ctx, txn, goodsEvent(gs.eventTopic, eventType, goods))
+}
@@ -2,11 +2,17 @@ package v1
 
 import (
 	proto "Advanced_Shop/api/goods/v1"
+	ipbv1 "Advanced_Shop/api/inventory/v1"
 	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
 	v12 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
 	"Advanced_Shop/app/goods/srv/internal/domain/do"
 	"Advanced_Shop/app/goods/srv/internal/domain/dto"
+	"Advanced_Shop/app/pkg/message"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/app/pkg/outbox"
 	"context"
+	"fmt"
+	"gorm.io/gorm"
 	"sync"
 
 	metav1 "Advanced_Shop/pkg/common/meta/v1"
@@ -37,6 +43,12 @@ type GoodsSrv interface {
 
 	// BatchGet 批量查询商品
 	BatchGet(ctx context.Context, ids []uint64) ([]*dto.GoodsDTO, error)
+
+	// HandleStockAlert 处理库存服务的告警事件，售罄时自动下架，补货后重新上架
+	HandleStockAlert(ctx context.Context, msg *message.StockAlertMessage) error
+
+	// Suggest 搜索建议和热搜词
+	Suggest(ctx context.Context, keyWords string, size int) (*dto.SuggestDTO, error)
 }
 
 type goodsService struct {
@@ -45,12 +57,19 @@ type goodsService struct {
 
 	searchData v12.SearchFactory // 搜索层（ES）
 
+	events     outbox.Writer // 发件箱，商品变更事件
+	eventTopic string
+
+	searchOpts *options.SearchOptions
 }
 
 func newGoods(srv *serviceFactory) GoodsSrv {
 	return &goodsService{
 		data:       srv.data,
 		searchData: srv.dataSearch,
+		events:     srv.events,
+		eventTopic: srv.eventTopic,
+		searchOpts: srv.searchOpts,
 	}
 }
 
@@ -91,6 +110,10 @@ func (gs *goodsService) List(ctx context.Context, opts metav1.ListMeta, req *pro
 		log.Errorf("serachdata.NewMysql().Search err: %v", err)
 		return nil, err
 	}
+	// 翻页不重复计数，统计失败不影响搜索
+	if req.Pages <= 1 {
+		gs.recordHotTerm(ctx, req.KeyWords)
+	}
 
 	log.Debugf("Search es data: %v", goodsList)
 
@@ -107,14 +130,99 @@ func (gs *goodsService) List(ctx context.Context, opts metav1.ListMeta, req *pro
 	}
 	var ret dto.GoodsDTOList
 	ret.TotalCount = int(goodsList.TotalCount)
-	for _, value := range goods.Items {
-		ret.Items = append(ret.Items, &dto.GoodsDTO{
-			GoodsDO: *value,
-		})
+	if len(orderby) > 0 {
+		for _, value := range goods.Items {
+			ret.Items = append(ret.Items, &dto.GoodsDTO{
+				GoodsDO: *value,
+			})
+		}
+	} else {
+		// in 查询不保证顺序，按 ES 返回的顺序（相关度、销量、价格等）重新排列，
+		// ES 中有而 MySQL 中已删除的商品直接跳过
+		goodsMap := make(map[int32]*do.GoodsDO, len(goods.Items))
+		for _, value := range goods.Items {
+			goodsMap[value.ID] = value
+		}
+		for _, hit := range goodsList.Items {
+			value, ok := goodsMap[hit.ID]
+			if !ok {
+				continue
+			}
+			ret.Items = append(ret.Items, &dto.GoodsDTO{
+				GoodsDO:        *value,
+				NameHighlight:  hit.NameHighlight,
+				BriefHighlight: hit.BriefHighlight,
+			})
+		}
+	}
+
+	if goodsList.Facets != nil {
+		if err := gs.facetNames(ctx, goodsList.Facets); err != nil {
+			return nil, err
+		}
+		ret.Facets = goodsList.Facets
 	}
 	return &ret, nil
 }
 
+// facetNames 补全品牌和分类筛选项的名称，已删除的品牌或分类不再作为筛选项
+func (gs *goodsService) facetNames(ctx context.Context, facets *do.GoodsFacets) error {
+	var brandIDs, categoryIDs []uint64
+	for _, bucket := range facets.Brands {
+		brandIDs = append(brandIDs, uint64(bucket.Key))
+	}
+	for _, bucket := range facets.Categories {
+		categoryIDs = append(categoryIDs, uint64(bucket.Key))
+	}
+
+	brands, err := gs.data.NewMysql().Brands().ListByIDs(ctx, brandIDs)
+	if err != nil {
+		log.Errorf("data.NewMysql().Brands().ListByIDs err: %v", err)
+		return err
+	}
+	brandNames := make(map[int64]string, len(brands))
+	for _, brand := range brands {
+		brandNames[int64(brand.ID)] = brand.Name
+	}
+
+	categorys, err := gs.data.NewMysql().Categorys().ListByIDs(ctx, categoryIDs)
+	if err != nil {
+		log.Errorf("data.NewMysql().Categorys().ListByIDs err: %v", err)
+		return err
+	}
+	categoryNames := make(map[int64]string, len(categorys))
+	for _, category := range categorys {
+		categoryNames[int64(category.ID)] = category.Name
+	}
+
+	facets.Brands = namedBuckets(facets.Brands, brandNames)
+	facets.Categories = namedBuckets(facets.Categories, categoryNames)
+	for _, bucket := range facets.ShipFree {
+		if bucket.Key == 1 {
+			bucket.Name = "包邮"
+		} else {
+			bucket.Name = "不包邮"
+		}
+	}
+	for _, bucket := range facets.Prices {
+		bucket.Name = fmt.Sprintf("%d-%d", bucket.Key, bucket.Key+facets.PriceInterval)
+	}
+	return nil
+}
+
+func namedBuckets(buckets []*do.FacetBucket, names map[int64]string) []*do.FacetBucket {
+	var ret []*do.FacetBucket
+	for _, bucket := range buckets {
+		name, ok := names[bucket.Key]
+		if !ok {
+			continue
+		}
+		bucket.Name = name
+		ret = append(ret, bucket)
+	}
+	return ret
+}
+
 func (gs *goodsService) Get(ctx context.Context, ID uint64) (*dto.GoodsDTO, error) {
 	goods, err := gs.data.NewMysql().Goods().Get(ctx, ID)
 	if err != nil {
@@ -163,10 +271,14 @@ func (gs *goodsService) Create(ctx context.Context, goods *v1.GoodsInfo) error {
 		BrandsID:    model.BrandsID,
 		Name:        model.Name,
 		ClickNum:    model.ClickNum,
+		SoldNum:     model.SoldNum,
 		FavNum:      model.FavNum,
 		MarketPrice: model.MarketPrice,
 		GoodsBrief:  model.GoodsBrief,
 		ShopPrice:   model.ShopPrice,
+		MinPrice:    model.ShopPrice,
+		MaxPrice:    model.ShopPrice,
+		AddTime:     model.CreatedAt.Unix(),
 	}
 	if model.OnSale != nil {
 		searchDO.OnSale = *model.OnSale
@@ -181,8 +293,11 @@ func (gs *goodsService) Create(ctx context.Context, goods *v1.GoodsInfo) error {
 		searchDO.IsHot = *model.IsHot
 	}
 
-	// Canal会自动监听binlog，无需在Create方法中主动发送MQ
-	// 同步逻辑由Canal监听器（后台goroutine）处理
+	// 未开启发件箱时由Canal监听binlog同步到ES，开启后变更事件在事务中写入发件箱
+	if err := gs.writeEvent(ctx, txn, goodsEventInsert, uint64(goods.GoodsDO.ID)); err != nil {
+		txn.Rollback()
+		return err
+	}
 
 	return txn.Commit().Error
 
@@ -213,6 +328,11 @@ func (gs *goodsService) Update(ctx context.Context, goods *v1.GoodsInfo) error {
 
 	err := gs.data.NewMysql().Goods().UpdateInTxn(ctx, txn, goods)
 	if err != nil {
+		txn.Rollback()
+		return err
+	}
+	if err := gs.writeEvent(ctx, txn, goodsEventUpdate, uint64(goods.GoodsDO.ID)); err != nil {
+		txn.Rollback()
 		return err
 	}
 
@@ -257,10 +377,14 @@ func (gs *goodsService) CreateInTxn(ctx context.Context, goods *v1.GoodsInfo) er
 		BrandsID:    model.BrandsID,
 		Name:        model.Name,
 		ClickNum:    model.ClickNum,
+		SoldNum:     model.SoldNum,
 		FavNum:      model.FavNum,
 		MarketPrice: model.MarketPrice,
 		GoodsBrief:  model.GoodsBrief,
 		ShopPrice:   model.ShopPrice,
+		MinPrice:    model.ShopPrice,
+		MaxPrice:    model.ShopPrice,
+		AddTime:     model.CreatedAt.Unix(),
 	}
 	if model.OnSale != nil {
 		searchDO.OnSale = *model.OnSale
@@ -320,10 +444,14 @@ func (gs *goodsService) UpdateInTxn(ctx context.Context, goods *v1.GoodsInfo) er
 		BrandsID:    model.BrandsID,
 		Name:        model.Name,
 		ClickNum:    model.ClickNum,
+		SoldNum:     model.SoldNum,
 		FavNum:      model.FavNum,
 		MarketPrice: model.MarketPrice,
 		GoodsBrief:  model.GoodsBrief,
 		ShopPrice:   model.ShopPrice,
+		MinPrice:    model.ShopPrice,
+		MaxPrice:    model.ShopPrice,
+		AddTime:     model.CreatedAt.Unix(),
 	}
 	if model.OnSale != nil {
 		searchDO.OnSale = *model.OnSale
@@ -348,9 +476,72 @@ func (gs *goodsService) UpdateInTxn(ctx context.Context, goods *v1.GoodsInfo) er
 
 }
 
+// HandleStockAlert 消息可能延迟或重复投递，上下架前重新查询当前的可售库存
+func (gs *goodsService) HandleStockAlert(ctx context.Context, msg *message.StockAlertMessage) error {
+	switch msg.Event {
+	case message.StockAlertSoldOut, message.StockAlertRestock:
+		soldOut := msg.Event == message.StockAlertSoldOut
+		inv, err := gs.data.Inventorys().InvDetail(ctx, &ipbv1.GoodsInvInfo{GoodsId: msg.GoodsId})
+		if err != nil {
+			return err
+		}
+		if soldOut != (inv.Num <= 0) {
+			log.Infof("商品%d当前可售库存%d，忽略过期的%s事件", msg.GoodsId, inv.Num, msg.Event)
+			return nil
+		}
+		return gs.updateSoldOut(ctx, uint64(msg.GoodsId), soldOut)
+	case message.StockAlertLow:
+		log.Warnf("商品%d库存不足，可售库存%d，阈值%d", msg.GoodsId, msg.Available, msg.LowWater)
+	default:
+		log.Warnf("未知的库存告警事件: %s", msg.Event)
+	}
+	return nil
+}
+
+// updateSoldOut 先改 MySQL，canal 或发件箱也会同步到 ES，这里直接更新 ES 是为了让搜索尽快生效
+func (gs *goodsService) updateSoldOut(ctx context.Context, ID uint64, soldOut bool) error {
+	var rows int64
+	err := gs.data.NewMysql().DB().Transaction(func(tx *gorm.DB) (err error) {
+		rows, err = gs.data.NewMysql().Goods().UpdateSoldOutInTxn(ctx, tx, ID, soldOut)
+		if err != nil || rows == 0 {
+			return err
+		}
+		return gs.writeEvent(ctx, tx, goodsEventUpdate, ID)
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		// 已经下架的商品不标记售罄，人工下架的商品补货后不自动上架
+		log.Infof("商品%d上下架状态不需要变化，跳过", ID)
+		return nil
+	}
+	if err := gs.searchData.Goods().UpdateOnSale(ctx, ID, !soldOut); err != nil {
+		return err
+	}
+	if soldOut {
+		log.Infof("商品%d已售罄，自动下架", ID)
+	} else {
+		log.Infof("商品%d已补货，重新上架", ID)
+	}
+	return nil
+}
+
 func (gs *goodsService) Delete(ctx context.Context, ID uint64) error {
-	err := gs.data.NewMysql().Goods().Delete(ctx, ID)
-	return err
+	if !gs.events.Enabled() {
+		return gs.data.NewMysql().Goods().Delete(ctx, ID)
+	}
+	return gs.data.NewMysql().DB().Transaction(func(tx *gorm.DB) error {
+		// 删除前读取商品，DELETE 事件中带上完整的列
+		goods, err := gs.data.NewMysql().Goods().GetInTxn(ctx, tx, ID)
+		if err != nil {
+			return err
+		}
+		if err := gs.data.NewMysql().Goods().DeleteInTxn(ctx, tx, ID); err != nil {
+			return err
+		}
+		return gs.events.Write(ctx, tx, goodsEvent(gs.eventTopic, goodsEventDelete, goods))
+	})
 }
 
 func (gs *goodsService) BatchGet(ctx context.Context, ids []uint64) ([]*dto.GoodsDTO, error) {
@@ -0,0 +1,262 @@
+package v1
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
+	v12 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/goods/srv/internal/domain/dto"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"math/rand"
+	"time"
+)
+
+// 增量同步有延迟，最近修改过的商品不参与一致性检查，重建索引的补写也多往前算这么久
+const syncLag = time.Minute
+
+// IndexSrv 商品搜索索引的维护，由命令行调用
+type IndexSrv interface {
+	// Reindex 从 MySQL 全量重建商品索引，校验数量后切换别名
+	Reindex(ctx context.Context) (*dto.ReindexDTO, error)
+	// Check 对比 MySQL 和 ES 的商品数量，并随机抽样对比商品字段
+	Check(ctx context.Context) (*dto.IndexCheckDTO, error)
+}
+
+type indexService struct {
+	data       v1.DataFactory
+	searchData v12.SearchFactory
+	opts       *options.SearchOptions
+}
+
+func newIndex(srv *serviceFactory) IndexSrv {
+	return &indexService{
+		data:       srv.data,
+		searchData: srv.dataSearch,
+		opts:       srv.searchOpts,
+	}
+}
+
+func (is *indexService) Reindex(ctx context.Context) (*dto.ReindexDTO, error) {
+	start := time.Now()
+	indices := is.searchData.Indices()
+	index, err := indices.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := &dto.ReindexDTO{Index: index}
+
+	var afterID uint64
+	for {
+		items, err := is.data.NewMysql().Goods().ListAfter(ctx, afterID, is.opts.ReindexBatch)
+		if err != nil {
+			is.abort(ctx, index)
+			return nil, err
+		}
+		if len(items) == 0 {
+			break
+		}
+		docs := make([]*do.GoodsSearchDO, 0, len(items))
+		for _, item := range items {
+			docs = append(docs, do.NewGoodsSearchDO(item))
+		}
+		if err := indices.BulkIndex(ctx, index, docs); err != nil {
+			is.abort(ctx, index)
+			return nil, err
+		}
+		ret.Indexed += int64(len(items))
+		afterID = uint64(items[len(items)-1].ID)
+		log.Infof("重建索引 %s: 已写入 %d 个商品", index, ret.Indexed)
+	}
+
+	// 新索引只有这里在写，数量必须一致
+	count, err := indices.Count(ctx, index)
+	if err != nil {
+		is.abort(ctx, index)
+		return nil, err
+	}
+	if count != ret.Indexed {
+		is.abort(ctx, index)
+		return nil, errors.WithCode(code.ErrReindexFailed, "索引 %s 中有 %d 个商品，写入了 %d 个", index, count, ret.Indexed)
+	}
+
+	previous, err := indices.SwapAlias(ctx, index)
+	if err != nil {
+		is.abort(ctx, index)
+		return nil, err
+	}
+	ret.Previous = previous
+	log.Infof("商品索引别名已切换到 %s，原索引 %v", index, previous)
+
+	// 切换之前增量同步一直写旧索引，补写重建期间修改过的商品，外部版本号保证不会覆盖更新的数据
+	caughtUp, err := is.catchUp(ctx, start.Add(-syncLag))
+	if err != nil {
+		return nil, errors.WithCode(code.ErrReindexFailed, "别名已切换到 %s，补写重建期间修改的商品失败，可以执行 check-index 检查: %v", index, err)
+	}
+	ret.CaughtUp = caughtUp
+
+	ret.Deleted, err = is.cleanup(ctx, index, previous)
+	if err != nil {
+		return nil, err
+	}
+	ret.Elapsed = time.Since(start)
+	return ret, nil
+}
+
+// abort 重建失败时删除新索引，别名仍然指向旧索引
+func (is *indexService) abort(ctx context.Context, index string) {
+	if err := is.searchData.Indices().Delete(ctx, index); err != nil {
+		log.Errorf("删除重建失败的索引 %s 失败: %v", index, err)
+	}
+}
+
+func (is *indexService) catchUp(ctx context.Context, since time.Time) (int64, error) {
+	alias := do.GoodsSearchDO{}.GetIndexName()
+	indices := is.searchData.Indices()
+	var total int64
+	var afterID uint64
+	for {
+		items, err := is.data.NewMysql().Goods().ListChangedSince(ctx, since, afterID, is.opts.ReindexBatch)
+		if err != nil {
+			return total, err
+		}
+		if len(items) == 0 {
+			return total, nil
+		}
+		var docs []*do.GoodsSearchDO
+		var deleted []uint64
+		for _, item := range items {
+			if item.DeletedAt.Valid {
+				deleted = append(deleted, uint64(item.ID))
+				continue
+			}
+			docs = append(docs, do.NewGoodsSearchDO(item))
+		}
+		if err := indices.BulkIndex(ctx, alias, docs); err != nil {
+			return total, err
+		}
+		if err := indices.BulkDelete(ctx, alias, deleted); err != nil {
+			return total, err
+		}
+		total += int64(len(items))
+		afterID = uint64(items[len(items)-1].ID)
+	}
+}
+
+// cleanup 保留新索引和上一个版本用于回滚，删除更早的版本
+func (is *indexService) cleanup(ctx context.Context, index string, previous []string) ([]string, error) {
+	keep := map[string]bool{index: true}
+	for _, name := range previous {
+		keep[name] = true
+	}
+	versions, err := is.searchData.Indices().Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	for _, name := range versions {
+		if keep[name] {
+			continue
+		}
+		if err := is.searchData.Indices().Delete(ctx, name); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, name)
+	}
+	return deleted, nil
+}
+
+func (is *indexService) Check(ctx context.Context) (*dto.IndexCheckDTO, error) {
+	goodsStore := is.data.NewMysql().Goods()
+	indices := is.searchData.Indices()
+	var ret dto.IndexCheckDTO
+	var err error
+	if ret.MysqlCount, err = goodsStore.Count(ctx); err != nil {
+		return nil, err
+	}
+	if ret.EsCount, err = indices.Count(ctx, do.GoodsSearchDO{}.GetIndexName()); err != nil {
+		return nil, err
+	}
+
+	minID, maxID, err := goodsStore.IDRange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxID == 0 {
+		return &ret, nil
+	}
+	// 按ID范围随机抽样，落在已删除ID上的不计入样本
+	goods, err := goodsStore.ListByIDs(ctx, sampleIDs(minID, maxID, is.opts.CheckSample), nil)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint64
+	for _, item := range goods.Items {
+		ids = append(ids, uint64(item.ID))
+	}
+	docs, err := indices.MultiGet(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range goods.Items {
+		if time.Since(item.UpdatedAt) < syncLag {
+			continue
+		}
+		ret.Sampled++
+		doc, ok := docs[item.ID]
+		if !ok {
+			ret.Missing = append(ret.Missing, item.ID)
+			continue
+		}
+		if fields := driftFields(do.NewGoodsSearchDO(item), doc); len(fields) > 0 {
+			ret.Stale = append(ret.Stale, &dto.GoodsDrift{ID: item.ID, Fields: fields})
+		}
+	}
+	return &ret, nil
+}
+
+func sampleIDs(minID, maxID uint64, size int) []uint64 {
+	span := maxID - minID + 1
+	if span <= uint64(size) {
+		ids := make([]uint64, 0, span)
+		for id := minID; id <= maxID; id++ {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	seen := make(map[uint64]bool, size)
+	ids := make([]uint64, 0, size)
+	for len(ids) < size {
+		id := minID + uint64(rand.Int63n(int64(span)))
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// driftFields 对比会影响搜索和筛选的字段，点击数、收藏数这类计数不对比
+func driftFields(want, got *do.GoodsSearchDO) []string {
+	var fields []string
+	check := func(name string, equal bool) {
+		if !equal {
+			fields = append(fields, name)
+		}
+	}
+	check("name", want.Name == got.Name)
+	check("goods_brief", want.GoodsBrief == got.GoodsBrief)
+	check("category_id", want.CategoryID == got.CategoryID)
+	check("brands_id", want.BrandsID == got.BrandsID)
+	check("on_sale", want.OnSale == got.OnSale)
+	check("ship_free", want.ShipFree == got.ShipFree)
+	check("is_new", want.IsNew == got.IsNew)
+	check("is_hot", want.IsHot == got.IsHot)
+	check("market_price", want.MarketPrice == got.MarketPrice)
+	check("shop_price", want.ShopPrice == got.ShopPrice)
+	check("max_price", want.MaxPrice == got.MaxPrice)
+	return fields
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
 	v12 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/app/pkg/outbox"
 )
 
 type ServiceFactory interface {
@@ -11,15 +13,28 @@ type ServiceFactory interface {
 	Category() CategorySrv
 	CategoryBrands() CategoryBrandSrv
 	Banner() BannerSrv
+	Sku() SkuSrv
+	Index() IndexSrv
 }
 
 type serviceFactory struct {
 	data       v1.DataFactory
 	dataSearch v12.SearchFactory
+	events     outbox.Writer
+	eventTopic string
+	searchOpts *options.SearchOptions
 }
 
-func NewService(store v1.DataFactory, dataSearch v12.SearchFactory) ServiceFactory {
-	return &serviceFactory{data: store, dataSearch: dataSearch}
+// NewService 开启发件箱时商品变更事件发到 mqOpts.Topic，和 Canal 转发的是同一个 Topic
+func NewService(store v1.DataFactory, dataSearch v12.SearchFactory, mqOpts *options.RocketMQOptions, outboxOpts *options.OutboxOptions,
+	searchOpts *options.SearchOptions) ServiceFactory {
+	return &serviceFactory{
+		data:       store,
+		dataSearch: dataSearch,
+		events:     outbox.NewWriter(outboxOpts),
+		eventTopic: mqOpts.Topic,
+		searchOpts: searchOpts,
+	}
 }
 
 var _ ServiceFactory = &serviceFactory{}
@@ -39,6 +54,14 @@ func (s *serviceFactory) Banner() BannerSrv {
 	return newBanner(s)
 }
 
+func (s *serviceFactory) Index() IndexSrv {
+	return newIndex(s)
+}
+
 func (s *serviceFactory) CategoryBrands() CategoryBrandSrv {
 	return newCategoryBrand(s)
 }
+
+func (s *serviceFactory) Sku() SkuSrv {
+	return newSku(s)
+}
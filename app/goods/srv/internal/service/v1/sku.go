@@ -0,0 +1,180 @@
+package v1
+
+import (
+	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
+	"Advanced_Shop/app/goods/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"gorm.io/gorm"
+)
+
+// SkuSrv 规格和 SKU 服务，SKU 的价格区间会回写到商品上，经 canal 或发件箱同步到 ES
+type SkuSrv interface {
+	// Specifications 分类下的规格，按展示顺序排列
+	Specifications(ctx context.Context, categoryID int32) (*do.SpecificationDOList, error)
+	CreateSpecification(ctx context.Context, spec *do.SpecificationDO) error
+	UpdateSpecification(ctx context.Context, spec *do.SpecificationDO) error
+
+	Get(ctx context.Context, ID uint64) (*do.GoodsSkuDO, error)
+	// ListByGoods 商品的所有 SKU
+	ListByGoods(ctx context.Context, goodsID uint64) (*do.GoodsSkuDOList, error)
+	BatchGet(ctx context.Context, ids []uint64) (*do.GoodsSkuDOList, error)
+	Create(ctx context.Context, sku *do.GoodsSkuDO) error
+	// Update 规格为空时不修改规格
+	Update(ctx context.Context, sku *do.GoodsSkuDO) error
+}
+
+type skuService struct {
+	data  v1.DataFactory
+	goods *goodsService // 写商品变更事件
+}
+
+func newSku(srv *serviceFactory) SkuSrv {
+	return &skuService{
+		data:  srv.data,
+		goods: newGoods(srv).(*goodsService),
+	}
+}
+
+func (ss *skuService) Specifications(ctx context.Context, categoryID int32) (*do.SpecificationDOList, error) {
+	return ss.data.NewMysql().Specifications().List(ctx, categoryID)
+}
+
+func (ss *skuService) CreateSpecification(ctx context.Context, spec *do.SpecificationDO) error {
+	if _, err := ss.data.NewMysql().Categorys().Get(ctx, uint64(spec.CategoryID)); err != nil {
+		return err
+	}
+	if len(spec.Values) == 0 {
+		return errors.WithCode(code.ErrSkuSpecInvalid, "规格%s没有可选值", spec.Name)
+	}
+	return ss.data.NewMysql().Specifications().Create(ctx, spec)
+}
+
+func (ss *skuService) UpdateSpecification(ctx context.Context, spec *do.SpecificationDO) error {
+	return ss.data.NewMysql().Specifications().Update(ctx, spec)
+}
+
+func (ss *skuService) Get(ctx context.Context, ID uint64) (*do.GoodsSkuDO, error) {
+	return ss.data.NewMysql().Skus().Get(ctx, ID)
+}
+
+func (ss *skuService) ListByGoods(ctx context.Context, goodsID uint64) (*do.GoodsSkuDOList, error) {
+	return ss.data.NewMysql().Skus().ListByGoods(ctx, nil, goodsID)
+}
+
+func (ss *skuService) BatchGet(ctx context.Context, ids []uint64) (*do.GoodsSkuDOList, error) {
+	return ss.data.NewMysql().Skus().ListByIDs(ctx, ids)
+}
+
+// checkSpecs 规格必须和商品分类的规格一一对应，取值在可选值中，返回按展示顺序排列的规格
+func (ss *skuService) checkSpecs(ctx context.Context, categoryID int32, specs do.SkuSpecs) (do.SkuSpecs, error) {
+	list, err := ss.data.NewMysql().Specifications().List(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		if _, ok := values[spec.Name]; ok {
+			return nil, errors.WithCode(code.ErrSkuSpecInvalid, "规格%s重复", spec.Name)
+		}
+		values[spec.Name] = spec.Value
+	}
+	if len(values) != len(list.Items) {
+		return nil, errors.WithCode(code.ErrSkuSpecInvalid, "分类%d有%d个规格，SKU给出了%d个", categoryID, len(list.Items), len(values))
+	}
+	ordered := make(do.SkuSpecs, 0, len(list.Items))
+	for _, spec := range list.Items {
+		value, ok := values[spec.Name]
+		if !ok {
+			return nil, errors.WithCode(code.ErrSkuSpecInvalid, "缺少规格%s", spec.Name)
+		}
+		if !spec.Has(value) {
+			return nil, errors.WithCode(code.ErrSkuSpecInvalid, "规格%s没有可选值%s", spec.Name, value)
+		}
+		ordered = append(ordered, do.SkuSpec{Name: spec.Name, Value: value})
+	}
+	return ordered, nil
+}
+
+func (ss *skuService) Create(ctx context.Context, sku *do.GoodsSkuDO) error {
+	goods, err := ss.data.NewMysql().Goods().Get(ctx, uint64(sku.GoodsID))
+	if err != nil {
+		return err
+	}
+	sku.Specs, err = ss.checkSpecs(ctx, goods.CategoryID, sku.Specs)
+	if err != nil {
+		return err
+	}
+	return ss.data.NewMysql().DB().Transaction(func(txn *gorm.DB) error {
+		if err := ss.data.NewMysql().Skus().CreateInTxn(ctx, txn, sku); err != nil {
+			return err
+		}
+		return ss.refreshGoods(ctx, txn, uint64(sku.GoodsID))
+	})
+}
+
+func (ss *skuService) Update(ctx context.Context, sku *do.GoodsSkuDO) error {
+	old, err := ss.data.NewMysql().Skus().Get(ctx, uint64(sku.ID))
+	if err != nil {
+		return err
+	}
+	if len(sku.Specs) > 0 {
+		goods, err := ss.data.NewMysql().Goods().Get(ctx, uint64(old.GoodsID))
+		if err != nil {
+			return err
+		}
+		sku.Specs, err = ss.checkSpecs(ctx, goods.CategoryID, sku.Specs)
+		if err != nil {
+			return err
+		}
+	}
+	return ss.data.NewMysql().DB().Transaction(func(txn *gorm.DB) error {
+		if err := ss.data.NewMysql().Skus().UpdateInTxn(ctx, txn, sku); err != nil {
+			return err
+		}
+		return ss.refreshGoods(ctx, txn, uint64(old.GoodsID))
+	})
+}
+
+// refreshGoods 在事务中检查规格组合不重复，并按可售 SKU 重新计算商品的价格区间，没有可售 SKU 时按全部 SKU 计算
+func (ss *skuService) refreshGoods(ctx context.Context, txn *gorm.DB, goodsID uint64) error {
+	list, err := ss.data.NewMysql().Skus().ListByGoods(ctx, txn, goodsID)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(list.Items))
+	for _, sku := range list.Items {
+		text := sku.Specs.Text()
+		if seen[text] {
+			return errors.WithCode(code.ErrSkuSpecInvalid, "商品%d已有规格为[%s]的SKU", goodsID, text)
+		}
+		seen[text] = true
+	}
+
+	minPrice, maxPrice := priceRange(list.Items, true)
+	if maxPrice == 0 {
+		minPrice, maxPrice = priceRange(list.Items, false)
+	}
+	if err := ss.data.NewMysql().Goods().UpdatePriceInTxn(ctx, txn, goodsID, minPrice, maxPrice); err != nil {
+		return err
+	}
+	log.Debugf("商品%d价格区间更新为 %.2f - %.2f", goodsID, minPrice, maxPrice)
+	return ss.goods.writeEvent(ctx, txn, goodsEventUpdate, goodsID)
+}
+
+func priceRange(skus []*do.GoodsSkuDO, onSaleOnly bool) (minPrice, maxPrice float32) {
+	for _, sku := range skus {
+		if onSaleOnly && sku.OnSale != nil && !*sku.OnSale {
+			continue
+		}
+		if minPrice == 0 || sku.Price < minPrice {
+			minPrice = sku.Price
+		}
+		if sku.Price > maxPrice {
+			maxPrice = sku.Price
+		}
+	}
+	return minPrice, maxPrice
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"Advanced_Shop/app/goods/srv/internal/domain/dto"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"strings"
+	"unicode/utf8"
+)
+
+// 超过这个长度的关键词不计入热搜，多半是粘贴的整段文字
+const maxHotTermLen = 20
+
+func normalizeTerm(keyWords string) string {
+	return strings.ToLower(strings.Join(strings.Fields(keyWords), " "))
+}
+
+// recordHotTerm 统计搜索关键词，未开启热搜词统计时不记录
+func (gs *goodsService) recordHotTerm(ctx context.Context, keyWords string) {
+	hotTerms := gs.searchData.HotTerms()
+	term := normalizeTerm(keyWords)
+	if hotTerms == nil || term == "" || utf8.RuneCountInString(term) > maxHotTermLen {
+		return
+	}
+	if err := hotTerms.Incr(ctx, term); err != nil {
+		log.Warnf("record hot search term %q err: %v", term, err)
+	}
+}
+
+func (gs *goodsService) Suggest(ctx context.Context, keyWords string, size int) (*dto.SuggestDTO, error) {
+	if size <= 0 || size > gs.searchOpts.SuggestSize {
+		size = gs.searchOpts.SuggestSize
+	}
+	var ret dto.SuggestDTO
+	prefix := strings.TrimSpace(keyWords)
+	if prefix != "" {
+		suggestions, err := gs.searchData.Goods().Suggest(ctx, prefix, size)
+		if err != nil {
+			log.Errorf("searchData.Goods().Suggest err: %v", err)
+			return nil, err
+		}
+		ret.Suggestions = suggestions
+		return &ret, nil
+	}
+
+	// 还没有输入时展示热搜榜
+	hotTerms := gs.searchData.HotTerms()
+	if hotTerms == nil {
+		return &ret, nil
+	}
+	if size > gs.searchOpts.HotSize {
+		size = gs.searchOpts.HotSize
+	}
+	terms, err := hotTerms.Top(ctx, size)
+	if err != nil {
+		log.Errorf("searchData.HotTerms().Top err: %v", err)
+		return nil, err
+	}
+	ret.HotTerms = terms
+	return &ret, nil
+}
@@ -4,9 +4,10 @@ import (
 	gpb "Advanced_Shop/api/goods/v1"
 	"Advanced_Shop/app/goods/srv/config"
 	v12 "Advanced_Shop/app/goods/srv/internal/controller/v1"
+	"Advanced_Shop/app/goods/srv/internal/data/v1/mq"
 	data "Advanced_Shop/app/goods/srv/internal/data/v1/realize"
-	"Advanced_Shop/app/goods/srv/internal/data_search/v1/es"
 	v1 "Advanced_Shop/app/goods/srv/internal/service/v1"
+	"Advanced_Shop/app/pkg/outbox"
 	"Advanced_Shop/gnova/core/trace"
 	"Advanced_Shop/gnova/server/rpcserver"
 	"context"
@@ -16,7 +17,7 @@ import (
 	"Advanced_Shop/pkg/log"
 )
 
-func NewGoodsRPCServer(cfg *config.Config) (*rpcserver.Server, error) {
+func NewGoodsRPCServer(cfg *config.Config, ctx context.Context) (*rpcserver.Server, error) {
 	//初始化open-telemetry的exporter
 	trace.InitAgent(trace.Options{
 		cfg.Telemetry.Name,
@@ -26,9 +27,9 @@ func NewGoodsRPCServer(cfg *config.Config) (*rpcserver.Server, error) {
 	})
 
 	//有点繁琐，wire， ioc-golang
-	dataFactory := data.NewDataStore(cfg.MySQLOptions, cfg.MqOpts, cfg.CanalOpts)
+	dataFactory := data.NewDataStore(cfg.MySQLOptions, cfg.MqOpts, cfg.CanalOpts, cfg.Registry)
 	//构建，繁琐 - 工厂模式
-	searchFactory, err := es.GetSearchFactoryOr(cfg.EsOptions, cfg.MqOpts, cfg.CanalOpts)
+	searchFactory, err := NewSearchFactory(ctx, cfg, dataFactory)
 	if err != nil {
 		log.Fatal(err.Error())
 		return nil, err
@@ -39,13 +40,27 @@ func NewGoodsRPCServer(cfg *config.Config) (*rpcserver.Server, error) {
 		客户端调用Create → 参数校验 → 校验品牌/分类 → 开启MySQL事务 → 写入商品数据 → 提交事务 →
 		Canal监听binlog → 解析商品表变更 → 发送RocketMQ消息 → ES消费者消费消息并写入ES
 	*/
-	dataFactory.StartCanalListener(context.Background())
+	// 开启发件箱后商品变更事件在业务事务中写入，由发件箱转发，不再需要 Canal
+	if cfg.Outbox.Enable {
+		publisher, err := outbox.NewRocketMQPublisher(cfg.MqOpts)
+		if err != nil {
+			return nil, err
+		}
+		outbox.NewRelay(dataFactory.NewMysql().DB(), publisher, cfg.Outbox).Start(ctx)
+	} else {
+		dataFactory.StartCanalListener(ctx)
+	}
 	time.Sleep(2 * time.Second)
-	err = searchFactory.Listen(context.Background())
+	err = searchFactory.Listen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	srvFactory := v1.NewService(dataFactory, searchFactory, cfg.MqOpts, cfg.Outbox, cfg.Search)
+	// 库存服务售罄时自动下架
+	err = mq.ListenStockAlert(ctx, cfg.MqOpts, srvFactory.Goods().HandleStockAlert)
 	if err != nil {
 		return nil, err
 	}
-	srvFactory := v1.NewService(dataFactory, searchFactory)
 	goodsServer := v12.NewGoodsServer(srvFactory)
 	rpcAddr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
 	grpcServer := rpcserver.NewServer(rpcserver.WithAddress(rpcAddr))
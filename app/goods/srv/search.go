@@ -0,0 +1,71 @@
+package srv
+
+import (
+	"Advanced_Shop/app/goods/srv/config"
+	v1 "Advanced_Shop/app/goods/srv/internal/data/v1"
+	v12 "Advanced_Shop/app/goods/srv/internal/data_search/v1"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/breaker"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/es"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/memory"
+	"Advanced_Shop/app/goods/srv/internal/data_search/v1/mysql"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"time"
+)
+
+// ES 启动时不可用，隔多久重试一次初始化索引
+const ensureIndexInterval = 30 * time.Second
+
+// NewSearchFactory 按配置选择商品搜索后端
+// ES 后端开启降级时 ES 不可用也能启动，搜索出错或熔断时改查 MySQL
+func NewSearchFactory(ctx context.Context, cfg *config.Config, dataFactory v1.DataFactory) (v12.SearchFactory, error) {
+	switch cfg.Search.Backend {
+	case options.SearchBackendMySQL:
+		return mysql.NewSearchFactory(dataFactory.NewMysql().DB(), cfg.Search)
+	case options.SearchBackendMemory:
+		log.Warn("商品搜索使用进程内索引，只用于测试和本地开发")
+		return memory.NewSearchFactory(cfg.Search)
+	}
+
+	searchFactory, err := es.GetSearchFactoryOr(cfg.EsOptions, cfg.MqOpts, cfg.CanalOpts, cfg.Search)
+	if err != nil {
+		return nil, err
+	}
+	// 先写入索引模板，ES 同步时创建的索引才会使用中文分词和搜索建议字段
+	err = searchFactory.EnsureIndex(ctx)
+	if !cfg.Search.Fallback {
+		if err != nil {
+			return nil, err
+		}
+		return searchFactory, nil
+	}
+	if err != nil {
+		log.Warnf("初始化商品索引失败，ES 恢复前搜索降级到 MySQL: %v", err)
+		go ensureIndexLater(ctx, searchFactory)
+	}
+	fallback, err := mysql.NewSearchFactory(dataFactory.NewMysql().DB(), cfg.Search)
+	if err != nil {
+		return nil, err
+	}
+	return breaker.NewSearchFactory(searchFactory, fallback, cfg.Search)
+}
+
+// ensureIndexLater ES 恢复后写入索引模板，读取索引支持的功能，服务停止时退出
+func ensureIndexLater(ctx context.Context, searchFactory v12.SearchFactory) {
+	ticker := time.NewTicker(ensureIndexInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := searchFactory.EnsureIndex(ctx); err != nil {
+			log.Warnf("初始化商品索引失败，%s 后重试: %v", ensureIndexInterval, err)
+			continue
+		}
+		log.Info("ES 已恢复，商品索引初始化完成")
+		return
+	}
+}
@@ -94,6 +94,7 @@ func NewInventoryApp(cfg *config.Config, ctx context.Context) (*gapp.App, error)
 		gapp.WithName(cfg.Server.Name),
 		gapp.WithRPCServer(rpcServer),
 		gapp.WithRegistrar(register),
+		gapp.WithMetricsPort(cfg.Server.MetricPort),
 	), nil
 }
 
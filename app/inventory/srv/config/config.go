@@ -15,6 +15,7 @@ type Config struct {
 	Registry     *options.RegistryOptions  `json:"registry" mapstructure:"registry"`
 	RedisOptions *options.RedisOptions     `json:"redis" mapstructure:"redis"`
 	Mq           *options.RocketMQOptions  `json:"mq" mapstructure:"mq"`
+	Inventory    *options.InventoryOptions `json:"inventory" mapstructure:"inventory"`
 }
 
 func New() *Config {
@@ -27,6 +28,7 @@ func New() *Config {
 		Registry:     options.NewRegistryOptions(),
 		RedisOptions: options.NewRedisOptions(),
 		Mq:           options.NewRocketMQOptions(),
+		Inventory:    options.NewInventoryOptions(),
 	}
 }
 
@@ -39,6 +41,7 @@ func (o *Config) Flags() (fss cliflag.NamedFlagSets) {
 	o.MySQLOptions.AddFlags(fss.FlagSet("mysql"))
 	o.RedisOptions.AddFlags(fss.FlagSet("redis"))
 	o.Mq.AddFlags(fss.FlagSet("mq"))
+	o.Inventory.AddFlags(fss.FlagSet("inventory"))
 	return fss
 }
 
@@ -58,5 +61,6 @@ func (o *Config) Validate() []error {
 	errs = append(errs, o.Registry.Validate()...)
 	errs = append(errs, o.RedisOptions.Validate()...)
 	errs = append(errs, o.Mq.Validate()...)
+	errs = append(errs, o.Inventory.Validate()...)
 	return errs
 }
@@ -6,12 +6,14 @@ import (
 	"Advanced_Shop/app/inventory/srv/internal/domain/dto"
 	"Advanced_Shop/app/inventory/srv/internal/service/v1"
 	"Advanced_Shop/app/pkg/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
 	"Advanced_Shop/pkg/errors"
 	"Advanced_Shop/pkg/log"
 	"context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
 )
 
 // TODO DTM (Distributed Transactions Manager)
@@ -25,7 +27,9 @@ type inventoryServer struct {
 func (is *inventoryServer) SetInv(ctx context.Context, info *invpb.GoodsInvInfo) (*emptypb.Empty, error) {
 	invDTO := &dto.InventoryDTO{}
 	invDTO.Goods = info.GoodsId
+	invDTO.Sku = info.SkuId
 	invDTO.Stock = info.Num
+	invDTO.Warehouse = info.WarehouseId
 	err := is.srv.Inventories().Create(ctx, invDTO)
 	if err != nil {
 		return nil, err
@@ -34,24 +38,213 @@ func (is *inventoryServer) SetInv(ctx context.Context, info *invpb.GoodsInvInfo)
 }
 
 func (is *inventoryServer) InvDetail(ctx context.Context, info *invpb.GoodsInvInfo) (*invpb.GoodsInvInfo, error) {
-	inv, err := is.srv.Inventories().Get(ctx, uint64(info.GoodsId))
+	inv, err := is.srv.Inventories().Get(ctx, uint64(info.GoodsId), uint64(info.SkuId))
 	if err != nil {
 		return nil, err
 	}
+	// 冻结中的库存不可再售，返回可售库存
 	return &invpb.GoodsInvInfo{
 		GoodsId: inv.Goods,
-		Num:     inv.Stock,
+		SkuId:   inv.Sku,
+		Num:     inv.Stock - inv.Frozen,
 	}, nil
 }
 
+// BatchInvDetail 批量查询可售库存，没有库存记录的商品不返回，bySku 时每个规格一条
+func (is *inventoryServer) BatchInvDetail(ctx context.Context, request *invpb.BatchInvRequest) (*invpb.BatchInvResponse, error) {
+	goodsIDs := make([]uint64, 0, len(request.GoodsIds))
+	for _, id := range request.GoodsIds {
+		goodsIDs = append(goodsIDs, uint64(id))
+	}
+	invs, err := is.srv.Inventories().BatchGet(ctx, goodsIDs, request.BySku)
+	if err != nil {
+		return nil, err
+	}
+	response := &invpb.BatchInvResponse{}
+	for _, inv := range invs {
+		response.Data = append(response.Data, &invpb.GoodsInvInfo{
+			GoodsId: inv.Goods,
+			SkuId:   inv.Sku,
+			Num:     inv.Stock - inv.Frozen,
+		})
+	}
+	return response, nil
+}
+
+func (is *inventoryServer) CreateWarehouse(ctx context.Context, info *invpb.WarehouseInfo) (*invpb.WarehouseInfo, error) {
+	warehouseDTO := &dto.WarehouseDTO{}
+	warehouseDTO.Name = info.Name
+	warehouseDTO.Province = info.Province
+	warehouseDTO.City = info.City
+	warehouseDTO.Address = info.Address
+	err := is.srv.Warehouses().Create(ctx, warehouseDTO)
+	if err != nil {
+		return nil, err
+	}
+	info.Id = warehouseDTO.ID
+	return info, nil
+}
+
+func (is *inventoryServer) AdjustInv(ctx context.Context, info *invpb.AdjustInvInfo) (*emptypb.Empty, error) {
+	if info.Num == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "调整量不能为0")
+	}
+	err := is.srv.Inventories().Adjust(ctx, uint64(info.GoodsId), uint64(info.SkuId), uint64(info.WarehouseId), info.Num, info.Remark)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (is *inventoryServer) SetStockThreshold(ctx context.Context, info *invpb.StockThresholdInfo) (*emptypb.Empty, error) {
+	if info.LowWater < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "阈值不能为负数")
+	}
+	err := is.srv.Inventories().SetThreshold(ctx, uint64(info.GoodsId), info.LowWater)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (is *inventoryServer) PreloadFlashSale(ctx context.Context, info *invpb.FlashSaleInfo) (*emptypb.Empty, error) {
+	var detail []do.GoodsDetail
+	for _, value := range info.GoodsInfo {
+		detail = append(detail, do.GoodsDetail{GoodId: value.GoodsId, Sku: value.SkuId, Num: value.Num})
+	}
+	err := is.srv.Inventories().PreloadFlashSale(ctx, detail)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (is *inventoryServer) StopFlashSale(ctx context.Context, info *invpb.FlashSaleInfo) (*emptypb.Empty, error) {
+	goodsIDs := make([]int32, 0, len(info.GoodsInfo))
+	for _, value := range info.GoodsInfo {
+		goodsIDs = append(goodsIDs, value.GoodsId)
+	}
+	err := is.srv.Inventories().StopFlashSale(ctx, goodsIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (is *inventoryServer) RunReconcile(ctx context.Context, request *invpb.ReconcileRequest) (*invpb.ReconcileReportResponse, error) {
+	run, err := is.srv.Reconciles().Run(ctx, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return is.reconcileReport(ctx, uint64(run.ID), metav1.ListMeta{})
+}
+
+func (is *inventoryServer) ReconcileReport(ctx context.Context, request *invpb.ReconcileReportRequest) (*invpb.ReconcileReportResponse, error) {
+	meta := metav1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	}
+	return is.reconcileReport(ctx, uint64(request.RunId), meta)
+}
+
+func (is *inventoryServer) reconcileReport(ctx context.Context, runID uint64, meta metav1.ListMeta) (*invpb.ReconcileReportResponse, error) {
+	run, items, err := is.srv.Reconciles().Report(ctx, runID, meta)
+	if err != nil {
+		return nil, err
+	}
+	response := &invpb.ReconcileReportResponse{
+		Id:            run.ID,
+		DryRun:        run.DryRun,
+		Scanned:       run.Scanned,
+		Returned:      run.Returned,
+		Confirmed:     run.Confirmed,
+		Pending:       run.Pending,
+		Discrepancies: run.Discrepancies,
+		Failed:        run.Failed,
+		StartTime:     run.StartedAt.Unix(),
+		Total:         int32(items.TotalCount),
+	}
+	if !run.FinishedAt.IsZero() {
+		response.EndTime = run.FinishedAt.Unix()
+	}
+	for _, item := range items.Items {
+		response.Items = append(response.Items, &invpb.ReconcileItem{
+			OrderSn:     item.OrderSn,
+			OrderStatus: item.OrderStatus,
+			Action:      item.Action,
+			Message:     item.Message,
+		})
+	}
+	return response, nil
+}
+
+func (is *inventoryServer) InventoryLedger(ctx context.Context, request *invpb.LedgerFilterRequest) (*invpb.LedgerListResponse, error) {
+	filter := do.LedgerFilter{
+		Goods:   request.GoodsId,
+		OrderSn: request.OrderSn,
+	}
+	if request.StartTime > 0 {
+		filter.Start = time.Unix(request.StartTime, 0)
+	}
+	if request.EndTime > 0 {
+		filter.End = time.Unix(request.EndTime, 0)
+	}
+	meta := metav1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	}
+	list, err := is.srv.Inventories().Ledger(ctx, filter, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &invpb.LedgerListResponse{Total: int32(list.TotalCount)}
+	for _, item := range list.Items {
+		response.Data = append(response.Data, &invpb.LedgerInfo{
+			Id:          item.ID,
+			GoodsId:     item.Goods,
+			SkuId:       item.Sku,
+			WarehouseId: item.Warehouse,
+			OrderSn:     item.OrderSn,
+			Type:        item.Type,
+			Change:      item.Change,
+			Remark:      item.Remark,
+			AddTime:     item.CreatedAt.Unix(),
+		})
+	}
+	return response, nil
+}
+
+// PlanWarehouse 只读取库存，返回的 warehouseId 为 0 时表示下单时不指定仓库
+func (is *inventoryServer) PlanWarehouse(ctx context.Context, info *invpb.SellInfo) (*invpb.SellInfo, error) {
+	var detail []do.GoodsDetail
+	for _, value := range info.GoodsInfo {
+		detail = append(detail, do.GoodsDetail{GoodId: value.GoodsId, Sku: value.SkuId, Num: value.Num})
+	}
+	planned, err := is.srv.Inventories().Plan(ctx, info.Province, detail)
+	if err != nil {
+		return nil, err
+	}
+	response := &invpb.SellInfo{OrderSn: info.OrderSn, Province: info.Province}
+	for _, value := range planned {
+		response.GoodsInfo = append(response.GoodsInfo, &invpb.GoodsInvInfo{
+			GoodsId:     value.GoodId,
+			SkuId:       value.Sku,
+			Num:         value.Num,
+			WarehouseId: value.Warehouse,
+		})
+	}
+	return response, nil
+}
+
 func (is *inventoryServer) Sell(ctx context.Context, info *invpb.SellInfo) (*emptypb.Empty, error) {
 	var detail []do.GoodsDetail
 	for _, value := range info.GoodsInfo {
-		detail = append(detail, do.GoodsDetail{GoodId: value.GoodsId, Num: value.Num})
+		detail = append(detail, do.GoodsDetail{GoodId: value.GoodsId, Sku: value.SkuId, Num: value.Num, Warehouse: value.WarehouseId})
 	}
-	err := is.srv.Inventories().Sell(ctx, info.OrderSn, detail)
+	err := is.srv.Inventories().Sell(ctx, info.OrderSn, info.Province, detail)
 	if err != nil {
-		if errors.IsCode(err, code.ErrInvNotEnough) {
+		if errors.IsCode(err, code.ErrInvNotEnough) || errors.IsCode(err, code.ErrFlashSaleMixed) {
 			return nil, status.Errorf(codes.Aborted, err.Error())
 		}
 		return nil, err
@@ -60,13 +253,59 @@ func (is *inventoryServer) Sell(ctx context.Context, info *invpb.SellInfo) (*emp
 	return &emptypb.Empty{}, nil
 }
 
+// Reback 以扣减记录为准归还到原仓库，只需要 OrderSn
+// 下单 Saga 的补偿会带上扣减时的商品明细，这里忽略明细，整单归还
 func (is *inventoryServer) Reback(ctx context.Context, info *invpb.SellInfo) (*emptypb.Empty, error) {
 	log.Infof("订单%s归还库存", info.OrderSn)
+	err := is.srv.Inventories().Reback(ctx, info.OrderSn)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ReturnGoods 售后退货，只归还退货的商品
+func (is *inventoryServer) ReturnGoods(ctx context.Context, info *invpb.SellInfo) (*emptypb.Empty, error) {
+	log.Infof("订单%s退货归还库存", info.OrderSn)
+	var detail []do.GoodsDetail
+	for _, value := range info.GoodsInfo {
+		detail = append(detail, do.GoodsDetail{GoodId: value.GoodsId, Sku: value.SkuId, Num: value.Num})
+	}
+	if err := is.srv.Inventories().Return(ctx, info.OrderSn, detail); err != nil {
+		if errors.IsCode(err, code.ErrInvReturnExceeded) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (is *inventoryServer) TrySell(ctx context.Context, info *invpb.SellInfo) (*emptypb.Empty, error) {
 	var detail []do.GoodsDetail
-	for _, v := range info.GoodsInfo {
-		detail = append(detail, do.GoodsDetail{GoodId: v.GoodsId, Num: v.Num})
+	for _, value := range info.GoodsInfo {
+		detail = append(detail, do.GoodsDetail{GoodId: value.GoodsId, Sku: value.SkuId, Num: value.Num, Warehouse: value.WarehouseId})
 	}
-	err := is.srv.Inventories().Reback(ctx, info.OrderSn, detail)
+	err := is.srv.Inventories().TrySell(ctx, info.OrderSn, info.Province, detail)
+	if err != nil {
+		if errors.IsCode(err, code.ErrFlashSaleMixed) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmSell 以预留记录为准，只需要 OrderSn
+func (is *inventoryServer) ConfirmSell(ctx context.Context, info *invpb.SellInfo) (*emptypb.Empty, error) {
+	err := is.srv.Inventories().ConfirmSell(ctx, info.OrderSn)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (is *inventoryServer) CancelSell(ctx context.Context, info *invpb.SellInfo) (*emptypb.Empty, error) {
+	err := is.srv.Inventories().CancelSell(ctx, info.OrderSn)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	orderpb "Advanced_Shop/api/order/v1"
 	"context"
 	redsyncredis "github.com/go-redsync/redsync/v4/redis"
 	"gorm.io/gorm"
@@ -8,7 +9,16 @@ import (
 
 type DataFactory interface {
 	Inventorys() InventoryStore
-	Listen(ctx context.Context)
+	Warehouses() WarehouseStore
+	Ledgers() LedgerStore
+	FlashSales() FlashSaleStore
+	Reconciles() ReconcileStore
+	Orders() orderpb.OrderClient
+	Producer() MQProducer
+	// Listen 消费订单超时的归还消息，归还成功后调用 handler
+	Listen(ctx context.Context, handler RebackHandler)
+	// ListenFlashSale 消费秒杀写回消息，handler 返回错误时消息重试
+	ListenFlashSale(ctx context.Context, handler FlashSaleHandler)
 	Begin() *gorm.DB
 	DB() *gorm.DB
 	Pool() redsyncredis.Pool
@@ -0,0 +1,226 @@
+package mysql
+
+import (
+	v1 "Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// 所有秒杀 key 共用 {flash_sale} hash tag，集群模式下落在同一个槽，Lua 脚本才能一次操作多个 key
+const (
+	flashStockKeyPrefix = "{flash_sale}:stock:"
+	flashOrderKeyPrefix = "{flash_sale}:order:"
+
+	// 订单记录保留时间，需要覆盖 DTM 补偿和写回消息重试的时间窗口
+	flashOrderTTL = 7 * 24 * time.Hour
+)
+
+// deductScript 先检查整单所有商品再统一扣减，订单记录里保存每个库存 key 的扣减量，归还时原样加回
+// KEYS[1] 订单 key，KEYS[2..] 商品库存 key；ARGV[1] 订单 key 过期秒数，ARGV[2..] 扣减数量
+var deductScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 2
+end
+for i = 2, #KEYS do
+	local stock = redis.call('GET', KEYS[i])
+	if not stock then
+		return -1
+	end
+	if tonumber(stock) < tonumber(ARGV[i]) then
+		return 0
+	end
+end
+redis.call('HSET', KEYS[1], 'state', 'sold')
+for i = 2, #KEYS do
+	redis.call('DECRBY', KEYS[i], ARGV[i])
+	redis.call('HINCRBY', KEYS[1], KEYS[i], ARGV[i])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+return 1
+`)
+
+// cancelScript 只有尚未被写回消费者认领的订单才在 Redis 内归还
+// 已经结束秒杀的商品不再加回，避免重新建出秒杀库存
+// KEYS[1] 订单 key；ARGV[1] 为 1 时删除订单记录，否则置为 cancelled
+var cancelScript = redis.NewScript(`
+local state = redis.call('HGET', KEYS[1], 'state')
+if not state then
+	return ''
+end
+if state ~= 'sold' then
+	return state
+end
+local fields = redis.call('HGETALL', KEYS[1])
+for i = 1, #fields, 2 do
+	if fields[i] ~= 'state' and redis.call('EXISTS', fields[i]) == 1 then
+		redis.call('INCRBY', fields[i], fields[i + 1])
+	end
+end
+if ARGV[1] == '1' then
+	redis.call('DEL', KEYS[1])
+else
+	redis.call('HSET', KEYS[1], 'state', 'cancelled')
+end
+return state
+`)
+
+// claimScript KEYS[1] 订单 key
+var claimScript = redis.NewScript(`
+local state = redis.call('HGET', KEYS[1], 'state')
+if not state then
+	return ''
+end
+if state == 'sold' then
+	redis.call('HSET', KEYS[1], 'state', 'persisted')
+end
+return state
+`)
+
+// releaseScript KEYS[1] 订单 key
+var releaseScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'state') == 'persisted' then
+	redis.call('HSET', KEYS[1], 'state', 'sold')
+end
+return 1
+`)
+
+// restoreScript KEYS 商品库存 key；ARGV 对应的加回数量
+var restoreScript = redis.NewScript(`
+for i = 1, #KEYS do
+	if redis.call('EXISTS', KEYS[i]) == 1 then
+		redis.call('INCRBY', KEYS[i], ARGV[i])
+	end
+end
+return 1
+`)
+
+type flashSales struct {
+	client redis.UniversalClient
+}
+
+func newFlashSales(factory *mysqlStore) *flashSales {
+	return &flashSales{client: factory.redis}
+}
+
+func flashStockKey(goodsID int32) string {
+	return fmt.Sprintf("%s%d", flashStockKeyPrefix, goodsID)
+}
+
+func flashOrderKey(ordersn string) string {
+	return flashOrderKeyPrefix + ordersn
+}
+
+func (f *flashSales) Preload(ctx context.Context, goodsID int32, stock int32) error {
+	ok, err := f.client.SetNX(ctx, flashStockKey(goodsID), stock, 0).Result()
+	if err != nil {
+		return errors.WithCode(code.ErrRedisLock, "写入秒杀库存失败: %v", err)
+	}
+	if !ok {
+		return errors.WithCode(code.ErrFlashSaleRunning, "商品%d正在秒杀中，先结束秒杀再重新预热", goodsID)
+	}
+	return nil
+}
+
+func (f *flashSales) Stop(ctx context.Context, goodsID int32) error {
+	if err := f.client.Del(ctx, flashStockKey(goodsID)).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "删除秒杀库存失败: %v", err)
+	}
+	return nil
+}
+
+func (f *flashSales) Flagged(ctx context.Context, goodsIDs []int32) (map[int32]bool, error) {
+	ret := make(map[int32]bool, len(goodsIDs))
+	if len(goodsIDs) == 0 {
+		return ret, nil
+	}
+	keys := make([]string, 0, len(goodsIDs))
+	for _, id := range goodsIDs {
+		keys = append(keys, flashStockKey(id))
+	}
+	values, err := f.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.WithCode(code.ErrRedisLock, "查询秒杀库存失败: %v", err)
+	}
+	for i, v := range values {
+		if v != nil {
+			ret[goodsIDs[i]] = true
+		}
+	}
+	return ret, nil
+}
+
+func (f *flashSales) Deduct(ctx context.Context, ordersn string, detail []do.GoodsDetail) (do.FlashDeductResult, error) {
+	keys := make([]string, 0, len(detail)+1)
+	args := make([]interface{}, 0, len(detail)+1)
+	keys = append(keys, flashOrderKey(ordersn))
+	args = append(args, int64(flashOrderTTL/time.Second))
+	// 同一商品出现多次时合并，脚本按合并后的数量检查
+	index := make(map[int32]int, len(detail))
+	for _, goodsInfo := range detail {
+		if i, ok := index[goodsInfo.GoodId]; ok {
+			args[i] = args[i].(int32) + goodsInfo.Num
+			continue
+		}
+		index[goodsInfo.GoodId] = len(args)
+		keys = append(keys, flashStockKey(goodsInfo.GoodId))
+		args = append(args, goodsInfo.Num)
+	}
+	result, err := deductScript.Run(ctx, f.client, keys, args...).Int()
+	if err != nil {
+		return 0, errors.WithCode(code.ErrRedisLock, "秒杀扣减失败: %v", err)
+	}
+	return do.FlashDeductResult(result), nil
+}
+
+func (f *flashSales) Cancel(ctx context.Context, ordersn string) (string, error) {
+	state, err := cancelScript.Run(ctx, f.client, []string{flashOrderKey(ordersn)}, 0).Text()
+	if err != nil {
+		return "", errors.WithCode(code.ErrRedisLock, "秒杀订单归还失败: %v", err)
+	}
+	return state, nil
+}
+
+func (f *flashSales) Revert(ctx context.Context, ordersn string) error {
+	if err := cancelScript.Run(ctx, f.client, []string{flashOrderKey(ordersn)}, 1).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "撤销秒杀扣减失败: %v", err)
+	}
+	return nil
+}
+
+func (f *flashSales) Claim(ctx context.Context, ordersn string) (string, error) {
+	state, err := claimScript.Run(ctx, f.client, []string{flashOrderKey(ordersn)}).Text()
+	if err != nil {
+		return "", errors.WithCode(code.ErrRedisLock, "认领秒杀订单失败: %v", err)
+	}
+	return state, nil
+}
+
+func (f *flashSales) Release(ctx context.Context, ordersn string) error {
+	if err := releaseScript.Run(ctx, f.client, []string{flashOrderKey(ordersn)}).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "放弃认领秒杀订单失败: %v", err)
+	}
+	return nil
+}
+
+func (f *flashSales) Restore(ctx context.Context, detail []do.GoodsDetail) error {
+	if len(detail) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(detail))
+	args := make([]interface{}, 0, len(detail))
+	for _, goodsInfo := range detail {
+		keys = append(keys, flashStockKey(goodsInfo.GoodId))
+		args = append(args, goodsInfo.Num)
+	}
+	if err := restoreScript.Run(ctx, f.client, keys, args...).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "加回秒杀库存失败: %v", err)
+	}
+	return nil
+}
+
+var _ v1.FlashSaleStore = &flashSales{}
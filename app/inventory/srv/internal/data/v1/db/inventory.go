@@ -16,6 +16,7 @@ import (
 	"Advanced_Shop/app/inventory/srv/internal/data/v1"
 	"Advanced_Shop/pkg/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type inventorys struct {
@@ -53,12 +54,166 @@ func (i *inventorys) GetSellDetail(ctx context.Context, txn *gorm.DB, ordersn st
 	return &orderSellDetail, err
 }
 
-func (i *inventorys) Reduce(ctx context.Context, txn *gorm.DB, goodsID uint64, num int) error {
+func (i *inventorys) ListStaleSellDetails(ctx context.Context, before time.Time, afterID int32, limit int) ([]*do.StockSellDetailDO, error) {
+	var details []*do.StockSellDetailDO
+	err := i.db.Where("id > ? AND add_time < ? AND status IN (?)",
+		afterID, before, []int{do.StockSellStatusPending, do.StockSellStatusProcessing}).
+		Order("id").Limit(limit).Find(&details).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return details, nil
+}
+
+func (i *inventorys) Reduce(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) (int64, error) {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	// TCC 冻结不持有商品锁，分配之后可售库存可能已被冻结，条件不满足时不更新任何行
+	result := db.Model(&do.InventoryDO{}).
+		Where("goods = ? AND sku = ? AND warehouse = ?", goodsID, skuID, warehouseID).
+		Where("stock - frozen >= ?", num).
+		UpdateColumn("stock", gorm.Expr("stock - ?", num))
+	if result.Error != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+func (i *inventorys) Adjust(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) (int64, error) {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	// 调减时不能把冻结中的库存也减掉
+	result := db.Model(&do.InventoryDO{}).
+		Where("goods = ? AND sku = ? AND warehouse = ?", goodsID, skuID, warehouseID).
+		Where("stock + ? >= frozen", num).
+		UpdateColumn("stock", gorm.Expr("stock + ?", num))
+	if result.Error != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+func (i *inventorys) Freeze(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) (int64, error) {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	// 条件更新保证原子性：只有可售库存足够时才会冻结成功
+	result := db.Model(&do.InventoryDO{}).
+		Where("goods = ? AND sku = ? AND warehouse = ?", goodsID, skuID, warehouseID).
+		Where("stock - frozen >= ?", num).
+		UpdateColumn("frozen", gorm.Expr("frozen + ?", num))
+	if result.Error != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+func (i *inventorys) Unfreeze(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) error {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	result := db.Model(&do.InventoryDO{}).
+		Where("goods = ? AND sku = ? AND warehouse = ?", goodsID, skuID, warehouseID).
+		Where("frozen >= ?", num).
+		UpdateColumn("frozen", gorm.Expr("frozen - ?", num))
+	if result.Error != nil {
+		return errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return errors.WithCode(code.ErrInventoryNotFound, "商品%d规格%d冻结库存不足以释放", goodsID, skuID)
+	}
+	return nil
+}
+
+func (i *inventorys) ConfirmFrozen(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) error {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	result := db.Model(&do.InventoryDO{}).
+		Where("goods = ? AND sku = ? AND warehouse = ?", goodsID, skuID, warehouseID).
+		Where("frozen >= ?", num).
+		Updates(map[string]interface{}{
+			"stock":   gorm.Expr("stock - ?", num),
+			"frozen":  gorm.Expr("frozen - ?", num),
+			"version": gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return errors.WithCode(code.ErrInventoryNotFound, "商品%d规格%d冻结库存不足以确认", goodsID, skuID)
+	}
+	return nil
+}
+
+func (i *inventorys) CreateReservations(ctx context.Context, txn *gorm.DB, reservations []*do.StockReservationDO) error {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	if err := db.CreateInBatches(reservations, 100).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (i *inventorys) ListReservations(ctx context.Context, txn *gorm.DB, ordersn string) ([]*do.StockReservationDO, error) {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	var reservations []*do.StockReservationDO
+	if err := db.Where("order_sn = ?", ordersn).Order("goods, sku, warehouse").Find(&reservations).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return reservations, nil
+}
+
+func (i *inventorys) GetThresholds(ctx context.Context, goodsIDs []uint64) (map[int32]int32, error) {
+	ret := make(map[int32]int32, len(goodsIDs))
+	if len(goodsIDs) == 0 {
+		return ret, nil
+	}
+	var thresholds []*do.StockThresholdDO
+	if err := i.db.Where("goods IN ?", goodsIDs).Find(&thresholds).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	for _, t := range thresholds {
+		ret[t.Goods] = t.LowWater
+	}
+	return ret, nil
+}
+
+func (i *inventorys) SaveThreshold(ctx context.Context, threshold *do.StockThresholdDO) error {
+	err := i.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "goods"}},
+		DoUpdates: clause.AssignmentColumns([]string{"low_water", "update_time"}),
+	}).Create(threshold).Error
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (i *inventorys) UpdateReservationStatus(ctx context.Context, txn *gorm.DB, ordersn string, from, to int32) (int64, error) {
 	db := i.db
 	if txn != nil {
 		db = txn
 	}
-	return db.Model(&do.InventoryDO{}).Where("goods=?", goodsID).Where("stock >= ?", num).UpdateColumn("stock", gorm.Expr("stock - ?", num)).Error
+	result := db.Model(&do.StockReservationDO{}).
+		Where("order_sn = ? AND status = ?", ordersn, from).
+		Update("status", to)
+	if result.Error != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
 }
 
 func (i *inventorys) IncreaseSLock(ctx context.Context, txn *gorm.DB, inventory *do.InventoryDO) (int64, error) {
@@ -67,7 +222,7 @@ func (i *inventorys) IncreaseSLock(ctx context.Context, txn *gorm.DB, inventory
 		db = txn
 	}
 	tx := db.Model(do.InventoryDO{}).
-		Where("goods = ? and version = ?", inventory.Goods, inventory.Version).
+		Where("goods = ? and sku = ? and warehouse = ? and version = ?", inventory.Goods, inventory.Sku, inventory.Warehouse, inventory.Version).
 		Select("stock", "version").
 		Updates(map[string]interface{}{"stock": inventory.Stock, "version": inventory.Version + 1})
 	// 查不到 也不报错
@@ -80,7 +235,7 @@ func (i *inventorys) Increase(ctx context.Context, txn *gorm.DB, inventory *do.I
 	if txn != nil {
 		db = txn
 	}
-	err := db.Model(do.InventoryDO{}).Where("goods = ?", inventory.Goods).Update("stock", inventory.Stock).Error
+	err := db.Model(do.InventoryDO{}).Where("goods = ? AND sku = ? AND warehouse = ?", inventory.Goods, inventory.Sku, inventory.Warehouse).Update("stock", inventory.Stock).Error
 	if err != nil {
 		log.Errorf("increase inventory stock error: %v", err)
 		return errors.WithCode(code2.ErrDatabase, "increase inventory stock error")
@@ -102,18 +257,33 @@ func (i *inventorys) CreateStockSellDetail(ctx context.Context, txn *gorm.DB, de
 	return nil
 }
 
-func (i *inventorys) Create(ctx context.Context, inv *do.InventoryDO) error {
+func (i *inventorys) Create(ctx context.Context, txn *gorm.DB, inv *do.InventoryDO) error {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
 	//设置库存， 如果我要更新库存
-	tx := i.db.Create(&inv)
+	tx := db.Create(&inv)
 	if tx.Error != nil {
 		return errors.WithCode(code2.ErrDatabase, tx.Error.Error())
 	}
 	return nil
 }
 
-func (i *inventorys) Get(ctx context.Context, goodsID uint64) (*do.InventoryDO, error) {
+// sumColumns 多仓库汇总时查询的列，skuSumColumns 按规格汇总时使用
+const (
+	sumColumns    = "goods, SUM(stock) AS stock, SUM(frozen) AS frozen"
+	skuSumColumns = "goods, sku, SUM(stock) AS stock, SUM(frozen) AS frozen"
+)
+
+func (i *inventorys) Get(ctx context.Context, goodsID, skuID uint64) (*do.InventoryDO, error) {
 	inv := do.InventoryDO{}
-	err := i.db.Where("goods = ?", goodsID).First(&inv).Error
+	query := i.db.Model(&do.InventoryDO{}).Select(sumColumns).Where("goods = ?", goodsID).Group("goods")
+	if skuID != 0 {
+		query = i.db.Model(&do.InventoryDO{}).Select(skuSumColumns).
+			Where("goods = ? AND sku = ?", goodsID, skuID).Group("goods, sku")
+	}
+	err := query.Take(&inv).Error
 	if err != nil {
 		log.Errorf("get inv err: %v", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -126,9 +296,41 @@ func (i *inventorys) Get(ctx context.Context, goodsID uint64) (*do.InventoryDO,
 	return &inv, nil
 }
 
-func (i *inventorys) GetWithTx(ctx context.Context, txn *gorm.DB, goodsID uint64) (*do.InventoryDO, error) {
+func (i *inventorys) BatchGet(ctx context.Context, goodsIDs []uint64, bySku bool) ([]*do.InventoryDO, error) {
+	var invs []*do.InventoryDO
+	if len(goodsIDs) == 0 {
+		return invs, nil
+	}
+	columns, group := sumColumns, "goods"
+	if bySku {
+		columns, group = skuSumColumns, "goods, sku"
+	}
+	err := i.db.Model(&do.InventoryDO{}).Select(columns).
+		Where("goods IN ?", goodsIDs).Group(group).Find(&invs).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return invs, nil
+}
+
+func (i *inventorys) ListByGoods(ctx context.Context, txn *gorm.DB, goodsID, skuID uint64) ([]*do.InventoryDO, error) {
+	db := i.db
+	if txn != nil {
+		db = txn
+	}
+	var invs []*do.InventoryDO
+	if err := db.Where("goods = ? AND sku = ?", goodsID, skuID).Order("warehouse").Find(&invs).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	if len(invs) == 0 {
+		return nil, errors.WithCode(code.ErrInventoryNotFound, "商品%d规格%d没有库存记录", goodsID, skuID)
+	}
+	return invs, nil
+}
+
+func (i *inventorys) GetWithTx(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64) (*do.InventoryDO, error) {
 	inv := do.InventoryDO{}
-	err := txn.Where("goods = ?", goodsID).First(&inv).Error
+	err := txn.Where("goods = ? AND sku = ? AND warehouse = ?", goodsID, skuID, warehouseID).First(&inv).Error
 	if err != nil {
 		log.Errorf("get inv err: %v", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -175,6 +377,14 @@ func (i *inventorys) AutoReback(ctx context.Context, txn *gorm.DB, orderSn strin
 	).Take(&history).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// TCC 模式下未支付的订单只有预留记录，释放冻结库存即可
+		released, err := i.releaseReservations(ctx, txn, orderSn)
+		if err != nil {
+			return do.OptionFail, fmt.Errorf("释放冻结库存失败: %w", err)
+		}
+		if released {
+			return do.Continuing, nil
+		}
 		// 没有待处理记录，说明已经处理完成（被其他节点处理）
 		log.Infof("未找到待处理归还记录，幂等跳过，OrderSn: %s", orderSn)
 		return do.DirectPass, nil
@@ -241,17 +451,53 @@ func (i *inventorys) AutoReback(ctx context.Context, txn *gorm.DB, orderSn strin
 	return do.Continuing, nil
 }
 
+// releaseReservations 释放订单仍处于冻结状态的预留库存，没有可释放的记录时返回 false
+func (i *inventorys) releaseReservations(ctx context.Context, txn *gorm.DB, orderSn string) (bool, error) {
+	reservations, err := i.ListReservations(ctx, txn, orderSn)
+	if err != nil {
+		return false, err
+	}
+	var frozen []*do.StockReservationDO
+	for _, r := range reservations {
+		if r.Status == do.ReservationStatusFrozen {
+			frozen = append(frozen, r)
+		}
+	}
+	if len(frozen) == 0 {
+		return false, nil
+	}
+
+	// 先抢占状态，影响行数不一致说明被其他节点处理了
+	rows, err := i.UpdateReservationStatus(ctx, txn, orderSn, do.ReservationStatusFrozen, do.ReservationStatusCancelled)
+	if err != nil {
+		return false, err
+	}
+	if rows != int64(len(frozen)) {
+		return false, fmt.Errorf("预留记录被其他节点抢占，OrderSn: %s", orderSn)
+	}
+
+	for _, r := range frozen {
+		if err := i.Unfreeze(ctx, txn, uint64(r.Goods), uint64(r.Sku), uint64(r.Warehouse), int(r.Num)); err != nil {
+			return false, err
+		}
+	}
+	log.Infof("订单%s冻结库存释放成功", orderSn)
+	return true, nil
+}
+
 // ==================== Redis 分布式锁执行归还 ====================
 func Reback(ctx context.Context, tx *gorm.DB, info *do.RebackInfo, pool redsyncredis.Pool) error {
 	rs := redsync.New(pool)
 	//  GoodsDetailList 就是 []GoodsDetail，直接强转，复用已有排序接口
 	sort.Sort(do.GoodsDetailList(info.GoodsInfo))
+	goodsIDs := do.GoodsDetailList(info.GoodsInfo).GoodsIDs()
 
 	// 按排好序的顺序逐个拿锁，拿锁失败则释放已持有的，避免泄漏
-	mutexes := make([]*redsync.Mutex, 0, len(info.GoodsInfo))
-	for _, invInfo := range info.GoodsInfo {
+	// 锁是商品维度的，同一商品拆分到多个仓库时只加一次
+	mutexes := make([]*redsync.Mutex, 0, len(goodsIDs))
+	for _, goodsID := range goodsIDs {
 		mutex := rs.NewMutex(
-			do.InventoryLockPrefix+strconv.FormatInt(int64(invInfo.GoodId), 10),
+			do.InventoryLockPrefix+strconv.FormatInt(int64(goodsID), 10),
 			redsync.WithExpiry(8*time.Second),
 			redsync.WithTries(3),
 			redsync.WithRetryDelay(100*time.Millisecond),
@@ -262,7 +508,7 @@ func Reback(ctx context.Context, tx *gorm.DB, info *do.RebackInfo, pool redsyncr
 					log.Errorf("归还释放锁失败，OrderSn: %s, err: %v", info.OrderSn, unlockErr)
 				}
 			}
-			return fmt.Errorf("商品%d获取分布式锁失败: %w", invInfo.GoodId, err)
+			return fmt.Errorf("商品%d获取分布式锁失败: %w", goodsID, err)
 		}
 		mutexes = append(mutexes, mutex)
 	}
@@ -278,7 +524,7 @@ func Reback(ctx context.Context, tx *gorm.DB, info *do.RebackInfo, pool redsyncr
 
 	// 持锁后直接执行，无需乐观锁重试
 	for _, invInfo := range info.GoodsInfo {
-		if err := rebackSingleGoods(ctx, tx, invInfo); err != nil {
+		if err := rebackSingleGoods(ctx, tx, info.OrderSn, invInfo); err != nil {
 			return fmt.Errorf("商品%d库存归还失败: %w", invInfo.GoodId, err)
 		}
 	}
@@ -286,18 +532,18 @@ func Reback(ctx context.Context, tx *gorm.DB, info *do.RebackInfo, pool redsyncr
 }
 
 // ==================== 单个商品归还（持锁后直接读写）====================
-func rebackSingleGoods(ctx context.Context, tx *gorm.DB, invInfo do.GoodsDetail) error {
+func rebackSingleGoods(ctx context.Context, tx *gorm.DB, orderSn string, invInfo do.GoodsDetail) error {
 
 	var model do.InventoryDO
-	if err := tx.Where("goods = ?", invInfo.GoodId).Take(&model).Error; err != nil {
-		return fmt.Errorf("查询商品库存失败，goodsId: %d, err: %w", invInfo.GoodId, err)
+	if err := tx.Where("goods = ? AND sku = ? AND warehouse = ?", invInfo.GoodId, invInfo.Sku, invInfo.Warehouse).Take(&model).Error; err != nil {
+		return fmt.Errorf("查询商品库存失败，goodsId: %d, warehouse: %d, err: %w", invInfo.GoodId, invInfo.Warehouse, err)
 	}
 
 	newStock := model.Stock + invInfo.Num
 
 	// ✅ 持有分布式锁，并发安全，不需要 version 作为更新条件
 	result := tx.Model(&do.InventoryDO{}).
-		Where("goods = ?", model.Goods).
+		Where("goods = ? AND sku = ? AND warehouse = ?", model.Goods, model.Sku, model.Warehouse).
 		Updates(map[string]interface{}{
 			"stock":   newStock,
 			"version": model.Version + 1, // 依然自增，保留审计语义
@@ -311,8 +557,20 @@ func rebackSingleGoods(ctx context.Context, tx *gorm.DB, invInfo do.GoodsDetail)
 		return fmt.Errorf("商品%d库存记录不存在", invInfo.GoodId)
 	}
 
-	log.Infof("商品%d库存归还成功，归还数量: %d，新库存: %d",
-		invInfo.GoodId, invInfo.Num, newStock)
+	err := appendLedger(tx, &do.InventoryLedgerDO{
+		Goods:     invInfo.GoodId,
+		Sku:       invInfo.Sku,
+		Warehouse: invInfo.Warehouse,
+		OrderSn:   orderSn,
+		Type:      do.LedgerTypeAutoReback,
+		Change:    invInfo.Num,
+	})
+	if err != nil {
+		return fmt.Errorf("写入库存流水失败，goodsId: %d, err: %w", invInfo.GoodId, err)
+	}
+
+	log.Infof("商品%d仓库%d库存归还成功，归还数量: %d，新库存: %d",
+		invInfo.GoodId, invInfo.Warehouse, invInfo.Num, newStock)
 	return nil
 }
 
@@ -0,0 +1,84 @@
+package mysql
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	code2 "Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+)
+
+type ledgers struct {
+	db *gorm.DB
+}
+
+func (l *ledgers) Append(ctx context.Context, txn *gorm.DB, entries ...*do.InventoryLedgerDO) error {
+	db := l.db
+	if txn != nil {
+		db = txn
+	}
+	return appendLedger(db, entries...)
+}
+
+func (l *ledgers) List(ctx context.Context, filter do.LedgerFilter, meta metav1.ListMeta) (*do.InventoryLedgerDOList, error) {
+	ret := &do.InventoryLedgerDOList{}
+
+	query := l.db.Model(&do.InventoryLedgerDO{})
+	if filter.Goods != 0 {
+		query = query.Where("goods = ?", filter.Goods)
+	}
+	if filter.OrderSn != "" {
+		query = query.Where("order_sn = ?", filter.OrderSn)
+	}
+	if !filter.Start.IsZero() {
+		query = query.Where("add_time >= ?", filter.Start)
+	}
+	if !filter.End.IsZero() {
+		query = query.Where("add_time < ?", filter.End)
+	}
+
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	err := query.Order("id desc").Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (l *ledgers) SumByOrder(ctx context.Context, txn *gorm.DB, orderSn, typ string) (do.GoodsDetailList, error) {
+	db := l.db
+	if txn != nil {
+		db = txn
+	}
+	var ret do.GoodsDetailList
+	err := db.Model(&do.InventoryLedgerDO{}).
+		Select("goods AS good_id, sku, warehouse, SUM(`change`) AS num").
+		Where("order_sn = ? AND type = ?", orderSn, typ).
+		Group("goods, sku, warehouse").
+		Scan(&ret).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+// appendLedger 写入库存流水，供没有 store 实例的归还逻辑复用
+func appendLedger(tx *gorm.DB, entries ...*do.InventoryLedgerDO) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := tx.Create(entries).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func newLedgers(data *mysqlStore) *ledgers {
+	return &ledgers{db: data.db}
+}
+
+var _ v1.LedgerStore = &ledgers{}
@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	orderpb "Advanced_Shop/api/order/v1"
 	v12 "Advanced_Shop/app/inventory/srv/internal/data/v1"
 	"Advanced_Shop/app/inventory/srv/internal/domain/do"
 	"Advanced_Shop/app/pkg/options"
@@ -27,15 +28,43 @@ import (
 )
 
 type mysqlStore struct {
-	db     *gorm.DB
-	mqOpts *options.RocketMQOptions
-	pool   redsyncredis.Pool
+	db       *gorm.DB
+	mqOpts   *options.RocketMQOptions
+	pool     redsyncredis.Pool
+	redis    redis.UniversalClient
+	producer *producer
+	oc       orderpb.OrderClient
+	onReback v12.RebackHandler
 }
 
 func (m *mysqlStore) Inventorys() v12.InventoryStore {
 	return newInventorys(m)
 }
 
+func (m *mysqlStore) Warehouses() v12.WarehouseStore {
+	return newWarehouses(m)
+}
+
+func (m *mysqlStore) Ledgers() v12.LedgerStore {
+	return newLedgers(m)
+}
+
+func (m *mysqlStore) FlashSales() v12.FlashSaleStore {
+	return newFlashSales(m)
+}
+
+func (m *mysqlStore) Reconciles() v12.ReconcileStore {
+	return newReconciles(m)
+}
+
+func (m *mysqlStore) Orders() orderpb.OrderClient {
+	return m.oc
+}
+
+func (m *mysqlStore) Producer() v12.MQProducer {
+	return m.producer
+}
+
 var _ v12.DataFactory = &mysqlStore{}
 
 var (
@@ -44,7 +73,7 @@ var (
 )
 
 // GetDBFactoryOr 对于复杂的初始化过程，使用工厂模式
-func GetDBFactoryOr(mysqlOpts *options.MySQLOptions, mqOpts *options.RocketMQOptions) (v12.DataFactory, error) {
+func GetDBFactoryOr(mysqlOpts *options.MySQLOptions, mqOpts *options.RocketMQOptions, registry *options.RegistryOptions) (v12.DataFactory, error) {
 	if mysqlOpts == nil && dbFactory == nil {
 		return nil, fmt.Errorf("failed to get mysql store fatory")
 	}
@@ -92,13 +121,18 @@ func GetDBFactoryOr(mysqlOpts *options.MySQLOptions, mqOpts *options.RocketMQOpt
 		zlog.Info("✅ Redis客户端复用成功，认证通过")
 
 		// 第三步：基于已认证的客户端创建redsync的Pool（仅此一个即可）
-		pool := goredis.NewPool(redisClient.(redis.UniversalClient))
+		universalClient := redisClient.(redis.UniversalClient)
+		pool := goredis.NewPool(universalClient)
 
 		sqlDB, _ := db.DB()
 		dbFactory = &mysqlStore{
-			db:     db,
-			mqOpts: mqOpts,
-			pool:   pool,
+			db:       db,
+			mqOpts:   mqOpts,
+			pool:     pool,
+			redis:    universalClient,
+			producer: newProducer(mqOpts),
+			// 服务发现，对账时查询订单状态
+			oc: GetOrderClient(registry),
 		}
 
 		sqlDB.SetMaxOpenConns(mysqlOpts.MaxOpenConnections)
@@ -124,10 +158,9 @@ func (ds *mysqlStore) DB() *gorm.DB {
 	return ds.db
 }
 
-func (m *mysqlStore) Listen(ctx context.Context) {
-
-	mqConsumer, err := rocketmq.NewPushConsumer(consumer.WithNameServer([]string{m.mqOpts.Addr()}),
-		consumer.WithGroupName(m.mqOpts.ConsumerGroupName),
+func (m *mysqlStore) newPushConsumer(group string) (rocketmq.PushConsumer, error) {
+	return rocketmq.NewPushConsumer(consumer.WithNameServer([]string{m.mqOpts.Addr()}),
+		consumer.WithGroupName(group),
 		// 最大重试次数
 		// -1表示使用默认值16次，这里显式设置为3次
 		consumer.WithMaxReconsumeTimes(int32(m.mqOpts.MaxRetryTimes)),
@@ -142,24 +175,10 @@ func (m *mysqlStore) Listen(ctx context.Context) {
 		// 批量消费大小（可选，每次最多消费1条，保证幂等）
 		consumer.WithConsumeMessageBatchMaxSize(1),
 	)
-	if err != nil {
-		panic(err)
-	}
-
-	// 订阅 Topi 普通归还消息（订单超时的库存归还）
-	err = mqConsumer.Subscribe(m.mqOpts.ConsumerTopic, consumer.MessageSelector{}, m.AutoReBack)
-	if err != nil {
-		zlog.Errorf("订阅普通归还Topic失败 %v", err)
-		panic(err)
-	}
-
-	// 启动消费者
-	if err = mqConsumer.Start(); err != nil {
-		zlog.Errorf("启动RocketMQ消费者失败 %v", err)
-		panic(err)
-	}
+}
 
-	// 优雅退出
+// shutdownOnDone ctx 结束时优雅关闭消费者
+func shutdownOnDone(ctx context.Context, mqConsumer rocketmq.PushConsumer) {
 	go func() {
 		<-ctx.Done()
 		// 用超时机制包装Shutdown，避免无限阻塞
@@ -181,10 +200,76 @@ func (m *mysqlStore) Listen(ctx context.Context) {
 			zlog.Error("RocketMQ消费者关闭超时（10秒），强制退出")
 		}
 	}()
+}
+
+func (m *mysqlStore) Listen(ctx context.Context, handler v12.RebackHandler) {
+	m.onReback = handler
+
+	mqConsumer, err := m.newPushConsumer(m.mqOpts.ConsumerGroupName)
+	if err != nil {
+		panic(err)
+	}
+
+	// 订阅 Topi 普通归还消息（订单超时的库存归还）
+	err = mqConsumer.Subscribe(m.mqOpts.ConsumerTopic, consumer.MessageSelector{}, m.AutoReBack)
+	if err != nil {
+		zlog.Errorf("订阅普通归还Topic失败 %v", err)
+		panic(err)
+	}
+
+	// 启动消费者
+	if err = mqConsumer.Start(); err != nil {
+		zlog.Errorf("启动RocketMQ消费者失败 %v", err)
+		panic(err)
+	}
+
+	// 优雅退出
+	shutdownOnDone(ctx, mqConsumer)
 	zlog.Infof("库存服务MQ消费者启动成功，监听Topic： %v", m.mqOpts.ConsumerTopic)
 
 }
 
+// ListenFlashSale 秒杀写回使用独立的消费组，与订单超时归还互不影响
+func (m *mysqlStore) ListenFlashSale(ctx context.Context, handler v12.FlashSaleHandler) {
+	mqConsumer, err := m.newPushConsumer(m.mqOpts.ConsumerGroupName + "_flash_sale")
+	if err != nil {
+		panic(err)
+	}
+
+	err = mqConsumer.Subscribe(m.mqOpts.FlashSaleTopic, consumer.MessageSelector{},
+		func(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+			needRetry := false
+			for _, msg := range msgs {
+				var flashMsg do.FlashSaleMessage
+				if err := json.Unmarshal(msg.Body, &flashMsg); err != nil {
+					// 消息体损坏，重试无意义
+					zlog.Errorf("秒杀写回消息解析失败，msgId: %s, body: %s, err: %v", msg.MsgId, string(msg.Body), err)
+					continue
+				}
+				if err := handler(ctx, &flashMsg); err != nil {
+					zlog.Errorf("秒杀写回失败，msgId: %s, OrderSn: %s, err: %v", msg.MsgId, flashMsg.OrderSn, err)
+					needRetry = true
+				}
+			}
+			if needRetry {
+				return consumer.ConsumeRetryLater, nil
+			}
+			return consumer.ConsumeSuccess, nil
+		})
+	if err != nil {
+		zlog.Errorf("订阅秒杀写回Topic失败 %v", err)
+		panic(err)
+	}
+
+	if err = mqConsumer.Start(); err != nil {
+		zlog.Errorf("启动秒杀写回消费者失败 %v", err)
+		panic(err)
+	}
+
+	shutdownOnDone(ctx, mqConsumer)
+	zlog.Infof("秒杀写回消费者启动成功，监听Topic： %v", m.mqOpts.FlashSaleTopic)
+}
+
 // AutoReBack ==================== MQ消费入口 ====================
 func (m *mysqlStore) AutoReBack(ctx context.Context, msg ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
 
@@ -261,5 +346,8 @@ func (m *mysqlStore) processOneMessage(ctx context.Context, msg *primitive.Messa
 	}
 	committed = true
 	zlog.Infof("库存归还成功，OrderSn: %s", orderInfo.OrderSns)
+	if m.onReback != nil {
+		m.onReback(ctx, orderInfo.OrderSns)
+	}
 	return nil
 }
@@ -0,0 +1,45 @@
+package mysql
+
+import (
+	orderpb "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/gnova/registry/consul"
+	"Advanced_Shop/gnova/server/rpcserver"
+	"Advanced_Shop/gnova/server/rpcserver/clientinterceptors"
+	"context"
+	cosulAPI "github.com/hashicorp/consul/api"
+
+	"Advanced_Shop/gnova/registry"
+)
+
+const orderserviceName = "discovery:///xshop-order-srv"
+
+func NewDiscovery(opts *options.RegistryOptions) registry.Discovery {
+	c := cosulAPI.DefaultConfig()
+	c.Address = opts.Address
+	c.Scheme = opts.Scheme
+	cli, err := cosulAPI.NewClient(c)
+	if err != nil {
+		panic(err)
+	}
+	r := consul.New(cli, consul.WithHealthCheck(true))
+	return r
+}
+
+func GetOrderClient(opts *options.RegistryOptions) orderpb.OrderClient {
+	discovery := NewDiscovery(opts)
+	return NewOrderServiceClient(discovery)
+}
+
+func NewOrderServiceClient(r registry.Discovery) orderpb.OrderClient {
+	conn, err := rpcserver.DialInsecure(
+		context.Background(),
+		rpcserver.WithEndpoint(orderserviceName),
+		rpcserver.WithDiscovery(r),
+		rpcserver.WithClientUnaryInterceptor(clientinterceptors.UnaryTracingInterceptor),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return orderpb.NewOrderClient(conn)
+}
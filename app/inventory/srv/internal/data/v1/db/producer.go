@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/message"
+	"Advanced_Shop/app/pkg/options"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	zlog "Advanced_Shop/pkg/log"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	rmqproducer "github.com/apache/rocketmq-client-go/v2/producer"
+	"sync"
+)
+
+// producer 库存服务的 RocketMQ 生产者，第一次发送时才连接，避免不发消息的部署也依赖 MQ
+type producer struct {
+	mqOpts *options.RocketMQOptions
+
+	once     sync.Once
+	producer rocketmq.Producer
+	initErr  error
+}
+
+func newProducer(mqOpts *options.RocketMQOptions) *producer {
+	return &producer{mqOpts: mqOpts}
+}
+
+func (p *producer) init() error {
+	p.once.Do(func() {
+		ins, err := rocketmq.NewProducer(
+			rmqproducer.WithNameServer([]string{p.mqOpts.Addr()}),
+			rmqproducer.WithGroupName(p.mqOpts.GroupName),
+		)
+		if err != nil {
+			p.initErr = errors.WithCode(code2.ErrConnectMQ, fmt.Sprintf("rocketmq生产者创建失败: %v", err))
+			return
+		}
+		if err = ins.Start(); err != nil {
+			p.initErr = errors.WithCode(code2.ErrConnectMQ, fmt.Sprintf("rocketmq生产者启动失败: %v", err))
+			return
+		}
+		p.producer = ins
+		zlog.Info("库存服务RocketMQ生产者初始化成功")
+	})
+	return p.initErr
+}
+
+func (p *producer) SendStockAlert(ctx context.Context, msg *message.StockAlertMessage) error {
+	if err := p.init(); err != nil {
+		return err
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.WithCode(code2.ErrEncodingJSON, fmt.Sprintf("序列化库存告警消息失败: %v", err))
+	}
+	mqMsg := primitive.NewMessage(p.mqOpts.StockAlertTopic, body)
+	mqMsg.WithKeys([]string{fmt.Sprintf("goods_%d", msg.GoodsId)})
+	mqMsg.WithTag(msg.Event)
+
+	result, err := p.producer.SendSync(ctx, mqMsg)
+	if err != nil {
+		return errors.WithCode(code2.ErrConnectMQ, fmt.Sprintf("发送库存告警消息失败: %v", err))
+	}
+	zlog.Infof("发送库存告警消息成功, goods: %d, event: %s, msgID: %s", msg.GoodsId, msg.Event, result.MsgID)
+	return nil
+}
+
+func (p *producer) SendFlashSale(ctx context.Context, msg *do.FlashSaleMessage) error {
+	if err := p.init(); err != nil {
+		return err
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.WithCode(code2.ErrEncodingJSON, fmt.Sprintf("序列化秒杀写回消息失败: %v", err))
+	}
+	mqMsg := primitive.NewMessage(p.mqOpts.FlashSaleTopic, body)
+	mqMsg.WithKeys([]string{msg.OrderSn})
+
+	result, err := p.producer.SendSync(ctx, mqMsg)
+	if err != nil {
+		return errors.WithCode(code2.ErrConnectMQ, fmt.Sprintf("发送秒杀写回消息失败: %v", err))
+	}
+	zlog.Infof("发送秒杀写回消息成功, OrderSn: %s, msgID: %s", msg.OrderSn, result.MsgID)
+	return nil
+}
+
+var _ v1.MQProducer = &producer{}
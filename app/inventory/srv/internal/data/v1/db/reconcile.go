@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+)
+
+type reconciles struct {
+	db *gorm.DB
+}
+
+func (r *reconciles) CreateRun(ctx context.Context, run *do.ReconcileRunDO) error {
+	if err := r.db.Create(run).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (r *reconciles) UpdateRun(ctx context.Context, run *do.ReconcileRunDO) error {
+	if err := r.db.Save(run).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (r *reconciles) GetRun(ctx context.Context, id uint64) (*do.ReconcileRunDO, error) {
+	run := &do.ReconcileRunDO{}
+	query := r.db
+	if id != 0 {
+		query = query.Where("id = ?", id)
+	} else {
+		query = query.Order("id desc")
+	}
+	err := query.First(run).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrReconcileRunNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return run, nil
+}
+
+func (r *reconciles) AppendItems(ctx context.Context, items ...*do.ReconcileItemDO) error {
+	if len(items) == 0 {
+		return nil
+	}
+	if err := r.db.Create(items).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (r *reconciles) ListItems(ctx context.Context, runID uint64, meta metav1.ListMeta) (*do.ReconcileItemDOList, error) {
+	ret := &do.ReconcileItemDOList{}
+	query := r.db.Model(&do.ReconcileItemDO{}).Where("run = ?", runID)
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	err := query.Order("id").Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func newReconciles(data *mysqlStore) *reconciles {
+	return &reconciles{db: data.db}
+}
+
+var _ v1.ReconcileStore = &reconciles{}
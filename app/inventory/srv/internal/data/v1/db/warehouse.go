@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+)
+
+type warehouses struct {
+	db *gorm.DB
+}
+
+func (w *warehouses) Create(ctx context.Context, warehouse *do.WarehouseDO) error {
+	if err := w.db.Create(warehouse).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (w *warehouses) Get(ctx context.Context, id uint64) (*do.WarehouseDO, error) {
+	warehouse := do.WarehouseDO{}
+	err := w.db.Where("id = ?", id).First(&warehouse).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrWarehouseNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &warehouse, nil
+}
+
+func (w *warehouses) ListByIDs(ctx context.Context, ids []int32) ([]*do.WarehouseDO, error) {
+	var list []*do.WarehouseDO
+	if len(ids) == 0 {
+		return list, nil
+	}
+	if err := w.db.Where("id IN ?", ids).Find(&list).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return list, nil
+}
+
+func newWarehouses(data *mysqlStore) *warehouses {
+	return &warehouses{db: data.db}
+}
+
+var _ v1.WarehouseStore = &warehouses{}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"context"
+)
+
+// FlashSaleStore 秒杀库存，存放在 Redis 中
+type FlashSaleStore interface {
+	// Preload 写入商品的秒杀库存，商品从此走 Redis 扣减
+	// 商品已在秒杀中时不覆盖，Redis 库存里可能还有没写回的扣减
+	Preload(ctx context.Context, goodsID int32, stock int32) error
+
+	// Stop 删除商品的秒杀库存，商品回到 MySQL 扣减
+	Stop(ctx context.Context, goodsID int32) error
+
+	// Flagged 返回给定商品中处于秒杀中的商品
+	Flagged(ctx context.Context, goodsIDs []int32) (map[int32]bool, error)
+
+	// Deduct 一次往返原子扣减整单，任一商品不足时整单不扣
+	Deduct(ctx context.Context, ordersn string, detail []do.GoodsDetail) (do.FlashDeductResult, error)
+
+	// Cancel 写回前归还：状态为 sold 时加回库存并置为 cancelled，返回切换前的状态，订单不存在时返回空串
+	Cancel(ctx context.Context, ordersn string) (string, error)
+
+	// Revert 撤销一次未发出写回消息的扣减，加回库存并删除订单记录，允许重试扣减
+	Revert(ctx context.Context, ordersn string) error
+
+	// Claim 写回消费者认领订单：状态为 sold 时置为 persisted，返回切换前的状态
+	Claim(ctx context.Context, ordersn string) (string, error)
+
+	// Release 写回失败时放弃认领：状态为 persisted 时置回 sold，归还时可以直接在 Redis 内加回
+	Release(ctx context.Context, ordersn string) error
+
+	// Restore 已写回的订单归还到 MySQL 后，同步加回仍在秒杀中的商品的 Redis 库存
+	Restore(ctx context.Context, detail []do.GoodsDetail) error
+}
@@ -5,22 +5,34 @@ import (
 	"context"
 	redsyncredis "github.com/go-redsync/redsync/v4/redis"
 	"gorm.io/gorm"
+	"time"
 )
 
 type InventoryStore interface {
 	// Create 新建库存信息
-	Create(ctx context.Context, inv *do.InventoryDO) error
+	Create(ctx context.Context, txn *gorm.DB, inv *do.InventoryDO) error
 
-	// Get 查询商品的库存信息
-	Get(ctx context.Context, goodsID uint64) (*do.InventoryDO, error)
+	// Get 查询商品的库存信息，多仓库的库存汇总后返回，skuID 为 0 时汇总商品的所有规格
+	Get(ctx context.Context, goodsID, skuID uint64) (*do.InventoryDO, error)
+
+	// BatchGet 批量查询商品的库存信息，多仓库的库存汇总后返回，没有库存记录的商品不返回
+	// bySku 为 true 时每个规格单独返回一条，否则按商品汇总
+	BatchGet(ctx context.Context, goodsIDs []uint64, bySku bool) ([]*do.InventoryDO, error)
+
+	// ListByGoods 查询商品的一个规格在各仓库的库存
+	ListByGoods(ctx context.Context, txn *gorm.DB, goodsID, skuID uint64) ([]*do.InventoryDO, error)
 
 	// GetSellDetail 查询库存销售信息
 	GetSellDetail(ctx context.Context, txn *gorm.DB, ordersn string) (*do.StockSellDetailDO, error)
 
-	// Reduce 扣减库存
-	Reduce(ctx context.Context, txn *gorm.DB, goodsID uint64, num int) error
+	// Reduce 扣减指定仓库的库存，返回影响行数，0 表示可售库存不足
+	Reduce(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) (int64, error)
+
+	// Adjust 人工调整指定仓库的库存，num 可为负数，返回影响行数，0 表示库存记录不存在或调整后低于冻结库存
+	Adjust(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) (int64, error)
 
-	GetWithTx(ctx context.Context, txn *gorm.DB, goodsID uint64) (*do.InventoryDO, error)
+	// GetWithTx 查询商品规格在指定仓库的库存
+	GetWithTx(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64) (*do.InventoryDO, error)
 
 	// IncreaseSLock  乐观锁 新增库存
 	IncreaseSLock(ctx context.Context, txn *gorm.DB, inventory *do.InventoryDO) (int64, error)
@@ -30,8 +42,35 @@ type InventoryStore interface {
 	// CreateStockSellDetail 新增库存销售信息
 	CreateStockSellDetail(ctx context.Context, txn *gorm.DB, detail *do.StockSellDetailDO) error
 
+	// ListStaleSellDetails 按 id 顺序查询 before 之前创建、仍未归还也未确认的扣减记录，afterID 用于分批
+	ListStaleSellDetails(ctx context.Context, before time.Time, afterID int32, limit int) ([]*do.StockSellDetailDO, error)
+
 	// UpdateStockSellDetailStatus 更新库存销售状态
 	UpdateStockSellDetailStatus(ctx context.Context, txn *gorm.DB, ordersn string, status int32) error
 
 	AutoReback(ctx context.Context, txn *gorm.DB, OrderSns string, pool redsyncredis.Pool) (do.MQMessageType, error)
+
+	// Freeze 冻结库存（TCC Try），返回影响行数，0 表示可售库存不足
+	Freeze(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) (int64, error)
+
+	// Unfreeze 释放冻结库存（TCC Cancel）
+	Unfreeze(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) error
+
+	// ConfirmFrozen 冻结库存转为已售（TCC Confirm）
+	ConfirmFrozen(ctx context.Context, txn *gorm.DB, goodsID, skuID, warehouseID uint64, num int) error
+
+	// CreateReservations 新增订单的库存预留记录
+	CreateReservations(ctx context.Context, txn *gorm.DB, reservations []*do.StockReservationDO) error
+
+	// ListReservations 查询订单的库存预留记录
+	ListReservations(ctx context.Context, txn *gorm.DB, ordersn string) ([]*do.StockReservationDO, error)
+
+	// GetThresholds 批量查询商品单独配置的低库存阈值，没有配置的商品不在结果中
+	GetThresholds(ctx context.Context, goodsIDs []uint64) (map[int32]int32, error)
+
+	// SaveThreshold 新增或更新商品的低库存阈值
+	SaveThreshold(ctx context.Context, threshold *do.StockThresholdDO) error
+
+	// UpdateReservationStatus 按状态流转更新订单的预留记录，返回影响行数
+	UpdateReservationStatus(ctx context.Context, txn *gorm.DB, ordersn string, from, to int32) (int64, error)
 }
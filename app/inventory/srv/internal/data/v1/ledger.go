@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+	"gorm.io/gorm"
+)
+
+type LedgerStore interface {
+	// Append 追加库存流水，需要和库存变更使用同一个事务
+	Append(ctx context.Context, txn *gorm.DB, entries ...*do.InventoryLedgerDO) error
+
+	// List 分页查询库存流水，按时间倒序
+	List(ctx context.Context, filter do.LedgerFilter, meta metav1.ListMeta) (*do.InventoryLedgerDOList, error)
+
+	// SumByOrder 按商品、规格和仓库汇总订单某类流水的变更量
+	SumByOrder(ctx context.Context, txn *gorm.DB, orderSn, typ string) (do.GoodsDetailList, error)
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/message"
+	"context"
+)
+
+type MQProducer interface {
+	// SendStockAlert 发送低库存/售罄事件
+	SendStockAlert(ctx context.Context, msg *message.StockAlertMessage) error
+
+	// SendFlashSale 发送秒杀扣减写回消息
+	SendFlashSale(ctx context.Context, msg *do.FlashSaleMessage) error
+}
+
+// FlashSaleHandler 秒杀写回消息的业务处理
+type FlashSaleHandler func(ctx context.Context, msg *do.FlashSaleMessage) error
+
+// RebackHandler 订单超时归还的事务提交后调用，只做通知，不影响消息的消费结果
+type RebackHandler func(ctx context.Context, ordersn string)
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+)
+
+type ReconcileStore interface {
+	// CreateRun 新建对账汇总
+	CreateRun(ctx context.Context, run *do.ReconcileRunDO) error
+
+	// UpdateRun 保存对账汇总
+	UpdateRun(ctx context.Context, run *do.ReconcileRunDO) error
+
+	// GetRun 查询对账汇总，id 为 0 时返回最近一次
+	GetRun(ctx context.Context, id uint64) (*do.ReconcileRunDO, error)
+
+	// AppendItems 写入对账明细
+	AppendItems(ctx context.Context, items ...*do.ReconcileItemDO) error
+
+	// ListItems 分页查询一次对账的明细
+	ListItems(ctx context.Context, runID uint64, meta metav1.ListMeta) (*do.ReconcileItemDOList, error)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"context"
+)
+
+type WarehouseStore interface {
+	// Create 新建仓库
+	Create(ctx context.Context, warehouse *do.WarehouseDO) error
+
+	// Get 查询仓库
+	Get(ctx context.Context, id uint64) (*do.WarehouseDO, error)
+
+	// ListByIDs 批量查询仓库
+	ListByIDs(ctx context.Context, ids []int32) ([]*do.WarehouseDO, error)
+}
@@ -0,0 +1,25 @@
+package do
+
+// 秒杀订单在 Redis 中的状态，写回消费者与归还流程通过状态切换互斥
+const (
+	FlashStateSold      = "sold"      // Redis 已扣减，尚未写回 MySQL
+	FlashStatePersisted = "persisted" // 已被写回消费者认领，以 MySQL 扣减记录为准
+	FlashStateCancelled = "cancelled" // 写回前已归还，Redis 库存已加回
+)
+
+// FlashDeductResult Lua 扣减脚本的返回值
+type FlashDeductResult int
+
+const (
+	FlashDeductNotLoaded FlashDeductResult = -1 // 有商品未预热或秒杀已结束
+	FlashDeductNotEnough FlashDeductResult = 0  // 有商品秒杀库存不足，整单不扣
+	FlashDeductOK        FlashDeductResult = 1
+	FlashDeductDuplicate FlashDeductResult = 2 // 同一订单已扣减过
+)
+
+// FlashSaleMessage 秒杀扣减写回 MySQL 的 MQ 消息体
+type FlashSaleMessage struct {
+	OrderSn  string          `json:"order_sn"`
+	Province string          `json:"province"`
+	Detail   GoodsDetailList `json:"detail"`
+}
@@ -9,13 +9,36 @@ import (
 
 type GoodsDetailList []GoodsDetail
 type GoodsDetail struct {
-	GoodId int32
-	Num    int32
+	GoodId    int32
+	Sku       int32 `json:",omitempty"` // 规格ID，0 为没有规格的商品
+	Num       int32
+	Warehouse int32 // 扣减的仓库，老数据没有该字段即默认仓库 0
 }
 
-func (a GoodsDetailList) Len() int           { return len(a) }
-func (a GoodsDetailList) Less(i, j int) bool { return a[i].GoodId < a[j].GoodId }
-func (a GoodsDetailList) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a GoodsDetailList) Len() int { return len(a) }
+func (a GoodsDetailList) Less(i, j int) bool {
+	if a[i].GoodId != a[j].GoodId {
+		return a[i].GoodId < a[j].GoodId
+	}
+	if a[i].Sku != a[j].Sku {
+		return a[i].Sku < a[j].Sku
+	}
+	return a[i].Warehouse < a[j].Warehouse
+}
+func (a GoodsDetailList) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+
+// GoodsIDs 返回去重后的商品id，顺序与列表一致，列表需要先排序
+// 同一商品可能有多个规格、拆分到多个仓库，锁是商品维度的，加锁时只能按商品加一次
+func (a GoodsDetailList) GoodsIDs() []int32 {
+	ids := make([]int32, 0, len(a))
+	for i, v := range a {
+		if i > 0 && a[i-1].GoodId == v.GoodId {
+			continue
+		}
+		ids = append(ids, v.GoodId)
+	}
+	return ids
+}
 
 func (g GoodsDetailList) Value() (driver.Value, error) {
 	return json.Marshal(g)
@@ -43,15 +66,57 @@ func (ssd *StockSellDetailDO) TableName() string {
 
 type InventoryDO struct {
 	bgorm.Model `structs:"-"`
-	Goods       int32 `gorm:"type:int;index"`
+	Goods       int32 `gorm:"type:int;uniqueIndex:idx_goods_warehouse"`
+	Sku         int32 `gorm:"type:int;default:0;uniqueIndex:idx_goods_warehouse"` //规格ID，0 为没有规格的商品
+	Warehouse   int32 `gorm:"type:int;default:0;uniqueIndex:idx_goods_warehouse"` //所在仓库，0 为默认仓库
 	Stock       int32 `gorm:"type:int"`
-	Version     int32 `gorm:"type:int"` //分布式锁
+	Frozen      int32 `gorm:"type:int;default:0"` //TCC 冻结中的库存，可售库存 = Stock - Frozen
+	Version     int32 `gorm:"type:int"`           //分布式锁
 }
 
 func (id *InventoryDO) TableName() string {
 	return "inventory_models"
 }
 
+// StockReservationDO TCC 模式下订单对单个商品的库存预留记录
+type StockReservationDO struct {
+	bgorm.Model `structs:"-"`
+	OrderSn     string `gorm:"type:varchar(200);uniqueIndex:idx_order_goods"`
+	Goods       int32  `gorm:"type:int;uniqueIndex:idx_order_goods"`
+	Sku         int32  `gorm:"type:int;default:0;uniqueIndex:idx_order_goods"`
+	Warehouse   int32  `gorm:"type:int;default:0;uniqueIndex:idx_order_goods"`
+	Num         int32  `gorm:"type:int"`
+	Status      int32  `gorm:"type:int"` //0 已冻结 1 已确认 2 已取消
+}
+
+func (sr *StockReservationDO) TableName() string {
+	return "stock_reservations"
+}
+
+// StockThresholdDO 商品的低库存阈值，没有记录的商品使用配置的默认阈值
+type StockThresholdDO struct {
+	bgorm.Model `structs:"-"`
+	Goods       int32 `gorm:"type:int;uniqueIndex"`
+	LowWater    int32 `gorm:"type:int"`
+}
+
+func (st *StockThresholdDO) TableName() string {
+	return "stock_thresholds"
+}
+
+// WarehouseDO 仓库
+type WarehouseDO struct {
+	bgorm.Model `structs:"-"`
+	Name        string `gorm:"type:varchar(100);not null"`
+	Province    string `gorm:"type:varchar(20);index"`
+	City        string `gorm:"type:varchar(20)"`
+	Address     string `gorm:"type:varchar(200)"`
+}
+
+func (w *WarehouseDO) TableName() string {
+	return "warehouses"
+}
+
 type OrderMQMessageRequest struct {
 	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // 订单ID（查询详情时必填，创建时不传）
 	UserId   int32  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`  // 用户ID
@@ -0,0 +1,45 @@
+package do
+
+import (
+	bgorm "Advanced_Shop/app/pkg/gorm"
+	"time"
+)
+
+// 库存流水类型
+const (
+	LedgerTypeSet        = "set"         // 设置库存
+	LedgerTypeSell       = "sell"        // 下单扣减
+	LedgerTypeReback     = "reback"      // DTM 补偿归还
+	LedgerTypeAdjust     = "adjust"      // 人工调整
+	LedgerTypeAutoReback = "auto_reback" // 超时未支付，MQ 自动归还
+	LedgerTypeReturn     = "return"      // 售后退货，按退货商品部分归还
+)
+
+// InventoryLedgerDO 库存流水，只追加不修改，与库存变更在同一事务中写入
+type InventoryLedgerDO struct {
+	bgorm.Model `structs:"-"`
+	Goods       int32  `gorm:"type:int;index"`
+	Sku         int32  `gorm:"type:int;default:0"`
+	Warehouse   int32  `gorm:"type:int;default:0"`
+	OrderSn     string `gorm:"type:varchar(200);index"`
+	Type        string `gorm:"type:varchar(20)"`
+	Change      int32  `gorm:"type:int"` //正数入库 负数出库
+	Remark      string `gorm:"type:varchar(200)"`
+}
+
+func (l *InventoryLedgerDO) TableName() string {
+	return "inventory_ledgers"
+}
+
+type InventoryLedgerDOList struct {
+	TotalCount int64                `json:"totalCount,omitempty"`
+	Items      []*InventoryLedgerDO `json:"items"`
+}
+
+// LedgerFilter 库存流水查询条件，零值表示不过滤
+type LedgerFilter struct {
+	Goods   int32
+	OrderSn string
+	Start   time.Time
+	End     time.Time
+}
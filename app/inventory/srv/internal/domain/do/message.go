@@ -14,6 +14,13 @@ const (
 	StockSellStatusPending    = 0 // 待处理
 	StockSellStatusProcessing = 1 // 处理中（已抢占）
 	StockSellStatusDone       = 2 // 已完成
+	StockSellStatusSold       = 3 // 对账确认订单已支付，不再归还
+)
+
+const (
+	ReservationStatusFrozen    = 0 // 已冻结
+	ReservationStatusConfirmed = 1 // 已确认（冻结转为已售）
+	ReservationStatusCancelled = 2 // 已取消（冻结已释放）
 )
 
 const (
@@ -0,0 +1,52 @@
+package do
+
+import (
+	bgorm "Advanced_Shop/app/pkg/gorm"
+	"time"
+)
+
+// 对账处理结果
+const (
+	ReconcileActionReturned    = "returned"     // 订单已关闭或不存在，已归还库存
+	ReconcileActionWouldReturn = "would_return" // dry-run 下应归还
+	ReconcileActionConfirmed   = "confirmed"    // 订单已支付，扣减记录标记为已售
+	ReconcileActionDiscrepancy = "discrepancy"  // 状态不一致，需要人工处理
+	ReconcileActionFailed      = "failed"       // 查询订单或归还失败，下次再对
+)
+
+// ReconcileRunDO 一次对账的汇总
+type ReconcileRunDO struct {
+	bgorm.Model   `structs:"-"`
+	DryRun        bool      `gorm:"type:tinyint(1)"`
+	Scanned       int32     `gorm:"type:int"`
+	Returned      int32     `gorm:"type:int"`
+	Confirmed     int32     `gorm:"type:int"`
+	Pending       int32     `gorm:"type:int"`
+	Discrepancies int32     `gorm:"type:int"`
+	Failed        int32     `gorm:"type:int"`
+	StartedAt     time.Time `gorm:"type:datetime"`
+	FinishedAt    time.Time `gorm:"type:datetime"`
+}
+
+func (r *ReconcileRunDO) TableName() string {
+	return "reconcile_runs"
+}
+
+// ReconcileItemDO 对账明细，只记录有处理动作或需要关注的订单
+type ReconcileItemDO struct {
+	bgorm.Model `structs:"-"`
+	Run         int32  `gorm:"type:int;index"`
+	OrderSn     string `gorm:"type:varchar(200)"`
+	OrderStatus string `gorm:"type:varchar(20)"` // 订单不存在时为空
+	Action      string `gorm:"type:varchar(20)"`
+	Message     string `gorm:"type:varchar(500)"`
+}
+
+func (r *ReconcileItemDO) TableName() string {
+	return "reconcile_items"
+}
+
+type ReconcileItemDOList struct {
+	TotalCount int64              `json:"totalCount,omitempty"`
+	Items      []*ReconcileItemDO `json:"items"`
+}
@@ -5,3 +5,7 @@ import "Advanced_Shop/app/inventory/srv/internal/domain/do"
 type InventoryDTO struct {
 	do.InventoryDO
 }
+
+type WarehouseDTO struct {
+	do.WarehouseDO
+}
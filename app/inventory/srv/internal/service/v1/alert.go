@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/message"
+	"Advanced_Shop/gnova/core/metric"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"strconv"
+	"time"
+)
+
+// 库存水位，数值越大越紧张
+const (
+	stockLevelNormal  = 0
+	stockLevelLow     = 1
+	stockLevelSoldOut = 2
+)
+
+// stockAlertLevel 每个商品当前的库存水位，Prometheus 可以直接按 level 配置告警规则
+var stockAlertLevel = metric.NewGaugeVec(&metric.GaugeVecOpts{
+	Namespace: "inventory",
+	Subsystem: "stock",
+	Name:      "alert_level",
+	Help:      "Stock alert level of goods, 0 normal, 1 low, 2 sold out.",
+	Labels:    []string{"goods"},
+})
+
+// stockLevel 可售库存为 0 但还有冻结库存时，未支付的订单超时后库存会释放，只算库存紧张
+func stockLevel(available, frozen, lowWater int32) int {
+	switch {
+	case available <= 0 && frozen <= 0:
+		return stockLevelSoldOut
+	case available <= lowWater:
+		return stockLevelLow
+	default:
+		return stockLevelNormal
+	}
+}
+
+// alertEvent 水位变化对应的事件，水位没变或者只是从库存紧张恢复时返回空
+func alertEvent(prev, level int) string {
+	switch {
+	case level > prev && level == stockLevelSoldOut:
+		return message.StockAlertSoldOut
+	case level > prev:
+		return message.StockAlertLow
+	case prev == stockLevelSoldOut && level < prev:
+		return message.StockAlertRestock
+	default:
+		return ""
+	}
+}
+
+// stockChange 一次库存变更中商品总库存和冻结库存的变化量
+type stockChange struct {
+	stock  int32
+	frozen int32
+}
+
+// stockChanges 按商品汇总库存的变化量，stockSign、frozenSign 为 -1 表示扣减，0 表示不变
+func stockChanges(detail do.GoodsDetailList, stockSign, frozenSign int32) map[int32]stockChange {
+	changes := make(map[int32]stockChange, len(detail))
+	for _, d := range detail {
+		c := changes[d.GoodId]
+		c.stock += stockSign * d.Num
+		c.frozen += frozenSign * d.Num
+		changes[d.GoodId] = c
+	}
+	return changes
+}
+
+// CheckReback 归还的是扣减记录或冻结的预留记录，按订单重新查出归还的数量
+func (is *inventoryService) CheckReback(ctx context.Context, ordersn string) {
+	sellDetail, err := is.data.Inventorys().GetSellDetail(ctx, nil, ordersn)
+	if err == nil {
+		is.checkStock(ctx, stockChanges(sellDetail.Detail, 1, 0))
+		return
+	}
+	if !errors.IsCode(err, code2.ErrInvSellDetailNotFound) {
+		log.Errorf("订单%s查询扣减记录失败，跳过阈值检查: %v", ordersn, err)
+		return
+	}
+	reservations, err := is.data.Inventorys().ListReservations(ctx, nil, ordersn)
+	if err != nil {
+		log.Errorf("订单%s查询预留记录失败，跳过阈值检查: %v", ordersn, err)
+		return
+	}
+	var released do.GoodsDetailList
+	for _, r := range reservations {
+		released = append(released, do.GoodsDetail{GoodId: r.Goods, Sku: r.Sku, Num: r.Num, Warehouse: r.Warehouse})
+	}
+	is.checkStock(ctx, stockChanges(released, 0, -1))
+}
+
+// checkStock 在库存变更的事务提交后调用，用变更前后的库存判断水位是否变化
+// 水位变紧张时发低库存/售罄事件，从售罄恢复时发补货事件，商品服务据此下架和重新上架
+// 告警只是通知，失败只记日志，不影响库存变更结果
+func (is *inventoryService) checkStock(ctx context.Context, changes map[int32]stockChange) {
+	if len(changes) == 0 {
+		return
+	}
+	goodsIDs := make([]uint64, 0, len(changes))
+	for id := range changes {
+		goodsIDs = append(goodsIDs, uint64(id))
+	}
+
+	invs, err := is.data.Inventorys().BatchGet(ctx, goodsIDs, false)
+	if err != nil {
+		log.Errorf("查询商品库存失败，跳过阈值检查: %v", err)
+		return
+	}
+	thresholds, err := is.data.Inventorys().GetThresholds(ctx, goodsIDs)
+	if err != nil {
+		log.Errorf("查询商品库存阈值失败，跳过阈值检查: %v", err)
+		return
+	}
+
+	for _, inv := range invs {
+		lowWater, ok := thresholds[inv.Goods]
+		if !ok {
+			lowWater = is.invOptions.LowWater
+		}
+		change := changes[inv.Goods]
+		after := inv.Stock - inv.Frozen
+		before := after - (change.stock - change.frozen)
+
+		level := stockLevel(after, inv.Frozen, lowWater)
+		prev := stockLevel(before, inv.Frozen-change.frozen, lowWater)
+		stockAlertLevel.Set(float64(level), strconv.Itoa(int(inv.Goods)))
+
+		event := alertEvent(prev, level)
+		if event == "" {
+			continue
+		}
+		msg := &message.StockAlertMessage{
+			Event:     event,
+			GoodsId:   inv.Goods,
+			Available: after,
+			LowWater:  lowWater,
+			Timestamp: time.Now().UnixMilli(),
+		}
+		if err := is.data.Producer().SendStockAlert(ctx, msg); err != nil {
+			log.Errorf("商品%d发送库存告警失败: %v", inv.Goods, err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"Advanced_Shop/app/pkg/message"
+	"testing"
+)
+
+func TestAlertEvent(t *testing.T) {
+	const lowWater = 10
+	tests := []struct {
+		name          string
+		stock, frozen int32 // 变更后的库存
+		change        stockChange
+		want          string
+	}{
+		{name: "扣减最后一件后售罄", stock: 0, change: stockChange{stock: -1}, want: message.StockAlertSoldOut},
+		{name: "冻结最后一件不算售罄", stock: 1, frozen: 1, change: stockChange{frozen: 1}, want: ""},
+		{name: "冻结转为已售后售罄", stock: 0, frozen: 0, change: stockChange{stock: -1, frozen: -1}, want: message.StockAlertSoldOut},
+		{name: "释放冻结不发事件", stock: 1, frozen: 0, change: stockChange{frozen: -1}, want: ""},
+		{name: "售罄后归还发补货事件", stock: 2, change: stockChange{stock: 2}, want: message.StockAlertRestock},
+		{name: "售罄后补货到阈值以上", stock: 50, change: stockChange{stock: 50}, want: message.StockAlertRestock},
+		{name: "调减到阈值以下", stock: 5, change: stockChange{stock: -20}, want: message.StockAlertLow},
+		{name: "库存充足时补货不发事件", stock: 50, change: stockChange{stock: 20}, want: ""},
+		{name: "库存紧张时继续扣减不重复告警", stock: 3, change: stockChange{stock: -2}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := tt.stock - tt.frozen
+			before := after - (tt.change.stock - tt.change.frozen)
+			level := stockLevel(after, tt.frozen, lowWater)
+			prev := stockLevel(before, tt.frozen-tt.change.frozen, lowWater)
+			if got := alertEvent(prev, level); got != tt.want {
+				t.Fatalf("event = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
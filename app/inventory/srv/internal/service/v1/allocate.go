@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/options"
+	"sort"
+)
+
+// WarehouseStock 商品在单个仓库的可售库存
+type WarehouseStock struct {
+	Warehouse int32
+	Province  string
+	Available int32
+}
+
+// AllocStrategy 仓库分配策略，决定一件商品优先从哪些仓库扣减
+type AllocStrategy interface {
+	// Sort 按扣减优先级对候选仓库排序，province 为收货省份，可能为空
+	Sort(stocks []WarehouseStock, province string)
+}
+
+// NewAllocStrategy 按配置名称返回分配策略，未知名称退化为就近策略
+func NewAllocStrategy(name string) AllocStrategy {
+	switch name {
+	case options.AllocStrategyLargest:
+		return largestStrategy{}
+	default:
+		return nearestStrategy{}
+	}
+}
+
+// nearestStrategy 收货省份内的仓库优先，同一优先级内库存多的优先
+type nearestStrategy struct{}
+
+func (nearestStrategy) Sort(stocks []WarehouseStock, province string) {
+	sort.SliceStable(stocks, func(i, j int) bool {
+		li := province != "" && stocks[i].Province == province
+		lj := province != "" && stocks[j].Province == province
+		if li != lj {
+			return li
+		}
+		return moreStock(stocks[i], stocks[j])
+	})
+}
+
+// largestStrategy 库存多的仓库优先，尽量少拆单
+type largestStrategy struct{}
+
+func (largestStrategy) Sort(stocks []WarehouseStock, province string) {
+	sort.SliceStable(stocks, func(i, j int) bool {
+		return moreStock(stocks[i], stocks[j])
+	})
+}
+
+func moreStock(a, b WarehouseStock) bool {
+	if a.Available != b.Available {
+		return a.Available > b.Available
+	}
+	return a.Warehouse < b.Warehouse
+}
+
+// allocate 按策略排好的顺序依次从仓库扣减，一个仓库不够时拆到下一个仓库
+// 指定了仓库时只从这个仓库扣减，所有候选仓库加起来都不够时返回 false
+func allocate(strategy AllocStrategy, stocks []WarehouseStock, province string, goodsInfo do.GoodsDetail) ([]do.GoodsDetail, bool) {
+	if goodsInfo.Warehouse != 0 {
+		stocks = pinned(stocks, goodsInfo.Warehouse)
+	}
+	strategy.Sort(stocks, province)
+
+	var result []do.GoodsDetail
+	remain := goodsInfo.Num
+	for _, s := range stocks {
+		if remain <= 0 {
+			break
+		}
+		if s.Available <= 0 {
+			continue
+		}
+		take := s.Available
+		if take > remain {
+			take = remain
+		}
+		result = append(result, do.GoodsDetail{GoodId: goodsInfo.GoodId, Sku: goodsInfo.Sku, Num: take, Warehouse: s.Warehouse})
+		remain -= take
+	}
+	return result, remain <= 0
+}
+
+// plan 按策略选出第一个能发出全部数量的仓库，下单时按仓库拆单
+// 没有单个仓库够发或者选中的是默认仓库时返回 0，不指定仓库，扣减时再按策略选仓
+func plan(strategy AllocStrategy, stocks []WarehouseStock, province string, goodsInfo do.GoodsDetail) int32 {
+	strategy.Sort(stocks, province)
+	for _, s := range stocks {
+		if s.Available >= goodsInfo.Num {
+			return s.Warehouse
+		}
+	}
+	return 0
+}
+
+func pinned(stocks []WarehouseStock, warehouse int32) []WarehouseStock {
+	for _, s := range stocks {
+		if s.Warehouse == warehouse {
+			return []WarehouseStock{s}
+		}
+	}
+	return nil
+}
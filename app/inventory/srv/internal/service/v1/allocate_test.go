@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"reflect"
+	"testing"
+)
+
+func testStocks() []WarehouseStock {
+	return []WarehouseStock{
+		{Warehouse: 1, Province: "广东", Available: 3},
+		{Warehouse: 2, Province: "浙江", Available: 10},
+		{Warehouse: 3, Province: "上海", Available: 5},
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		province string
+		goods    do.GoodsDetail
+		want     []do.GoodsDetail
+		ok       bool
+	}{
+		{
+			name:     "就近仓库不够时拆到库存多的仓库",
+			province: "广东",
+			goods:    do.GoodsDetail{GoodId: 1, Num: 5},
+			want:     []do.GoodsDetail{{GoodId: 1, Num: 3, Warehouse: 1}, {GoodId: 1, Num: 2, Warehouse: 2}},
+			ok:       true,
+		},
+		{
+			name:     "指定仓库时只从这个仓库扣减",
+			province: "广东",
+			goods:    do.GoodsDetail{GoodId: 1, Num: 5, Warehouse: 3},
+			want:     []do.GoodsDetail{{GoodId: 1, Num: 5, Warehouse: 3}},
+			ok:       true,
+		},
+		{
+			name:     "指定的仓库不够时不拆到其他仓库",
+			province: "广东",
+			goods:    do.GoodsDetail{GoodId: 1, Num: 4, Warehouse: 1},
+			want:     []do.GoodsDetail{{GoodId: 1, Num: 3, Warehouse: 1}},
+			ok:       false,
+		},
+		{
+			name:  "指定的仓库没有库存记录",
+			goods: do.GoodsDetail{GoodId: 1, Num: 1, Warehouse: 9},
+			ok:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := allocate(nearestStrategy{}, testStocks(), tt.province, tt.goods)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if tt.ok && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("allocate = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy AllocStrategy
+		province string
+		num      int32
+		want     int32
+	}{
+		{name: "就近仓库够发", strategy: nearestStrategy{}, province: "广东", num: 3, want: 1},
+		{name: "就近仓库不够时选能整单发出的仓库", strategy: nearestStrategy{}, province: "广东", num: 4, want: 2},
+		{name: "库存优先", strategy: largestStrategy{}, province: "广东", num: 1, want: 2},
+		{name: "没有单个仓库够发", strategy: nearestStrategy{}, province: "广东", num: 11, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := plan(tt.strategy, testStocks(), tt.province, do.GoodsDetail{GoodId: 1, Num: tt.num})
+			if got != tt.want {
+				t.Fatalf("plan = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
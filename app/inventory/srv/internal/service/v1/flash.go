@@ -0,0 +1,260 @@
+package v1
+
+import (
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"github.com/go-redsync/redsync/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"sort"
+	"strconv"
+
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	"Advanced_Shop/pkg/log"
+)
+
+// 秒杀流程：
+// 1. PreloadFlashSale 把商品的秒杀库存写入 Redis，商品从此只走 Redis 扣减
+// 2. Sell 对整单执行一次 Lua 脚本原子扣减，成功后发送写回消息，不再获取分布式锁
+// 3. 写回消费者 PersistFlashSale 在 MySQL 中扣减并生成扣减记录，复用扣减记录做幂等
+//    写回失败时放弃认领，订单回到 sold 状态，消息重试时重新认领
+// 4. Reback 在写回前直接加回 Redis，写回后按扣减记录归还 MySQL
+//    订单状态在 Redis 内原子切换，同一订单多次归还只加回一次；写回失败不会让归还一直重试
+
+// flashGoods 整单商品都在秒杀中时返回 true，混合下单时返回错误
+func (is *inventoryService) flashGoods(ctx context.Context, detail []do.GoodsDetail) (bool, error) {
+	goodsIDs := make([]int32, 0, len(detail))
+	for _, goodsInfo := range detail {
+		goodsIDs = append(goodsIDs, goodsInfo.GoodId)
+	}
+	flagged, err := is.data.FlashSales().Flagged(ctx, goodsIDs)
+	if err != nil {
+		return false, err
+	}
+	if len(flagged) == 0 {
+		return false, nil
+	}
+	for _, id := range goodsIDs {
+		if !flagged[id] {
+			return false, errors.WithCode(code2.ErrFlashSaleMixed, "秒杀商品%v不能与普通商品一起下单", keys(flagged))
+		}
+	}
+	return true, nil
+}
+
+// flashSell Redis 扣减成功后发送写回消息，消息没发出去时撤销扣减，DTM 重试时可以重新扣
+func (is *inventoryService) flashSell(ctx context.Context, ordersn, province string, detail do.GoodsDetailList) error {
+	result, err := is.data.FlashSales().Deduct(ctx, ordersn, detail)
+	if err != nil {
+		return err
+	}
+	switch result {
+	case do.FlashDeductDuplicate:
+		log.Infof("订单%s已秒杀扣减，跳过", ordersn)
+		return nil
+	case do.FlashDeductNotLoaded:
+		return status.Errorf(codes.Aborted, "订单%s包含已结束秒杀的商品", ordersn)
+	case do.FlashDeductNotEnough:
+		return errors.WithCode(code2.ErrInvNotEnough, "订单%s秒杀库存不足", ordersn)
+	}
+
+	err = is.data.Producer().SendFlashSale(ctx, &do.FlashSaleMessage{
+		OrderSn:  ordersn,
+		Province: province,
+		Detail:   detail,
+	})
+	if err != nil {
+		if rerr := is.data.FlashSales().Revert(ctx, ordersn); rerr != nil {
+			log.Errorf("订单%s撤销秒杀扣减失败: %v", ordersn, rerr)
+		}
+		return err
+	}
+	log.Infof("订单%s秒杀扣减成功", ordersn)
+	return nil
+}
+
+// flashReback 在归还的屏障事务内调用，返回订单归还前的秒杀状态，非秒杀订单返回空串
+// 返回 sold/cancelled 时已在 Redis 内归还完毕，返回 persisted 时继续按扣减记录归还 MySQL
+func (is *inventoryService) flashReback(ctx context.Context, tx *gorm.DB, ordersn string) (string, error) {
+	state, err := is.data.FlashSales().Cancel(ctx, ordersn)
+	if err != nil {
+		return "", err
+	}
+	switch state {
+	case do.FlashStateSold, do.FlashStateCancelled:
+		log.Infof("订单%s秒杀扣减尚未写回，已在Redis内归还", ordersn)
+	case do.FlashStatePersisted:
+		// 写回消费者已认领，但事务可能还没提交，此时空回滚会让写回后的扣减无人归还
+		// 写回失败时会放弃认领，所以这里只会在写回进行中短暂出现
+		if _, err := is.data.Inventorys().GetSellDetail(ctx, tx, ordersn); err != nil {
+			if errors.IsCode(err, code2.ErrInvSellDetailNotFound) {
+				return "", status.Errorf(codes.Unavailable, "订单%s秒杀扣减正在写回，稍后重试", ordersn)
+			}
+			return "", err
+		}
+	}
+	return state, nil
+}
+
+// PersistFlashSale 持有商品锁后再认领，重复投递的消息排队执行，不会在别的消费者放弃认领后继续写回
+func (is *inventoryService) PersistFlashSale(ctx context.Context, msg *do.FlashSaleMessage) error {
+	detail := msg.Detail
+	sort.Sort(detail)
+	goodsIDs := detail.GoodsIDs()
+
+	// 写回不在下单链路上，沿用普通扣减的商品锁，与 Reback/Adjust 互斥
+	rs := redsync.New(is.data.Pool())
+	mutexes := make([]*redsync.Mutex, 0, len(goodsIDs))
+	defer func() {
+		for _, mutex := range mutexes {
+			if _, err := mutex.Unlock(); err != nil {
+				log.Errorf("订单%s释放Redis锁失败: %v", msg.OrderSn, err)
+			}
+		}
+	}()
+	for _, goodsID := range goodsIDs {
+		mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsID), 10))
+		if err := mutex.LockContext(ctx); err != nil {
+			return errors.WithCode(code2.ErrRedisLock, "获取Redis锁失败: %v", err)
+		}
+		mutexes = append(mutexes, mutex)
+	}
+
+	state, err := is.data.FlashSales().Claim(ctx, msg.OrderSn)
+	if err != nil {
+		return err
+	}
+	switch state {
+	case "":
+		log.Warnf("订单%s秒杀记录不存在或已过期，跳过写回", msg.OrderSn)
+		return nil
+	case do.FlashStateCancelled:
+		log.Infof("订单%s写回前已归还，跳过", msg.OrderSn)
+		return nil
+	}
+
+	persisted := false
+	err = is.data.DB().Transaction(func(tx *gorm.DB) error {
+		_, err := is.data.Inventorys().GetSellDetail(ctx, tx, msg.OrderSn)
+		if err == nil {
+			log.Infof("订单%s秒杀扣减已写回，跳过", msg.OrderSn)
+			return nil
+		}
+		if !errors.IsCode(err, code2.ErrInvSellDetailNotFound) {
+			return err
+		}
+
+		var sold do.GoodsDetailList
+		for _, goodsInfo := range detail {
+			allocations, err := is.allocate(ctx, tx, msg.Province, goodsInfo)
+			if err != nil {
+				return err
+			}
+			for _, a := range allocations {
+				rows, err := is.data.Inventorys().Reduce(ctx, tx, uint64(a.GoodId), uint64(a.Sku), uint64(a.Warehouse), int(a.Num))
+				if err != nil {
+					return err
+				}
+				// Redis 已经扣减成功，MySQL 可售库存不足说明两边不一致，回滚后由消息重试，重试耗尽进入死信队列人工处理
+				if rows == 0 {
+					log.Errorf("订单%s秒杀写回时商品%d仓库%d可售库存不足%d，Redis 与 MySQL 库存不一致", msg.OrderSn, a.GoodId, a.Warehouse, a.Num)
+					return errors.WithCode(code2.ErrInvNotEnough, "订单%s写回时商品%d可售库存不足", msg.OrderSn, a.GoodId)
+				}
+				err = is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+					Goods:     a.GoodId,
+					Sku:       a.Sku,
+					Warehouse: a.Warehouse,
+					OrderSn:   msg.OrderSn,
+					Type:      do.LedgerTypeSell,
+					Change:    -a.Num,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			sold = append(sold, allocations...)
+		}
+
+		persisted = true
+		return is.data.Inventorys().CreateStockSellDetail(ctx, tx, &do.StockSellDetailDO{
+			OrderSn: msg.OrderSn,
+			Status:  do.StockSellStatusPending,
+			Detail:  sold,
+		})
+	})
+	if err != nil {
+		// 放弃认领，重试前订单的归还可以直接在 Redis 内完成
+		if rerr := is.data.FlashSales().Release(ctx, msg.OrderSn); rerr != nil {
+			log.Errorf("订单%s放弃认领秒杀订单失败: %v", msg.OrderSn, rerr)
+		}
+		return err
+	}
+	if persisted {
+		log.Infof("订单%s秒杀扣减写回成功", msg.OrderSn)
+		is.checkStock(ctx, stockChanges(detail, -1, 0))
+	}
+	return nil
+}
+
+// PreloadFlashSale 秒杀库存不能超过 MySQL 中的可售库存，否则写回时会扣减失败
+// Redis 中的秒杀库存是商品维度的，有规格的商品写回时无法确定扣哪个规格，不支持秒杀
+// 已在秒杀中的商品不重新预热，Redis 库存里还有没写回的扣减，覆盖会超卖
+func (is *inventoryService) PreloadFlashSale(ctx context.Context, detail []do.GoodsDetail) error {
+	goodsIDs := make([]int32, 0, len(detail))
+	for _, goodsInfo := range detail {
+		goodsIDs = append(goodsIDs, goodsInfo.GoodId)
+	}
+	flagged, err := is.data.FlashSales().Flagged(ctx, goodsIDs)
+	if err != nil {
+		return err
+	}
+	if len(flagged) > 0 {
+		return errors.WithCode(code2.ErrFlashSaleRunning, "商品%v正在秒杀中，先结束秒杀再重新预热", keys(flagged))
+	}
+	for _, goodsInfo := range detail {
+		if goodsInfo.Num <= 0 {
+			return status.Errorf(codes.InvalidArgument, "商品%d秒杀库存必须大于0", goodsInfo.GoodId)
+		}
+		invs, err := is.data.Inventorys().BatchGet(ctx, []uint64{uint64(goodsInfo.GoodId)}, true)
+		if err != nil {
+			return err
+		}
+		if len(invs) == 0 {
+			return errors.WithCode(code2.ErrInventoryNotFound, "商品%d没有库存记录", goodsInfo.GoodId)
+		}
+		if len(invs) > 1 || invs[0].Sku != 0 {
+			return status.Errorf(codes.InvalidArgument, "商品%d有规格，不支持秒杀", goodsInfo.GoodId)
+		}
+		if available := invs[0].Stock - invs[0].Frozen; goodsInfo.Num > available {
+			return errors.WithCode(code2.ErrInvNotEnough, "商品%d可售库存%d，不足以预热%d", goodsInfo.GoodId, available, goodsInfo.Num)
+		}
+	}
+	for _, goodsInfo := range detail {
+		if err := is.data.FlashSales().Preload(ctx, goodsInfo.GoodId, goodsInfo.Num); err != nil {
+			return err
+		}
+		log.Infof("商品%d预热秒杀库存%d", goodsInfo.GoodId, goodsInfo.Num)
+	}
+	return nil
+}
+
+func (is *inventoryService) StopFlashSale(ctx context.Context, goodsIDs []int32) error {
+	for _, id := range goodsIDs {
+		if err := is.data.FlashSales().Stop(ctx, id); err != nil {
+			return err
+		}
+		log.Infof("商品%d结束秒杀", id)
+	}
+	return nil
+}
+
+func keys(m map[int32]bool) []int32 {
+	ret := make([]int32, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
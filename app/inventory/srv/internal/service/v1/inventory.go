@@ -3,12 +3,12 @@ package v1
 import (
 	v1 "Advanced_Shop/app/inventory/srv/internal/data/v1"
 	code2 "Advanced_Shop/app/pkg/code"
+	bgorm "Advanced_Shop/app/pkg/gorm"
 	"Advanced_Shop/app/pkg/options"
 	"Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
 	"Advanced_Shop/pkg/errors"
 	"context"
-	"database/sql"
-	"github.com/dtm-labs/client/dtmcli"
 	"github.com/go-redsync/redsync/v4"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,35 +33,186 @@ type InventorySrv interface {
 	// Create 设置库存
 	Create(ctx context.Context, inv *dto.InventoryDTO) error
 
-	// Get 根据商品的id查询库存
-	Get(ctx context.Context, goodsID uint64) (*dto.InventoryDTO, error)
+	// Get 根据商品的id查询库存，skuID 为 0 时汇总商品的所有规格
+	Get(ctx context.Context, goodsID, skuID uint64) (*dto.InventoryDTO, error)
 
-	// Sell 扣减库存
-	Sell(ctx context.Context, ordersn string, detail []do.GoodsDetail) error
+	// BatchGet 批量查询商品库存，bySku 为 true 时按规格分别返回
+	BatchGet(ctx context.Context, goodsIDs []uint64, bySku bool) ([]*dto.InventoryDTO, error)
 
-	// Reback 归还库存
-	Reback(ctx context.Context, ordersn string, detail []do.GoodsDetail) error
+	// Plan 按分配策略为每件商品预选一个能整单发出的仓库，不扣减库存
+	// 秒杀商品和没有单个仓库够发的商品仓库为 0，扣减时再选仓
+	Plan(ctx context.Context, province string, detail []do.GoodsDetail) ([]do.GoodsDetail, error)
+
+	// Sell 扣减库存，province 为收货省份，用于选择仓库；商品指定了仓库时只从这个仓库扣减
+	Sell(ctx context.Context, ordersn, province string, detail []do.GoodsDetail) error
+
+	// Reback 按扣减记录归还库存
+	Reback(ctx context.Context, ordersn string) error
+
+	// CheckReback 订单超时归还由 MQ 消费者在数据层完成，提交后调用这里检查库存水位
+	CheckReback(ctx context.Context, ordersn string)
+
+	// Return 售后退货，只归还 detail 中的商品数量，累计不能超过订单扣减的数量
+	Return(ctx context.Context, ordersn string, detail []do.GoodsDetail) error
+
+	// Adjust 人工调整库存，num 为调整量，可为负数
+	Adjust(ctx context.Context, goodsID, skuID, warehouseID uint64, num int32, remark string) error
+
+	// SetThreshold 设置商品的低库存阈值
+	SetThreshold(ctx context.Context, goodsID uint64, lowWater int32) error
+
+	// PreloadFlashSale 预热秒杀库存到 Redis，detail 中的 Num 为秒杀库存
+	PreloadFlashSale(ctx context.Context, detail []do.GoodsDetail) error
+
+	// StopFlashSale 结束秒杀，商品回到普通扣减
+	StopFlashSale(ctx context.Context, goodsIDs []int32) error
+
+	// PersistFlashSale 秒杀扣减写回 MySQL，由 MQ 消费者调用
+	PersistFlashSale(ctx context.Context, msg *do.FlashSaleMessage) error
+
+	// Ledger 分页查询库存流水
+	Ledger(ctx context.Context, filter do.LedgerFilter, meta metav1.ListMeta) (*do.InventoryLedgerDOList, error)
+
+	// TrySell TCC Try：冻结库存并生成预留记录
+	TrySell(ctx context.Context, ordersn, province string, detail []do.GoodsDetail) error
+
+	// ConfirmSell TCC Confirm：订单支付成功后将冻结库存转为已售
+	ConfirmSell(ctx context.Context, ordersn string) error
+
+	// CancelSell TCC Cancel：释放订单冻结的库存
+	CancelSell(ctx context.Context, ordersn string) error
 }
 
 type inventoryService struct {
 	data v1.DataFactory
 
 	redisOptions *options.RedisOptions
+	invOptions   *options.InventoryOptions
+	strategy     AllocStrategy
 }
 
 func (is *inventoryService) Create(ctx context.Context, inv *dto.InventoryDTO) error {
-	return is.data.Inventorys().Create(ctx, &inv.InventoryDO)
+	if inv.Warehouse != 0 {
+		if _, err := is.data.Warehouses().Get(ctx, uint64(inv.Warehouse)); err != nil {
+			return err
+		}
+	}
+	err := is.data.DB().Transaction(func(tx *gorm.DB) error {
+		if err := is.data.Inventorys().Create(ctx, tx, &inv.InventoryDO); err != nil {
+			return err
+		}
+		return is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+			Goods:     inv.Goods,
+			Sku:       inv.Sku,
+			Warehouse: inv.Warehouse,
+			Type:      do.LedgerTypeSet,
+			Change:    inv.Stock,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	// 售罄的商品在新仓库补货时也要重新上架
+	is.checkStock(ctx, map[int32]stockChange{inv.Goods: {stock: inv.Stock}})
+	return nil
 }
 
-func (is *inventoryService) Get(ctx context.Context, goodsID uint64) (*dto.InventoryDTO, error) {
-	inv, err := is.data.Inventorys().Get(ctx, goodsID)
+func (is *inventoryService) Get(ctx context.Context, goodsID, skuID uint64) (*dto.InventoryDTO, error) {
+	inv, err := is.data.Inventorys().Get(ctx, goodsID, skuID)
 	if err != nil {
 		return nil, err
 	}
 	return &dto.InventoryDTO{InventoryDO: *inv}, nil
 }
 
-func (is *inventoryService) Sell(ctx context.Context, ordersn string, details []do.GoodsDetail) error {
+func (is *inventoryService) BatchGet(ctx context.Context, goodsIDs []uint64, bySku bool) ([]*dto.InventoryDTO, error) {
+	invs, err := is.data.Inventorys().BatchGet(ctx, goodsIDs, bySku)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*dto.InventoryDTO, 0, len(invs))
+	for _, inv := range invs {
+		ret = append(ret, &dto.InventoryDTO{InventoryDO: *inv})
+	}
+	return ret, nil
+}
+
+// stocks 读取商品规格在各仓库的可售库存
+func (is *inventoryService) stocks(ctx context.Context, tx *gorm.DB, goodsInfo do.GoodsDetail) ([]WarehouseStock, error) {
+	invs, err := is.data.Inventorys().ListByGoods(ctx, tx, uint64(goodsInfo.GoodId), uint64(goodsInfo.Sku))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(invs))
+	for _, inv := range invs {
+		if inv.Warehouse != 0 {
+			ids = append(ids, inv.Warehouse)
+		}
+	}
+	warehouses, err := is.data.Warehouses().ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	provinces := make(map[int32]string, len(warehouses))
+	for _, w := range warehouses {
+		provinces[w.ID] = w.Province
+	}
+
+	stocks := make([]WarehouseStock, 0, len(invs))
+	for _, inv := range invs {
+		stocks = append(stocks, WarehouseStock{
+			Warehouse: inv.Warehouse,
+			Province:  provinces[inv.Warehouse],
+			Available: inv.Stock - inv.Frozen,
+		})
+	}
+	return stocks, nil
+}
+
+// allocate 按分配策略拆分出每个仓库的扣减数量
+func (is *inventoryService) allocate(ctx context.Context, tx *gorm.DB, province string, goodsInfo do.GoodsDetail) ([]do.GoodsDetail, error) {
+	stocks, err := is.stocks(ctx, tx, goodsInfo)
+	if err != nil {
+		return nil, err
+	}
+	allocations, ok := allocate(is.strategy, stocks, province, goodsInfo)
+	if !ok {
+		return nil, status.Errorf(codes.Aborted, "库存不足: 商品%d", goodsInfo.GoodId)
+	}
+	return allocations, nil
+}
+
+func (is *inventoryService) Plan(ctx context.Context, province string, detail []do.GoodsDetail) ([]do.GoodsDetail, error) {
+	goodsIDs := make([]int32, 0, len(detail))
+	for _, goodsInfo := range detail {
+		goodsIDs = append(goodsIDs, goodsInfo.GoodId)
+	}
+	// 秒杀商品在 Redis 中扣减，写回时才选仓
+	flagged := map[int32]bool{}
+	if is.invOptions.FlashSale {
+		var err error
+		if flagged, err = is.data.FlashSales().Flagged(ctx, goodsIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	ret := make([]do.GoodsDetail, 0, len(detail))
+	for _, goodsInfo := range detail {
+		goodsInfo.Warehouse = 0
+		if !flagged[goodsInfo.GoodId] {
+			stocks, err := is.stocks(ctx, nil, goodsInfo)
+			if err != nil {
+				return nil, err
+			}
+			goodsInfo.Warehouse = plan(is.strategy, stocks, province, goodsInfo)
+		}
+		ret = append(ret, goodsInfo)
+	}
+	return ret, nil
+}
+
+func (is *inventoryService) Sell(ctx context.Context, ordersn, province string, details []do.GoodsDetail) error {
 	log.Infof("订单%s扣减库存", ordersn)
 	// 使用屏障子事务
 	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
@@ -79,8 +230,23 @@ func (is *inventoryService) Sell(ctx context.Context, ordersn string, details []
 
 	db := is.data.DB()
 
+	if is.invOptions.FlashSale {
+		flash, err := is.flashGoods(ctx, detail)
+		if err != nil {
+			return err
+		}
+		if flash {
+			// 秒杀商品在 Redis 内原子扣减，屏障只用来防止悬挂和重复请求
+			return bgorm.CallWithGorm(barrier, db, func(tx *gorm.DB) error {
+				return is.flashSell(ctx, ordersn, province, detail)
+			})
+		}
+	}
+
 	// 直接用封装好的helper，闭包里的tx就是*gorm.DB，你的repo照常用
-	return CallWithGorm(barrier, db, func(tx *gorm.DB) error {
+	err = bgorm.CallWithGorm(barrier, db, func(tx *gorm.DB) error {
+		// 实际扣减的仓库明细，归还时按仓库原路返回
+		var sold do.GoodsDetailList
 		for _, goodsInfo := range detail {
 			// 拿锁  一定是 先排序在拿锁
 			mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsInfo.GoodId), 10))
@@ -89,27 +255,40 @@ func (is *inventoryService) Sell(ctx context.Context, ordersn string, details []
 				return err
 			}
 
-			inv, err := is.data.Inventorys().GetWithTx(ctx, tx, uint64(goodsInfo.GoodId))
+			allocations, err := is.allocate(ctx, tx, province, goodsInfo)
 			if err != nil {
 				return err
 			}
 
-			if inv.Stock < goodsInfo.Num {
-				// TODO 错误码
-				return status.Errorf(codes.Aborted, "库存不足: 商品%d", goodsInfo.GoodId)
-			}
-
-			if err := is.data.Inventorys().Reduce(ctx, tx, uint64(goodsInfo.GoodId), int(goodsInfo.Num)); err != nil {
-				return err
+			for _, a := range allocations {
+				// 持有商品锁也可能和不加锁的 TCC 冻结并发，分配结果过期时扣减失败，按库存不足处理
+				rows, err := is.data.Inventorys().Reduce(ctx, tx, uint64(a.GoodId), uint64(a.Sku), uint64(a.Warehouse), int(a.Num))
+				if err != nil {
+					return err
+				}
+				if rows == 0 {
+					return status.Errorf(codes.Aborted, "库存不足: 商品%d", a.GoodId)
+				}
+				err = is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+					Goods:     a.GoodId,
+					Sku:       a.Sku,
+					Warehouse: a.Warehouse,
+					OrderSn:   ordersn,
+					Type:      do.LedgerTypeSell,
+					Change:    -a.Num,
+				})
+				if err != nil {
+					return err
+				}
 			}
-
+			sold = append(sold, allocations...)
 		}
 
 		// 生成历史记录
 		record := &do.StockSellDetailDO{
 			OrderSn: ordersn,
 			Status:  0,
-			Detail:  detail,
+			Detail:  sold,
 			Version: 0,
 		}
 		err = is.data.Inventorys().CreateStockSellDetail(ctx, tx, record)
@@ -119,10 +298,17 @@ func (is *inventoryService) Sell(ctx context.Context, ordersn string, details []
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
 
+	// 事务提交后再检查阈值，告警消息不能在事务内发出
+	is.checkStock(ctx, stockChanges(detail, -1, 0))
+	return nil
 }
 
-func (is *inventoryService) Reback(ctx context.Context, ordersn string, details []do.GoodsDetail) error {
+// Reback 归还的仓库和数量以扣减记录为准，不依赖请求里的商品明细
+func (is *inventoryService) Reback(ctx context.Context, ordersn string) error {
 	log.Infof("订单%s归还库存", ordersn)
 
 	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
@@ -134,10 +320,21 @@ func (is *inventoryService) Reback(ctx context.Context, ordersn string, details
 	rs := redsync.New(is.data.Pool())
 
 	gormDB := is.data.DB()
-	var detail = do.GoodsDetailList(details)
-	sort.Sort(detail) // 与 Sell 保持相同排序，防止死锁
 
-	return CallWithGorm(barrier, gormDB, func(tx *gorm.DB) error {
+	// 实际归还的明细，事务提交后用于刷新库存水位
+	var returned do.GoodsDetailList
+	var flashState string
+	err = bgorm.CallWithGorm(barrier, gormDB, func(tx *gorm.DB) error {
+		if is.invOptions.FlashSale {
+			flashState, err = is.flashReback(ctx, tx, ordersn)
+			if err != nil {
+				return err
+			}
+			if flashState == do.FlashStateSold || flashState == do.FlashStateCancelled {
+				return nil
+			}
+		}
+
 		sellDetail, err := is.data.Inventorys().GetSellDetail(ctx, tx, ordersn)
 		if err != nil {
 			if errors.IsCode(err, code2.ErrInvSellDetailNotFound) {
@@ -152,16 +349,21 @@ func (is *inventoryService) Reback(ctx context.Context, ordersn string, details
 			return nil
 		}
 
-		for _, goodsInfo := range detail {
+		detail := sellDetail.Detail
+		sort.Sort(detail) // 与 Sell 保持相同排序，防止死锁
+
+		for _, goodsID := range detail.GoodsIDs() {
 			// 加 Redis 分布式锁
-			mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsInfo.GoodId), 10))
+			mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsID), 10))
 			defer mutex.Unlock()
 			if err := mutex.Lock(); err != nil {
-				log.Errorf("订单%s商品%d获取Redis锁失败: %v", ordersn, goodsInfo.GoodId, err)
+				log.Errorf("订单%s商品%d获取Redis锁失败: %v", ordersn, goodsID, err)
 				return errors.WithCode(code2.ErrRedisLock, "获取Redis锁失败: %v", err)
 			}
+		}
 
-			inv, err := is.data.Inventorys().GetWithTx(ctx, tx, uint64(goodsInfo.GoodId))
+		for _, goodsInfo := range detail {
+			inv, err := is.data.Inventorys().GetWithTx(ctx, tx, uint64(goodsInfo.GoodId), uint64(goodsInfo.Sku), uint64(goodsInfo.Warehouse))
 			if err != nil {
 				log.Errorf("订单%s获取库存失败", ordersn)
 				return err
@@ -172,6 +374,17 @@ func (is *inventoryService) Reback(ctx context.Context, ordersn string, details
 				log.Errorf("订单%s商品%d归还库存失败: %v", ordersn, goodsInfo.GoodId, err)
 				return err
 			}
+			err = is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+				Goods:     goodsInfo.GoodId,
+				Sku:       goodsInfo.Sku,
+				Warehouse: goodsInfo.Warehouse,
+				OrderSn:   ordersn,
+				Type:      do.LedgerTypeReback,
+				Change:    goodsInfo.Num,
+			})
+			if err != nil {
+				return err
+			}
 
 			log.Infof("订单%s商品%d归还库存%d成功", ordersn, goodsInfo.GoodId, goodsInfo.Num)
 		}
@@ -181,36 +394,284 @@ func (is *inventoryService) Reback(ctx context.Context, ordersn string, details
 			return err
 		}
 
+		returned = detail
 		log.Infof("订单%s归还库存成功", ordersn)
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	if flashState == do.FlashStatePersisted {
+		// 归还到 MySQL 的库存同步加回仍在秒杀中的商品，失败时只会少卖
+		if err := is.data.FlashSales().Restore(ctx, returned); err != nil {
+			log.Errorf("订单%s加回秒杀库存失败: %v", ordersn, err)
+		}
+	}
+	is.checkStock(ctx, stockChanges(returned, 1, 0))
+	return nil
 }
 
-func newInventoryService(s *service) *inventoryService {
-	return &inventoryService{data: s.data, redisOptions: s.redisOptions}
+func (is *inventoryService) Adjust(ctx context.Context, goodsID, skuID, warehouseID uint64, num int32, remark string) error {
+	log.Infof("商品%d规格%d仓库%d人工调整库存%d", goodsID, skuID, warehouseID, num)
+	// 调整会修改 stock，与 Sell/Reback 共用同一把商品锁
+	rs := redsync.New(is.data.Pool())
+	mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsID), 10))
+	if err := mutex.LockContext(ctx); err != nil {
+		return errors.WithCode(code2.ErrRedisLock, "获取Redis锁失败: %v", err)
+	}
+	defer mutex.Unlock()
+
+	err := is.data.DB().Transaction(func(tx *gorm.DB) error {
+		rows, err := is.data.Inventorys().Adjust(ctx, tx, goodsID, skuID, warehouseID, int(num))
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return errors.WithCode(code2.ErrInvNotEnough, "商品%d规格%d仓库%d不存在或调整后低于冻结库存", goodsID, skuID, warehouseID)
+		}
+		return is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+			Goods:     int32(goodsID),
+			Sku:       int32(skuID),
+			Warehouse: int32(warehouseID),
+			Type:      do.LedgerTypeAdjust,
+			Change:    num,
+			Remark:    remark,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	is.checkStock(ctx, map[int32]stockChange{int32(goodsID): {stock: num}})
+	return nil
 }
 
-var _ InventorySrv = &inventoryService{}
+func (is *inventoryService) SetThreshold(ctx context.Context, goodsID uint64, lowWater int32) error {
+	return is.data.Inventorys().SaveThreshold(ctx, &do.StockThresholdDO{
+		Goods:    int32(goodsID),
+		LowWater: lowWater,
+	})
+}
+
+func (is *inventoryService) Ledger(ctx context.Context, filter do.LedgerFilter, meta metav1.ListMeta) (*do.InventoryLedgerDOList, error) {
+	return is.data.Ledgers().List(ctx, filter, meta)
+}
+
+func (is *inventoryService) TrySell(ctx context.Context, ordersn, province string, details []do.GoodsDetail) error {
+	log.Infof("订单%s冻结库存", ordersn)
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		log.Errorf("订单%s创建屏障失败: %v", ordersn, err)
+		return errors.WithCode(code.ErrUnknown, "创建屏障失败: %v", err)
+	}
+
+	var detail = do.GoodsDetailList(details)
+	sort.Sort(detail) // 与 Sell 保持相同排序，防止死锁
+
+	if is.invOptions.FlashSale {
+		flash, err := is.flashGoods(ctx, detail)
+		if err != nil {
+			return err
+		}
+		if flash {
+			// 秒杀库存在 Redis 中，MySQL 冻结会与写回的扣减重复占用库存
+			return errors.WithCode(code2.ErrFlashSaleMixed, "订单%s包含秒杀商品，不支持冻结库存", ordersn)
+		}
+	}
+
+	err = bgorm.CallWithGorm(barrier, is.data.DB(), func(tx *gorm.DB) error {
+		reservations := make([]*do.StockReservationDO, 0, len(detail))
+		for _, goodsInfo := range detail {
+			allocations, err := is.allocate(ctx, tx, province, goodsInfo)
+			if err != nil {
+				return err
+			}
+			for _, a := range allocations {
+				// 冻结是带条件的原子更新，不需要分布式锁，并发下分配结果过期时冻结失败，按库存不足处理
+				rows, err := is.data.Inventorys().Freeze(ctx, tx, uint64(a.GoodId), uint64(a.Sku), uint64(a.Warehouse), int(a.Num))
+				if err != nil {
+					return err
+				}
+				if rows == 0 {
+					return status.Errorf(codes.Aborted, "库存不足: 商品%d", a.GoodId)
+				}
+				reservations = append(reservations, &do.StockReservationDO{
+					OrderSn:   ordersn,
+					Goods:     a.GoodId,
+					Sku:       a.Sku,
+					Warehouse: a.Warehouse,
+					Num:       a.Num,
+					Status:    do.ReservationStatusFrozen,
+				})
+			}
+		}
+
+		if err := is.data.Inventorys().CreateReservations(ctx, tx, reservations); err != nil {
+			log.Errorf("订单%s创建预留记录失败", ordersn)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	is.checkStock(ctx, stockChanges(detail, 0, 1))
+	return nil
+}
+
+// ConfirmSell 由订单服务在支付成功后直接调用，不在 DTM 全局事务内，依靠预留记录的状态做幂等
+func (is *inventoryService) ConfirmSell(ctx context.Context, ordersn string) error {
+	log.Infof("订单%s确认冻结库存", ordersn)
+	reservations, err := is.data.Inventorys().ListReservations(ctx, nil, ordersn)
+	if err != nil {
+		return err
+	}
+	if len(reservations) == 0 {
+		// 非 TCC 模式创建的订单没有预留记录
+		log.Infof("订单%s没有预留记录，跳过", ordersn)
+		return nil
+	}
+
+	var detail do.GoodsDetailList
+	for _, r := range reservations {
+		switch r.Status {
+		case do.ReservationStatusConfirmed:
+			log.Infof("订单%s已确认，跳过", ordersn)
+			return nil
+		case do.ReservationStatusCancelled:
+			return errors.WithCode(code2.ErrInvReservationReleased, "订单%s冻结库存已释放", ordersn)
+		}
+		detail = append(detail, do.GoodsDetail{GoodId: r.Goods, Sku: r.Sku, Num: r.Num, Warehouse: r.Warehouse})
+	}
+	sort.Sort(detail)
+	goodsIDs := detail.GoodsIDs()
+
+	// 确认会修改 stock，与 Sell/Reback 共用同一把商品锁
+	rs := redsync.New(is.data.Pool())
+	mutexes := make([]*redsync.Mutex, 0, len(goodsIDs))
+	defer func() {
+		for _, mutex := range mutexes {
+			if _, err := mutex.Unlock(); err != nil {
+				log.Errorf("订单%s释放Redis锁失败: %v", ordersn, err)
+			}
+		}
+	}()
+	for _, goodsID := range goodsIDs {
+		mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsID), 10))
+		if err := mutex.LockContext(ctx); err != nil {
+			log.Errorf("订单%s商品%d获取Redis锁失败: %v", ordersn, goodsID, err)
+			return errors.WithCode(code2.ErrRedisLock, "获取Redis锁失败: %v", err)
+		}
+		mutexes = append(mutexes, mutex)
+	}
+
+	confirmed := false
+	err = is.data.DB().Transaction(func(tx *gorm.DB) error {
+		rows, err := is.data.Inventorys().UpdateReservationStatus(ctx, tx, ordersn, do.ReservationStatusFrozen, do.ReservationStatusConfirmed)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			// 并发确认，已被其他请求处理
+			log.Infof("订单%s预留记录已被处理，跳过", ordersn)
+			return nil
+		}
+
+		for _, goodsInfo := range detail {
+			if err := is.data.Inventorys().ConfirmFrozen(ctx, tx, uint64(goodsInfo.GoodId), uint64(goodsInfo.Sku), uint64(goodsInfo.Warehouse), int(goodsInfo.Num)); err != nil {
+				log.Errorf("订单%s商品%d确认冻结库存失败: %v", ordersn, goodsInfo.GoodId, err)
+				return err
+			}
+			err = is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+				Goods:     goodsInfo.GoodId,
+				Sku:       goodsInfo.Sku,
+				Warehouse: goodsInfo.Warehouse,
+				OrderSn:   ordersn,
+				Type:      do.LedgerTypeSell,
+				Change:    -goodsInfo.Num,
+			})
+			if err != nil {
+				return err
+			}
+		}
 
-// CallWithGorm 让DTM屏障支持GORM事务
-// 原理：拿到底层sql.DB开事务，把sql.Tx转给GORM，这样既能用屏障又能用GORM
-func CallWithGorm(barrier *dtmcli.BranchBarrier, db *gorm.DB, busiCall func(tx *gorm.DB) error) error {
-	// 1. 从gorm拿到底层 *sql.DB
-	sqlDB, err := db.DB()
+		// 与 Sell 一样生成扣减记录，后续的归还流程可以复用
+		record := &do.StockSellDetailDO{
+			OrderSn: ordersn,
+			Status:  do.StockSellStatusPending,
+			Detail:  detail,
+			Version: 0,
+		}
+		if err := is.data.Inventorys().CreateStockSellDetail(ctx, tx, record); err != nil {
+			log.Errorf("订单%s创建历史表失败", ordersn)
+			return err
+		}
+		log.Infof("订单%s确认冻结库存成功", ordersn)
+		confirmed = true
+		return nil
+	})
 	if err != nil {
 		return err
 	}
+	if confirmed {
+		// 冻结库存转为已售后没有冻结库存的商品才算售罄
+		is.checkStock(ctx, stockChanges(detail, -1, -1))
+	}
+	return nil
+}
 
-	// 2. 调用DTM原生的CallWithDB，拿到 *sql.Tx
-	return barrier.CallWithDB(sqlDB, func(sqlTx *sql.Tx) error {
-		// 3. 把 *sql.Tx 包装成 *gorm.DB，这样你的repo方法全部可以继续用
-		gormTx := db.WithContext(db.Statement.Context)
+func (is *inventoryService) CancelSell(ctx context.Context, ordersn string) error {
+	log.Infof("订单%s释放冻结库存", ordersn)
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "创建屏障失败: %v", err)
+	}
 
-		// 关键：用DTM给的sqlTx替换gorm内部的连接
-		// gorm提供了 ConnPool 接口，sql.Tx 实现了这个接口
-		gormTx.Statement.ConnPool = sqlTx
+	var released do.GoodsDetailList
+	err = bgorm.CallWithGorm(barrier, is.data.DB(), func(tx *gorm.DB) error {
+		reservations, err := is.data.Inventorys().ListReservations(ctx, tx, ordersn)
+		if err != nil {
+			return err
+		}
+
+		var frozen []*do.StockReservationDO
+		for _, r := range reservations {
+			if r.Status == do.ReservationStatusFrozen {
+				frozen = append(frozen, r)
+			}
+		}
+		if len(frozen) == 0 {
+			log.Infof("订单%s没有冻结中的预留记录，空回滚", ordersn)
+			return nil
+		}
+
+		if _, err := is.data.Inventorys().UpdateReservationStatus(ctx, tx, ordersn, do.ReservationStatusFrozen, do.ReservationStatusCancelled); err != nil {
+			return err
+		}
+		for _, r := range frozen {
+			if err := is.data.Inventorys().Unfreeze(ctx, tx, uint64(r.Goods), uint64(r.Sku), uint64(r.Warehouse), int(r.Num)); err != nil {
+				log.Errorf("订单%s商品%d释放冻结库存失败: %v", ordersn, r.Goods, err)
+				return err
+			}
+			released = append(released, do.GoodsDetail{GoodId: r.Goods, Sku: r.Sku, Num: r.Num, Warehouse: r.Warehouse})
+		}
 
-		// 4. 执行业务逻辑，用的是包装好的gormTx
-		return busiCall(gormTx)
+		log.Infof("订单%s释放冻结库存成功", ordersn)
+		return nil
 	})
+	if err != nil {
+		return err
+	}
+	is.checkStock(ctx, stockChanges(released, 0, -1))
+	return nil
 }
+
+func newInventoryService(s *service) *inventoryService {
+	return &inventoryService{
+		data:         s.data,
+		redisOptions: s.redisOptions,
+		invOptions:   s.invOptions,
+		strategy:     NewAllocStrategy(s.invOptions.AllocStrategy),
+	}
+}
+
+var _ InventorySrv = &inventoryService{}
@@ -0,0 +1,214 @@
+package v1
+
+import (
+	orderpb "Advanced_Shop/api/order/v1"
+	v1 "Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"fmt"
+	"github.com/go-redsync/redsync/v4"
+	"gorm.io/gorm"
+	"time"
+
+	"Advanced_Shop/pkg/log"
+)
+
+// 多个库存实例只允许一个在对账，锁的有效期要覆盖一次完整的对账
+const (
+	reconcileLockKey    = "inventory_reconcile"
+	reconcileLockExpiry = 30 * time.Minute
+)
+
+// 订单服务中的订单状态，支付宝回调会直接写入 TRADE_* 状态
+const (
+	orderStatusPaying        = "PAYING"
+	orderStatusWaitBuyerPay  = "WAIT_BUYER_PAY"
+	orderStatusClosed        = "CLOSED"
+	orderStatusTradeClosed   = "TRADE_CLOSED"
+	orderStatusTradeSuccess  = "TRADE_SUCCESS"
+	orderStatusTradeFinished = "TRADE_FINISHED"
+	orderStatusCancelled     = "CANCELLED"
+	orderStatusShipped       = "SHIPPED"
+	orderStatusReceived      = "RECEIVED"
+	orderStatusRefundSuccess = "REFUND_SUCCESS" // 退货的商品已在售后流程中归还
+)
+
+type ReconcileSrv interface {
+	// Run 执行一次对账，dryRun 时只报告不处理
+	Run(ctx context.Context, dryRun bool) (*do.ReconcileRunDO, error)
+
+	// Report 查询对账汇总和明细，runID 为 0 时查询最近一次
+	Report(ctx context.Context, runID uint64, meta metav1.ListMeta) (*do.ReconcileRunDO, *do.ReconcileItemDOList, error)
+
+	// Schedule 按配置的间隔定时对账，阻塞到 ctx 结束
+	Schedule(ctx context.Context)
+}
+
+type reconcileService struct {
+	data       v1.DataFactory
+	invOptions *options.InventoryOptions
+}
+
+func (rs *reconcileService) Run(ctx context.Context, dryRun bool) (*do.ReconcileRunDO, error) {
+	mutex := redsync.New(rs.data.Pool()).NewMutex(reconcileLockKey, redsync.WithExpiry(reconcileLockExpiry))
+	if err := mutex.TryLockContext(ctx); err != nil {
+		return nil, errors.WithCode(code2.ErrRedisLock, "已有对账正在执行: %v", err)
+	}
+	defer func() {
+		if _, err := mutex.Unlock(); err != nil {
+			log.Errorf("释放对账锁失败: %v", err)
+		}
+	}()
+
+	run := &do.ReconcileRunDO{DryRun: dryRun, StartedAt: time.Now()}
+	if err := rs.data.Reconciles().CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+	log.Infof("开始对账%d, dryRun: %v", run.ID, dryRun)
+
+	before := run.StartedAt.Add(-rs.invOptions.ReconcileStaleAfter)
+	var afterID int32
+	for {
+		details, err := rs.data.Inventorys().ListStaleSellDetails(ctx, before, afterID, rs.invOptions.ReconcileBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(details) == 0 {
+			break
+		}
+
+		var items []*do.ReconcileItemDO
+		for _, detail := range details {
+			afterID = detail.ID
+			run.Scanned++
+			item := rs.reconcileOne(ctx, detail, dryRun)
+			if item == nil {
+				run.Pending++
+				continue
+			}
+			switch item.Action {
+			case do.ReconcileActionReturned, do.ReconcileActionWouldReturn:
+				run.Returned++
+			case do.ReconcileActionConfirmed:
+				run.Confirmed++
+			case do.ReconcileActionDiscrepancy:
+				run.Discrepancies++
+			case do.ReconcileActionFailed:
+				run.Failed++
+			}
+			item.Run = run.ID
+			items = append(items, item)
+		}
+		if err := rs.data.Reconciles().AppendItems(ctx, items...); err != nil {
+			return nil, err
+		}
+	}
+
+	run.FinishedAt = time.Now()
+	if err := rs.data.Reconciles().UpdateRun(ctx, run); err != nil {
+		return nil, err
+	}
+	log.Infof("对账%d完成, 扫描%d, 归还%d, 确认%d, 待支付%d, 不一致%d, 失败%d",
+		run.ID, run.Scanned, run.Returned, run.Confirmed, run.Pending, run.Discrepancies, run.Failed)
+	return run, nil
+}
+
+// reconcileOne 对比一条扣减记录和订单状态，订单仍待支付时返回 nil
+func (rs *reconcileService) reconcileOne(ctx context.Context, detail *do.StockSellDetailDO, dryRun bool) *do.ReconcileItemDO {
+	item := &do.ReconcileItemDO{OrderSn: detail.OrderSn}
+
+	order, err := rs.data.Orders().OrderDetailByOrderSn(ctx, &orderpb.AlipayOrderSnRequest{OrderSn: detail.OrderSn})
+	if err != nil && !errors.IsCode(errors.FromGrpcError(err), code2.ErrOrderNotFound) {
+		item.Action = do.ReconcileActionFailed
+		item.Message = fmt.Sprintf("查询订单失败: %v", err)
+		return item
+	}
+	if err == nil {
+		item.OrderStatus = order.OrderInfo.Status
+	}
+
+	switch item.OrderStatus {
+	case orderStatusPaying, orderStatusWaitBuyerPay:
+		return nil
+	case orderStatusTradeSuccess, orderStatusTradeFinished, orderStatusShipped, orderStatusReceived, orderStatusRefundSuccess:
+		if detail.Status == do.StockSellStatusProcessing {
+			// 已支付的订单却有归还中的记录，说明归还流程被误触发，不能自动处理
+			item.Action = do.ReconcileActionDiscrepancy
+			item.Message = "订单已支付，但扣减记录处于归还中"
+			return item
+		}
+		item.Action = do.ReconcileActionConfirmed
+		if dryRun {
+			return item
+		}
+		if err := rs.data.Inventorys().UpdateStockSellDetailStatus(ctx, nil, detail.OrderSn, do.StockSellStatusSold); err != nil {
+			item.Action = do.ReconcileActionFailed
+			item.Message = fmt.Sprintf("标记已售失败: %v", err)
+		}
+		return item
+	case "", orderStatusClosed, orderStatusTradeClosed, orderStatusCancelled:
+		// 订单不存在说明下单事务已回滚，订单关闭或取消说明超时消息或补偿没有归还成功
+		if dryRun {
+			item.Action = do.ReconcileActionWouldReturn
+			return item
+		}
+		item.Action = do.ReconcileActionReturned
+		if err := rs.reback(ctx, detail.OrderSn); err != nil {
+			item.Action = do.ReconcileActionFailed
+			item.Message = fmt.Sprintf("归还库存失败: %v", err)
+		}
+		return item
+	default:
+		item.Action = do.ReconcileActionDiscrepancy
+		item.Message = fmt.Sprintf("无法识别的订单状态 %s", item.OrderStatus)
+		return item
+	}
+}
+
+// reback 复用超时归还的逻辑，按扣减记录归还并写入流水
+func (rs *reconcileService) reback(ctx context.Context, ordersn string) error {
+	return rs.data.DB().Transaction(func(tx *gorm.DB) error {
+		_, err := rs.data.Inventorys().AutoReback(ctx, tx, ordersn, rs.data.Pool())
+		return err
+	})
+}
+
+func (rs *reconcileService) Report(ctx context.Context, runID uint64, meta metav1.ListMeta) (*do.ReconcileRunDO, *do.ReconcileItemDOList, error) {
+	run, err := rs.data.Reconciles().GetRun(ctx, runID)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := rs.data.Reconciles().ListItems(ctx, uint64(run.ID), meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	return run, items, nil
+}
+
+func (rs *reconcileService) Schedule(ctx context.Context) {
+	if rs.invOptions.ReconcileInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(rs.invOptions.ReconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := rs.Run(ctx, rs.invOptions.ReconcileDryRun); err != nil {
+				log.Errorf("定时对账失败: %v", err)
+			}
+		}
+	}
+}
+
+func newReconcileService(s *service) *reconcileService {
+	return &reconcileService{data: s.data, invOptions: s.invOptions}
+}
+
+var _ ReconcileSrv = &reconcileService{}
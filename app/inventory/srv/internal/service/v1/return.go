@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"Advanced_Shop/app/inventory/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	bgorm "Advanced_Shop/app/pkg/gorm"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"github.com/dtm-labs/client/dtmgrpc"
+	"github.com/go-redsync/redsync/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"sort"
+	"strconv"
+)
+
+// Return 售后退货由订单服务的退款 Saga 调用，每次退款使用不同的 gid，屏障保证同一次退款只归还一次
+// 退货数量按扣减记录拆回原仓库，已退数量从退货流水汇总，整单退完后扣减记录标记为已归还
+func (is *inventoryService) Return(ctx context.Context, ordersn string, detail []do.GoodsDetail) error {
+	log.Infof("订单%s退货归还库存", ordersn)
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "创建屏障失败: %v", err)
+	}
+
+	var returned do.GoodsDetailList
+	err = bgorm.CallWithGorm(barrier, is.data.DB(), func(tx *gorm.DB) error {
+		sellDetail, err := is.data.Inventorys().GetSellDetail(ctx, tx, ordersn)
+		if err != nil {
+			if errors.IsCode(err, code2.ErrInvSellDetailNotFound) {
+				return errors.WithCode(code2.ErrInvReturnExceeded, "订单%s没有扣减记录，不能退货", ordersn)
+			}
+			return err
+		}
+		if sellDetail.Status == do.StockSellStatusProcessing || sellDetail.Status == do.StockSellStatusDone {
+			log.Infof("订单%s已整单归还，跳过", ordersn)
+			return nil
+		}
+
+		sums, err := is.data.Ledgers().SumByOrder(ctx, tx, ordersn, do.LedgerTypeReturn)
+		if err != nil {
+			return err
+		}
+		already := make(map[[3]int32]int32, len(sums))
+		for _, s := range sums {
+			already[[3]int32{s.GoodId, s.Sku, s.Warehouse}] = s.Num
+		}
+
+		// 与 Sell 保持相同的加锁顺序，防止死锁
+		list := do.GoodsDetailList(detail)
+		sort.Sort(list)
+		goodsIDs := list.GoodsIDs()
+		rs := redsync.New(is.data.Pool())
+		for _, goodsID := range goodsIDs {
+			mutex := rs.NewMutex(do.InventoryLockPrefix + strconv.FormatInt(int64(goodsID), 10))
+			if err := mutex.LockContext(ctx); err != nil {
+				return errors.WithCode(code2.ErrRedisLock, "获取Redis锁失败: %v", err)
+			}
+			defer mutex.Unlock()
+		}
+
+		for _, goodsInfo := range detail {
+			remain := goodsInfo.Num
+			for _, sold := range sellDetail.Detail {
+				if remain <= 0 {
+					break
+				}
+				if sold.GoodId != goodsInfo.GoodId || sold.Sku != goodsInfo.Sku {
+					continue
+				}
+				key := [3]int32{sold.GoodId, sold.Sku, sold.Warehouse}
+				take := sold.Num - already[key]
+				if take > remain {
+					take = remain
+				}
+				if take <= 0 {
+					continue
+				}
+
+				inv, err := is.data.Inventorys().GetWithTx(ctx, tx, uint64(sold.GoodId), uint64(sold.Sku), uint64(sold.Warehouse))
+				if err != nil {
+					return err
+				}
+				inv.Stock += take
+				if err := is.data.Inventorys().Increase(ctx, tx, inv); err != nil {
+					return err
+				}
+				err = is.data.Ledgers().Append(ctx, tx, &do.InventoryLedgerDO{
+					Goods:     sold.GoodId,
+					Sku:       sold.Sku,
+					Warehouse: sold.Warehouse,
+					OrderSn:   ordersn,
+					Type:      do.LedgerTypeReturn,
+					Change:    take,
+				})
+				if err != nil {
+					return err
+				}
+				already[key] += take
+				remain -= take
+				returned = append(returned, do.GoodsDetail{GoodId: sold.GoodId, Sku: sold.Sku, Num: take, Warehouse: sold.Warehouse})
+			}
+			if remain > 0 {
+				return errors.WithCode(code2.ErrInvReturnExceeded, "订单%s商品%d退货数量超过可退数量%d", ordersn, goodsInfo.GoodId, goodsInfo.Num-remain)
+			}
+		}
+
+		for _, sold := range sellDetail.Detail {
+			if already[[3]int32{sold.GoodId, sold.Sku, sold.Warehouse}] < sold.Num {
+				return nil
+			}
+		}
+		// 整单都已退货，之后的整单归还直接跳过
+		return is.data.Inventorys().UpdateStockSellDetailStatus(ctx, tx, ordersn, do.StockSellStatusDone)
+	})
+	if err != nil {
+		return err
+	}
+	is.checkStock(ctx, stockChanges(returned, 1, 0))
+	return nil
+}
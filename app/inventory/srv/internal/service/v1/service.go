@@ -7,23 +7,35 @@ import (
 
 type ServiceFactory interface {
 	Inventories() InventorySrv
+	Warehouses() WarehouseSrv
+	Reconciles() ReconcileSrv
 }
 
 type service struct {
 	data v1.DataFactory
 
 	redisOptions *options.RedisOptions
+	invOptions   *options.InventoryOptions
 }
 
 func (s *service) Inventories() InventorySrv {
 	return newInventoryService(s)
 }
 
-func NewService(store v1.DataFactory, redisOptions *options.RedisOptions) ServiceFactory {
+func (s *service) Warehouses() WarehouseSrv {
+	return newWarehouseService(s)
+}
+
+func (s *service) Reconciles() ReconcileSrv {
+	return newReconcileService(s)
+}
+
+func NewService(store v1.DataFactory, redisOptions *options.RedisOptions, invOptions *options.InventoryOptions) ServiceFactory {
 
 	return &service{
 		data:         store,
 		redisOptions: redisOptions,
+		invOptions:   invOptions,
 	}
 }
 
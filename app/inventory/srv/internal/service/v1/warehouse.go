@@ -0,0 +1,26 @@
+package v1
+
+import (
+	v1 "Advanced_Shop/app/inventory/srv/internal/data/v1"
+	"Advanced_Shop/app/inventory/srv/internal/domain/dto"
+	"context"
+)
+
+type WarehouseSrv interface {
+	// Create 新建仓库
+	Create(ctx context.Context, warehouse *dto.WarehouseDTO) error
+}
+
+type warehouseService struct {
+	data v1.DataFactory
+}
+
+func (ws *warehouseService) Create(ctx context.Context, warehouse *dto.WarehouseDTO) error {
+	return ws.data.Warehouses().Create(ctx, &warehouse.WarehouseDO)
+}
+
+func newWarehouseService(s *service) *warehouseService {
+	return &warehouseService{data: s.data}
+}
+
+var _ WarehouseSrv = &warehouseService{}
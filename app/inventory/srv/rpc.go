@@ -24,13 +24,19 @@ func NewInventoryRPCServer(cfg *config.Config, ctx context.Context) (*rpcserver.
 	})
 
 	//有点繁琐，wire， ioc-golang
-	dataFactory, err := db2.GetDBFactoryOr(cfg.MySQLOptions, cfg.Mq)
-	dataFactory.Listen(ctx)
+	dataFactory, err := db2.GetDBFactoryOr(cfg.MySQLOptions, cfg.Mq, cfg.Registry)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 
-	invService := v13.NewService(dataFactory, cfg.RedisOptions)
+	invService := v13.NewService(dataFactory, cfg.RedisOptions, cfg.Inventory)
+	// 超时归还后检查库存水位，售罄的商品恢复库存后重新上架
+	dataFactory.Listen(ctx, invService.Inventories().CheckReback)
+	if cfg.Inventory.FlashSale {
+		dataFactory.ListenFlashSale(ctx, invService.Inventories().PersistFlashSale)
+	}
+	// 定时对账，间隔为 0 时直接返回
+	go invService.Reconciles().Schedule(ctx)
 
 	invServer := v12.NewInventoryServer(invService)
 
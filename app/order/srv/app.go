@@ -10,8 +10,10 @@ import (
 	"Advanced_Shop/gnova/registry/consul"
 	"Advanced_Shop/pkg/app"
 	"Advanced_Shop/pkg/log"
+	"Advanced_Shop/pkg/storage"
 	"context"
 	"github.com/hashicorp/consul/api"
+	"time"
 )
 
 func NewApp(basename string) *app.App {
@@ -46,6 +48,43 @@ func NeworderApp(cfg *config.Config, ctx context.Context) (*gapp.App, error) {
 	//服务注册
 	register := NewRegistrar(cfg.Registry)
 
+	//使用 Redis 延时队列或游客购物车时连接redis
+	if cfg.Delay.Type == options.DelayQueueRedis || cfg.Cart.GuestEnable {
+		redisConfig := &storage.Config{
+			Host:                  cfg.RedisOptions.Host,
+			Port:                  cfg.RedisOptions.Port,
+			Addrs:                 cfg.RedisOptions.Addrs,
+			MasterName:            cfg.RedisOptions.MasterName,
+			Username:              cfg.RedisOptions.Username,
+			Password:              cfg.RedisOptions.Password,
+			Database:              cfg.RedisOptions.Database,
+			MaxIdle:               cfg.RedisOptions.MaxIdle,
+			MaxActive:             cfg.RedisOptions.MaxActive,
+			Timeout:               cfg.RedisOptions.Timeout,
+			EnableCluster:         cfg.RedisOptions.EnableCluster,
+			UseSSL:                cfg.RedisOptions.UseSSL,
+			SSLInsecureSkipVerify: cfg.RedisOptions.SSLInsecureSkipVerify,
+			EnableTracing:         cfg.RedisOptions.EnableTracing,
+		}
+		redisCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		storage.ConnectToRedis(redisCtx, redisConfig)
+
+		// 等待Redis连接就绪
+		for i := 0; i < 10; i++ {
+			if storage.Connected() {
+				log.Info("Redis连接成功")
+				break
+			}
+			log.Warn("等待Redis连接就绪...")
+			time.Sleep(1000 * time.Millisecond)
+		}
+
+		if !storage.Connected() {
+			log.Fatal("Redis连接失败，服务启动失败")
+		}
+	}
+
 	//生成rpc服务  传根 ctx
 	rpcServer, err := NewOrderRPCServer(cfg, ctx)
 	if err != nil {
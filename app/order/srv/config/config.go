@@ -9,13 +9,22 @@ import (
 )
 
 type Config struct {
-	MySQLOptions *options.MySQLOptions     `json:"mysql"     mapstructure:"mysql"`
-	MQOptions    *options.RocketMQOptions  `json:"mq" mapstructure:"mq"`
-	Log          *log.Options              `json:"log"     mapstructure:"log"`
-	Server       *options.ServerOptions    `json:"server"     mapstructure:"server"`
-	Telemetry    *options.TelemetryOptions `json:"telemetry" mapstructure:"telemetry"`
-	Registry     *options.RegistryOptions  `json:"registry" mapstructure:"registry"`
-	Dtm          *options.DtmOptions       `json:"dtm" mapstructure:"dtm"`
+	MySQLOptions *options.MySQLOptions      `json:"mysql"     mapstructure:"mysql"`
+	MQOptions    *options.RocketMQOptions   `json:"mq" mapstructure:"mq"`
+	Log          *log.Options               `json:"log"     mapstructure:"log"`
+	Server       *options.ServerOptions     `json:"server"     mapstructure:"server"`
+	Telemetry    *options.TelemetryOptions  `json:"telemetry" mapstructure:"telemetry"`
+	Registry     *options.RegistryOptions   `json:"registry" mapstructure:"registry"`
+	Dtm          *options.DtmOptions        `json:"dtm" mapstructure:"dtm"`
+	Aliyun       *options.AliyunOptions     `json:"aliyun" mapstructure:"aliyun"`
+	Payment      *options.PaymentOptions    `json:"payment" mapstructure:"payment"`
+	Pricing      *options.PricingOptions    `json:"pricing" mapstructure:"pricing"`
+	Delay        *options.DelayQueueOptions `json:"delay" mapstructure:"delay"`
+	RedisOptions *options.RedisOptions      `json:"redis" mapstructure:"redis"`
+	Outbox       *options.OutboxOptions     `json:"outbox" mapstructure:"outbox"`
+	Reconcile    *options.ReconcileOptions  `json:"reconcile" mapstructure:"reconcile"`
+	Shipping     *options.ShippingOptions   `json:"shipping" mapstructure:"shipping"`
+	Cart         *options.CartOptions       `json:"cart" mapstructure:"cart"`
 }
 
 func New() *Config {
@@ -28,6 +37,15 @@ func New() *Config {
 		Registry:     options.NewRegistryOptions(),
 		Dtm:          options.NewDtmOptions(),
 		MQOptions:    options.NewRocketMQOptions(),
+		Aliyun:       options.NewAliyunOptions(),
+		Payment:      options.NewPaymentOptions(),
+		Pricing:      options.NewPricingOptions(),
+		Delay:        options.NewDelayQueueOptions(),
+		RedisOptions: options.NewRedisOptions(),
+		Outbox:       options.NewOutboxOptions(),
+		Reconcile:    options.NewReconcileOptions(),
+		Shipping:     options.NewShippingOptions(),
+		Cart:         options.NewCartOptions(),
 	}
 }
 
@@ -39,6 +57,16 @@ func (o *Config) Flags() (fss cliflag.NamedFlagSets) {
 	o.Registry.AddFlags(fss.FlagSet("registry"))
 	o.MySQLOptions.AddFlags(fss.FlagSet("mysql"))
 	o.MQOptions.AddFlags(fss.FlagSet("mq"))
+	o.Dtm.AddFlags(fss.FlagSet("dtm"))
+	o.Aliyun.AddFlags(fss.FlagSet("aliyun"))
+	o.Payment.AddFlags(fss.FlagSet("payment"))
+	o.Pricing.AddFlags(fss.FlagSet("pricing"))
+	o.Delay.AddFlags(fss.FlagSet("delay"))
+	o.RedisOptions.AddFlags(fss.FlagSet("redis"))
+	o.Outbox.AddFlags(fss.FlagSet("outbox"))
+	o.Reconcile.AddFlags(fss.FlagSet("reconcile"))
+	o.Shipping.AddFlags(fss.FlagSet("shipping"))
+	o.Cart.AddFlags(fss.FlagSet("cart"))
 	return fss
 }
 
@@ -56,5 +84,17 @@ func (o *Config) Validate() []error {
 	errs = append(errs, o.Server.Validate()...)
 	errs = append(errs, o.Telemetry.Validate()...)
 	errs = append(errs, o.Registry.Validate()...)
+	errs = append(errs, o.Dtm.Validate()...)
+	errs = append(errs, o.Pricing.Validate()...)
+	errs = append(errs, o.Delay.Validate()...)
+	errs = append(errs, o.Outbox.Validate()...)
+	errs = append(errs, o.Reconcile.Validate()...)
+	errs = append(errs, o.Shipping.Validate()...)
+	errs = append(errs, o.Cart.Validate()...)
+	if o.Delay.Type == options.DelayQueueRedis || o.Cart.GuestEnable {
+		errs = append(errs, o.RedisOptions.Validate()...)
+	}
+	errs = append(errs, o.Aliyun.Validate()...)
+	errs = append(errs, o.Payment.Validate()...)
 	return errs
 }
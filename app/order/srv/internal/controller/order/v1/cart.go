@@ -3,14 +3,32 @@ package order
 import (
 	pb "Advanced_Shop/api/order/v1"
 	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/service/v1"
+	"Advanced_Shop/app/pkg/code"
 	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func (os *orderServer) CartItemList(ctx context.Context, info *pb.UserInfo) (*pb.CartItemListResponse, error) {
+// cart 按请求选择用户购物车或游客购物车
+func (os *orderServer) cart(guest bool) (service.CartSrv, error) {
+	if !guest {
+		return os.srv.Cart(), nil
+	}
+	cart := os.srv.GuestCart()
+	if cart == nil {
+		return nil, errors.WithCode(code.ErrGuestCartDisabled, "未开启游客购物车")
+	}
+	return cart, nil
+}
 
-	list, err := os.srv.Cart().List(ctx, uint64(info.Id), false, v1.ListMeta{}, []string{})
+func (os *orderServer) CartItemList(ctx context.Context, info *pb.UserInfo) (*pb.CartItemListResponse, error) {
+	cart, err := os.cart(info.Guest)
+	if err != nil {
+		return nil, err
+	}
+	list, err := cart.List(ctx, uint64(info.Id), false, v1.ListMeta{}, []string{})
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +39,7 @@ func (os *orderServer) CartItemList(ctx context.Context, info *pb.UserInfo) (*pb
 			Id:      model.ID,
 			UserId:  model.User,
 			GoodsId: model.Goods,
+			SkuId:   model.Sku,
 			Nums:    model.Nums,
 			Checked: model.Checked,
 		})
@@ -33,32 +52,44 @@ func (os *orderServer) CartItemList(ctx context.Context, info *pb.UserInfo) (*pb
 }
 
 func (os *orderServer) CreateCartItem(ctx context.Context, request *pb.CartItemRequest) (*pb.ShopCartInfoResponse, error) {
+	cart, err := os.cart(request.Guest)
+	if err != nil {
+		return nil, err
+	}
 	check := true
 	info := do.ShoppingCartDO{
 		User:    request.UserId,
 		Goods:   request.GoodsId,
+		Sku:     request.SkuId,
 		Nums:    request.Nums,
 		Checked: &check,
 	}
-	id, err := os.srv.Cart().Create(ctx, &info)
+	id, err := cart.Create(ctx, &info)
 	if err != nil {
 		return nil, err
 	}
 
+	// 游客第一次加购时 UserId 是新分配的游客ID
 	return &pb.ShopCartInfoResponse{
-		Id: id,
+		Id:     id,
+		UserId: info.User,
 	}, nil
 
 }
 
 func (os *orderServer) UpdateCartItem(ctx context.Context, request *pb.CartItemRequest) (*emptypb.Empty, error) {
+	cart, err := os.cart(request.Guest)
+	if err != nil {
+		return nil, err
+	}
 	info := do.ShoppingCartDO{
 		Goods:   request.GoodsId,
+		Sku:     request.SkuId,
 		Nums:    request.Nums,
 		Checked: request.Checked,
 		User:    request.UserId,
 	}
-	err := os.srv.Cart().UpdateNum(ctx, &info)
+	err = cart.UpdateNum(ctx, &info)
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +97,53 @@ func (os *orderServer) UpdateCartItem(ctx context.Context, request *pb.CartItemR
 }
 
 func (os *orderServer) DeleteCartItem(ctx context.Context, request *pb.CartItemRequest) (*emptypb.Empty, error) {
-	err := os.srv.Cart().Delete(ctx, uint64(request.UserId), uint64(request.GoodsId))
+	cart, err := os.cart(request.Guest)
+	if err != nil {
+		return nil, err
+	}
+	err = cart.Delete(ctx, uint64(request.UserId), uint64(request.GoodsId), uint64(request.SkuId))
 	if err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (os *orderServer) MergeCart(ctx context.Context, request *pb.MergeCartRequest) (*emptypb.Empty, error) {
+	err := service.MergeGuestCart(ctx, os.srv, uint64(request.GuestId), uint64(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (os *orderServer) ValidateCart(ctx context.Context, request *pb.ValidateCartRequest) (*pb.ValidateCartResponse, error) {
+	cart, err := os.cart(request.Guest)
+	if err != nil {
+		return nil, err
+	}
+	result, err := cart.Validate(ctx, uint64(request.UserId), request.CheckedOnly, request.AcceptPrice)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ValidateCartResponse{Valid: result.Valid()}
+	for _, line := range result.Lines {
+		response.Lines = append(response.Lines, &pb.CartLineCheck{
+			GoodsId:           line.Goods,
+			SkuId:             line.Sku,
+			SkuSpecs:          line.SkuSpecs,
+			GoodsName:         line.Name,
+			Nums:              line.Nums,
+			Checked:           line.Checked,
+			AddedPrice:        line.AddedPrice,
+			Price:             line.Price,
+			Stock:             line.Stock,
+			Limit:             line.Limit,
+			PriceChanged:      line.PriceChanged,
+			OffSale:           line.OffSale,
+			InsufficientStock: line.InsufficientStock,
+			LimitExceeded:     line.LimitExceeded,
+		})
+	}
+	return response, nil
+}
@@ -9,6 +9,7 @@ import (
 	"Advanced_Shop/pkg/log"
 	"context"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
 )
 
 type orderServer struct {
@@ -27,13 +28,39 @@ func (os *orderServer) CreateOrder(ctx context.Context, request *pb.CreateReques
 	for i, item := range request.OrderItems {
 		orderGoods[i] = &do.OrderGoodsModel{
 			Goods:      item.GoodsId,
+			Sku:        item.SkuId,
+			SkuSpecs:   item.SkuSpecs,
 			GoodsName:  item.GoodsName,
 			GoodImages: item.GoodsImage,
 			GoodsPrice: item.GoodsPrice,
 			Nums:       item.Nums,
+			Price:      item.Price,
+			PayAmount:  item.PayAmount,
 		}
 	}
 
+	price := request.GetPrice()
+	adjustments := make(do.PriceAdjustmentList, 0, len(price.GetAdjustments()))
+	for _, adj := range price.GetAdjustments() {
+		adjustments = append(adjustments, do.PriceAdjustment{Type: adj.Type, Name: adj.Name, Amount: adj.Amount})
+	}
+	subOrders := make([]*dto.SubOrderDTO, 0, len(request.SubOrders))
+	for _, sub := range request.SubOrders {
+		subOrders = append(subOrders, &dto.SubOrderDTO{
+			OrderInfoDO: do.OrderInfoDO{
+				OrderSn:        sub.OrderSn,
+				Merchant:       sub.MerchantId,
+				Warehouse:      sub.WarehouseId,
+				OrderMount:     do.Yuan(sub.GetPrice().GetPayAmount()),
+				GoodsAmount:    sub.GetPrice().GetGoodsAmount(),
+				DiscountAmount: sub.GetPrice().GetDiscountAmount(),
+				ShippingFee:    sub.GetPrice().GetShippingFee(),
+				PayAmount:      sub.GetPrice().GetPayAmount(),
+			},
+			GoodIds: sub.GoodsId,
+			SkuIds:  sub.SkuId,
+		})
+	}
 	err := os.srv.Orders().Create(ctx, &dto.OrderInfoResponse{
 		OrderInfoDO: do.OrderInfoDO{
 			OrderMount:   request.PriceSum,
@@ -43,9 +70,18 @@ func (os *orderServer) CreateOrder(ctx context.Context, request *pb.CreateReques
 			SignerMobile: request.Mobile,
 			Post:         request.Post,
 			OrderSn:      request.OrderSn,
+			PayType:      request.PayType,
+
+			GoodsAmount:    price.GetGoodsAmount(),
+			DiscountAmount: price.GetDiscountAmount(),
+			ShippingFee:    price.GetShippingFee(),
+			PayAmount:      price.GetPayAmount(),
+			CouponCode:     price.GetCouponCode(),
+			PriceDetail:    adjustments,
 		},
 		OrderGoods: orderGoods,
 		GoodIds:    request.GoodsId,
+		SubOrders:  subOrders,
 	})
 	if err != nil {
 		return nil, err
@@ -53,7 +89,28 @@ func (os *orderServer) CreateOrder(ctx context.Context, request *pb.CreateReques
 	return &emptypb.Empty{}, nil
 }
 
-func (os *orderServer) CreateOrderCom(ctx context.Context, request *pb.OrderRequest) (*emptypb.Empty, error) {
+// CreateOrderCom CreateOrder 的补偿，订单置为已取消并恢复购物车
+func (os *orderServer) CreateOrderCom(ctx context.Context, request *pb.CreateRequest) (*emptypb.Empty, error) {
+	log.Info("saga 补偿调用")
+	if err := os.srv.Orders().CreateCom(ctx, request.OrderSn); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CancelOrder 用户取消待支付的订单
+func (os *orderServer) CancelOrder(ctx context.Context, request *pb.OrderRequest) (*emptypb.Empty, error) {
+	if err := os.srv.Orders().Cancel(ctx, request.UserId, request.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CloseOrder 这个是给取消订单的saga调用的
+func (os *orderServer) CloseOrder(ctx context.Context, request *pb.OrderStatus) (*emptypb.Empty, error) {
+	if err := os.srv.Orders().Close(ctx, request.OrderSn, request.Operator); err != nil {
+		return nil, err
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -67,15 +124,31 @@ func (os *orderServer) SubmitOrder(ctx context.Context, request *pb.OrderRequest
 			SignerMobile: request.Mobile,
 			Post:         request.Post,
 			OrderSn:      request.OrderSn,
+			CouponCode:   request.CouponCode,
+			PayType:      request.PayType,
 		},
+		Province: request.Province,
 	}
-	total, err := os.srv.Orders().Submit(ctx, &orderDTO)
+	result, err := os.srv.Orders().Submit(ctx, &orderDTO)
 	if err != nil {
 		log.Errorf("新建订单失败: %v", err)
 		return nil, err
 	}
 
-	return &pb.SubmitResponse{PriceSum: total}, nil
+	return &pb.SubmitResponse{PriceSum: do.Yuan(result.PayAmount), PayAmount: result.PayAmount}, nil
+}
+
+// PreviewOrder 购物车页面展示的最终价格，与提交订单的计价结果一致
+func (os *orderServer) PreviewOrder(ctx context.Context, request *pb.OrderRequest) (*pb.PreviewOrderResponse, error) {
+	result, err := os.srv.Orders().Preview(ctx, request.UserId, request.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.PreviewOrderResponse{Price: result.Breakdown()}
+	for _, line := range result.Lines {
+		response.Items = append(response.Items, line.Item())
+	}
+	return response, nil
 }
 
 func (os *orderServer) OrderList(ctx context.Context, request *pb.OrderFilterRequest) (*pb.OrderListResponse, error) {
@@ -84,25 +157,14 @@ func (os *orderServer) OrderList(ctx context.Context, request *pb.OrderFilterReq
 		Page:     int(request.Pages),
 		PageSize: int(request.PagePerNums),
 	}
-	list, err := os.srv.Orders().List(ctx, uint64(request.UserId), pageInfo, []string{})
+	list, err := os.srv.Orders().List(ctx, uint64(request.UserId), request.View, pageInfo, []string{})
 	if err != nil {
 		return nil, err
 	}
 	response.Total = int32(list.TotalCount)
 	var modelsInfo []*pb.OrderInfoResponse
 	for _, item := range list.Items {
-		modelsInfo = append(modelsInfo, &pb.OrderInfoResponse{
-			Id:      item.ID,
-			UserId:  item.User,
-			OrderSn: item.OrderSn,
-			PayType: item.PayType,
-			Status:  item.Status,
-			Post:    item.Post,
-			Total:   item.OrderMount,
-			Address: item.Address,
-			Name:    item.SignerName,
-			Mobile:  item.SignerMobile,
-		})
+		modelsInfo = append(modelsInfo, orderInfoResponse(&item.OrderInfoDO))
 	}
 	response.Data = modelsInfo
 
@@ -110,7 +172,7 @@ func (os *orderServer) OrderList(ctx context.Context, request *pb.OrderFilterReq
 }
 
 func (os *orderServer) OrderDetail(ctx context.Context, request *pb.OrderRequest) (*pb.OrderInfoDetailResponse, error) {
-	var response *pb.OrderInfoDetailResponse
+	response := &pb.OrderInfoDetailResponse{}
 	detail := dto.OrderDetailRequest{
 		UserID:  request.UserId,
 		OrderID: request.Id,
@@ -120,36 +182,49 @@ func (os *orderServer) OrderDetail(ctx context.Context, request *pb.OrderRequest
 		return nil, err
 	}
 	// 构建返回
-	response.OrderInfo = &pb.OrderInfoResponse{
-		Id:      resp.ID,
-		UserId:  resp.User,
-		OrderSn: resp.OrderSn,
-		PayType: resp.PayType,
-		Status:  resp.Status,
-		Post:    resp.Post,
-		Total:   resp.OrderMount,
-		Address: resp.Address,
-		Name:    resp.SignerName,
-		Mobile:  resp.SignerMobile,
-	}
+	response.OrderInfo = orderInfoResponse(&resp.OrderInfoDO)
 	var Goods []*pb.OrderItemResponse
 	for _, item := range resp.OrderGoods {
 		Goods = append(Goods, &pb.OrderItemResponse{
 			Id:         item.ID,
 			OrderId:    item.Order,
 			GoodsId:    item.Goods,
+			SkuId:      item.Sku,
+			SkuSpecs:   item.SkuSpecs,
 			GoodsName:  item.GoodsName,
 			GoodsPrice: item.GoodsPrice,
 			Nums:       item.Nums,
+			Price:      item.Price,
+			PayAmount:  item.PayAmount,
 		})
 	}
 	response.Goods = Goods
+	if resp.Split {
+		subOrders, err := os.srv.Orders().SubOrders(ctx, resp.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, sub := range subOrders {
+			response.SubOrders = append(response.SubOrders, orderInfoResponse(sub))
+		}
+	}
 
 	return response, nil
 }
 
 func (os *orderServer) UpdateOrderStatus(ctx context.Context, status *pb.OrderStatus) (*emptypb.Empty, error) {
-	err := os.srv.Orders().UpdateStatus(ctx, status.OrderSn, status.Status)
+	req := &dto.OrderStatusDTO{
+		OrderSn:  status.OrderSn,
+		Status:   status.Status,
+		Operator: status.Operator,
+		Reason:   status.Reason,
+		TradeNo:  status.TradeNo,
+	}
+	if status.PayTime > 0 {
+		payTime := time.Unix(status.PayTime, 0)
+		req.PayTime = &payTime
+	}
+	err := os.srv.Orders().UpdateStatus(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -157,34 +232,50 @@ func (os *orderServer) UpdateOrderStatus(ctx context.Context, status *pb.OrderSt
 
 }
 
+func (os *orderServer) OrderStatusHistory(ctx context.Context, request *pb.OrderStatusHistoryRequest) (*pb.OrderStatusHistoryResponse, error) {
+	list, err := os.srv.Orders().History(ctx, request.UserId, request.Id, v1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.OrderStatusHistoryResponse{Total: int32(list.TotalCount)}
+	for _, item := range list.Items {
+		response.Data = append(response.Data, &pb.OrderStatusHistoryItem{
+			Id:         item.ID,
+			OrderSn:    item.OrderSn,
+			FromStatus: item.FromStatus,
+			ToStatus:   item.ToStatus,
+			Operator:   item.Operator,
+			Reason:     item.Reason,
+			CreatedAt:  item.CreatedAt.Unix(),
+		})
+	}
+	return response, nil
+}
+
 func (os *orderServer) OrderDetailByOrderSn(ctx context.Context, request *pb.AlipayOrderSnRequest) (*pb.OrderInfoDetailResponse, error) {
 	resp, err := os.srv.Orders().GetByOrderSn(ctx, request.OrderSn)
 	if err != nil {
 		return nil, err
 	}
-	var response *pb.OrderInfoDetailResponse
+	response := &pb.OrderInfoDetailResponse{}
 	// 构建返回
-	response.OrderInfo = &pb.OrderInfoResponse{
-		Id:      resp.ID,
-		UserId:  resp.User,
-		OrderSn: resp.OrderSn,
-		PayType: resp.PayType,
-		Status:  resp.Status,
-		Post:    resp.Post,
-		Total:   resp.OrderMount,
-		Address: resp.Address,
-		Name:    resp.SignerName,
-		Mobile:  resp.SignerMobile,
-	}
+	response.OrderInfo = orderInfoResponse(&resp.OrderInfoDO)
 	var Goods []*pb.OrderItemResponse
 	for _, item := range resp.OrderGoods {
 		Goods = append(Goods, &pb.OrderItemResponse{
 			Id:         item.ID,
 			OrderId:    item.Order,
 			GoodsId:    item.Goods,
+			SkuId:      item.Sku,
+			SkuSpecs:   item.SkuSpecs,
 			GoodsName:  item.GoodsName,
 			GoodsPrice: item.GoodsPrice,
 			Nums:       item.Nums,
+			Price:      item.Price,
+			PayAmount:  item.PayAmount,
 		})
 	}
 	response.Goods = Goods
@@ -194,3 +285,43 @@ func (os *orderServer) OrderDetailByOrderSn(ctx context.Context, request *pb.Ali
 }
 
 var _ pb.OrderServer = &orderServer{}
+
+func orderInfoResponse(order *do.OrderInfoDO) *pb.OrderInfoResponse {
+	return &pb.OrderInfoResponse{
+		Id:          order.ID,
+		UserId:      order.User,
+		OrderSn:     order.OrderSn,
+		PayType:     order.PayType,
+		Status:      order.Status,
+		Post:        order.Post,
+		Total:       order.OrderMount,
+		Address:     order.Address,
+		Name:        order.SignerName,
+		Mobile:      order.SignerMobile,
+		Price:       priceBreakdown(order),
+		ParentId:    order.Parent,
+		ParentSn:    order.ParentSn,
+		MerchantId:  order.Merchant,
+		Split:       order.Split,
+		WarehouseId: order.Warehouse,
+	}
+}
+
+// priceBreakdown 订单的价格明细，计价上线之前的订单只有 OrderMount
+func priceBreakdown(order *do.OrderInfoDO) *pb.PriceBreakdown {
+	ret := &pb.PriceBreakdown{
+		GoodsAmount:    order.GoodsAmount,
+		DiscountAmount: order.DiscountAmount,
+		ShippingFee:    order.ShippingFee,
+		PayAmount:      order.PayAmount,
+		CouponCode:     order.CouponCode,
+	}
+	if order.GoodsAmount == 0 {
+		ret.GoodsAmount = do.Cents(order.OrderMount)
+		ret.PayAmount = ret.GoodsAmount
+	}
+	for _, adj := range order.PriceDetail {
+		ret.Adjustments = append(ret.Adjustments, &pb.PriceAdjustment{Type: adj.Type, Name: adj.Name, Amount: adj.Amount})
+	}
+	return ret
+}
@@ -0,0 +1,73 @@
+package order
+
+import (
+	pb "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+	"time"
+)
+
+func (os *orderServer) PayNotify(ctx context.Context, request *pb.PayNotification) (*pb.PayNotificationInfo, error) {
+	notification := &do.PaymentNotificationDO{
+		PayType:     request.PayType,
+		TradeNo:     request.TradeNo,
+		OrderSn:     request.OrderSn,
+		TradeStatus: request.TradeStatus,
+		Amount:      request.Amount,
+		Payload:     request.Payload,
+	}
+	if request.PayTime > 0 {
+		payTime := time.Unix(request.PayTime, 0)
+		notification.PayTime = &payTime
+	}
+	notification, err := os.srv.Payments().Notify(ctx, notification)
+	if err != nil {
+		return nil, err
+	}
+	return notificationInfo(notification), nil
+}
+
+func (os *orderServer) PayNotificationList(ctx context.Context, request *pb.PayNotificationFilterRequest) (*pb.PayNotificationListResponse, error) {
+	list, err := os.srv.Payments().Notifications(ctx, request.OrderId, v1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.PayNotificationListResponse{Total: int32(list.TotalCount)}
+	for _, notification := range list.Items {
+		response.Data = append(response.Data, notificationInfo(notification))
+	}
+	return response, nil
+}
+
+func (os *orderServer) ReplayPayNotification(ctx context.Context, request *pb.PayNotificationReplayRequest) (*pb.PayNotificationInfo, error) {
+	notification, err := os.srv.Payments().Replay(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return notificationInfo(notification), nil
+}
+
+func notificationInfo(notification *do.PaymentNotificationDO) *pb.PayNotificationInfo {
+	info := &pb.PayNotificationInfo{
+		Id:          notification.ID,
+		PayType:     notification.PayType,
+		OrderSn:     notification.OrderSn,
+		TradeNo:     notification.TradeNo,
+		TradeStatus: notification.TradeStatus,
+		Amount:      notification.Amount,
+		Status:      notification.Status,
+		Remark:      notification.Remark,
+		NotifyCount: notification.NotifyCount,
+		Payload:     notification.Payload,
+		CreatedAt:   notification.CreatedAt.Unix(),
+		UpdatedAt:   notification.UpdatedAt.Unix(),
+	}
+	if notification.PayTime != nil {
+		info.PayTime = notification.PayTime.Unix()
+	}
+	return info
+}
@@ -0,0 +1,80 @@
+package order
+
+import (
+	pb "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+)
+
+func (os *orderServer) ApplyRefund(ctx context.Context, request *pb.RefundApplyRequest) (*pb.RefundInfo, error) {
+	items := make([]dto.RefundItem, 0, len(request.Items))
+	for _, item := range request.Items {
+		items = append(items, dto.RefundItem{OrderGoods: item.OrderGoodsId, Nums: item.Nums})
+	}
+	refund, err := os.srv.Refunds().Apply(ctx, request.UserId, request.OrderId, items, request.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return refundInfo(refund), nil
+}
+
+func (os *orderServer) ApproveRefund(ctx context.Context, request *pb.RefundReviewRequest) (*pb.RefundInfo, error) {
+	refund, err := os.srv.Refunds().Approve(ctx, request.OrderId, request.Id, do.OperatorUser(request.OperatorId))
+	if err != nil {
+		return nil, err
+	}
+	return refundInfo(refund), nil
+}
+
+func (os *orderServer) RejectRefund(ctx context.Context, request *pb.RefundReviewRequest) (*pb.RefundInfo, error) {
+	refund, err := os.srv.Refunds().Reject(ctx, request.OrderId, request.Id, request.Remark, do.OperatorUser(request.OperatorId))
+	if err != nil {
+		return nil, err
+	}
+	return refundInfo(refund), nil
+}
+
+func (os *orderServer) RefundList(ctx context.Context, request *pb.RefundFilterRequest) (*pb.RefundListResponse, error) {
+	list, err := os.srv.Refunds().List(ctx, request.UserId, request.OrderId, v1.ListMeta{
+		Page:     int(request.Pages),
+		PageSize: int(request.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RefundListResponse{Total: int32(list.TotalCount)}
+	for _, refund := range list.Items {
+		response.Data = append(response.Data, refundInfo(refund))
+	}
+	return response, nil
+}
+
+func refundInfo(refund *do.RefundDO) *pb.RefundInfo {
+	info := &pb.RefundInfo{
+		Id:        refund.ID,
+		RefundSn:  refund.RefundSn,
+		OrderSn:   refund.OrderSn,
+		OrderId:   refund.Order,
+		UserId:    refund.User,
+		Status:    refund.Status,
+		Amount:    do.Yuan(refund.Amount),
+		Reason:    refund.Reason,
+		Remark:    refund.Remark,
+		CreatedAt: refund.CreatedAt.Unix(),
+
+		RefundAmount: refund.Amount,
+	}
+	for _, goods := range refund.Goods {
+		info.Goods = append(info.Goods, &pb.RefundGoodsInfo{
+			OrderGoodsId: goods.OrderGoods,
+			GoodsId:      goods.Goods,
+			GoodsName:    goods.GoodsName,
+			Nums:         goods.Nums,
+			Amount:       do.Yuan(goods.Amount),
+			RefundAmount: goods.Amount,
+		})
+	}
+	return info
+}
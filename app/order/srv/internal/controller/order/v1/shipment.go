@@ -0,0 +1,47 @@
+package order
+
+import (
+	pb "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"context"
+)
+
+func (os *orderServer) ShipOrder(ctx context.Context, request *pb.ShipRequest) (*pb.ShipmentInfo, error) {
+	shipment, err := os.srv.Shipments().Ship(ctx, request.OrderId, request.Carrier, request.TrackingNo, do.OperatorUser(request.OperatorId))
+	if err != nil {
+		return nil, err
+	}
+	return shipmentInfo(shipment), nil
+}
+
+func (os *orderServer) ShipmentDetail(ctx context.Context, request *pb.ShipmentRequest) (*pb.ShipmentInfo, error) {
+	shipment, err := os.srv.Shipments().Get(ctx, request.UserId, request.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return shipmentInfo(shipment), nil
+}
+
+func shipmentInfo(shipment *do.ShipmentDO) *pb.ShipmentInfo {
+	info := &pb.ShipmentInfo{
+		Id:         shipment.ID,
+		OrderId:    shipment.Order,
+		OrderSn:    shipment.OrderSn,
+		Carrier:    shipment.Carrier,
+		TrackingNo: shipment.TrackingNo,
+		Status:     shipment.Status,
+		ShippedAt:  shipment.ShippedAt.Unix(),
+	}
+	if shipment.DeliveredAt != nil {
+		info.DeliveredAt = shipment.DeliveredAt.Unix()
+	}
+	for _, event := range shipment.Events {
+		info.Events = append(info.Events, &pb.ShipmentEvent{
+			Time:        event.EventTime.Unix(),
+			Status:      event.Status,
+			Location:    event.Location,
+			Description: event.Description,
+		})
+	}
+	return info
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"context"
+	"time"
+)
+
+// TrackEvent 物流轨迹中的一个节点，Status 使用 do.ShipmentStatus*
+type TrackEvent struct {
+	Time        time.Time
+	Status      string
+	Location    string
+	Description string
+}
+
+// Carrier 物流查询渠道，按快递公司编码和运单号查询轨迹
+type Carrier interface {
+	// Track 返回运单目前的全部轨迹，按时间正序
+	Track(ctx context.Context, carrier, trackingNo string) ([]*TrackEvent, error)
+}
@@ -0,0 +1,46 @@
+package carrier
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"context"
+	"sync"
+	"time"
+)
+
+// fakeSteps 模拟物流依次经过的节点
+var fakeSteps = []v1.TrackEvent{
+	{Status: do.ShipmentStatusInTransit, Location: "始发网点", Description: "快件已揽收"},
+	{Status: do.ShipmentStatusInTransit, Location: "转运中心", Description: "快件已到达转运中心，正在发往目的地"},
+	{Status: do.ShipmentStatusInTransit, Location: "目的网点", Description: "快件正在派送中"},
+	{Status: do.ShipmentStatusDelivered, Location: "目的网点", Description: "快件已签收"},
+}
+
+// Fake 本地联调和测试用的物流渠道，轨迹保存在内存中
+// 每查询一次运单推进一个节点，查询四次后签收
+type Fake struct {
+	mu     sync.Mutex
+	tracks map[string][]*v1.TrackEvent
+}
+
+func NewFake() *Fake {
+	return &Fake{tracks: make(map[string][]*v1.TrackEvent)}
+}
+
+func (f *Fake) Track(ctx context.Context, carrier, trackingNo string) ([]*v1.TrackEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := carrier + ":" + trackingNo
+	events := f.tracks[key]
+	if len(events) < len(fakeSteps) {
+		event := fakeSteps[len(events)]
+		event.Time = time.Now().Truncate(time.Second)
+		events = append(events, &event)
+		f.tracks[key] = events
+	}
+	ret := make([]*v1.TrackEvent, len(events))
+	copy(ret, events)
+	return ret, nil
+}
+
+var _ v1.Carrier = &Fake{}
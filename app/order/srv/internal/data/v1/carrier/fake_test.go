@@ -0,0 +1,46 @@
+package carrier
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"context"
+	"testing"
+)
+
+func TestFakeTrack(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+	wantStatus := []string{
+		do.ShipmentStatusInTransit,
+		do.ShipmentStatusInTransit,
+		do.ShipmentStatusInTransit,
+		do.ShipmentStatusDelivered,
+		do.ShipmentStatusDelivered, // 签收之后不再推进
+	}
+	for i, want := range wantStatus {
+		events, err := f.Track(ctx, "SF", "SF001")
+		if err != nil {
+			t.Fatalf("第%d次查询 err: %v", i+1, err)
+		}
+		if n := min(i+1, len(fakeSteps)); len(events) != n {
+			t.Fatalf("第%d次查询 events = %d, want %d", i+1, len(events), n)
+		}
+		if got := events[len(events)-1].Status; got != want {
+			t.Fatalf("第%d次查询 status = %s, want %s", i+1, got, want)
+		}
+	}
+
+	// 不同运单的轨迹互不影响
+	events, err := f.Track(ctx, "SF", "SF002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Status != do.ShipmentStatusInTransit {
+		t.Fatalf("新运单 events = %d, status = %s", len(events), events[0].Status)
+	}
+
+	// 返回的是副本，调用方修改不影响保存的轨迹
+	events[0] = nil
+	if events, _ := f.Track(ctx, "SF", "SF002"); events[0] == nil {
+		t.Fatal("Track 返回的切片与内部轨迹共享")
+	}
+}
@@ -0,0 +1,115 @@
+package carrier
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const kuaidi100QueryURL = "https://poll.kuaidi100.com/poll/query.do"
+
+// Kuaidi100 快递100 实时查询接口，carrier 使用快递100 的公司编码，如 shunfeng、yuantong
+type Kuaidi100 struct {
+	customer string
+	key      string
+	client   *http.Client
+}
+
+func NewKuaidi100(customer, key string) *Kuaidi100 {
+	return &Kuaidi100{
+		customer: customer,
+		key:      key,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type kuaidi100Response struct {
+	Result     *bool  `json:"result"`
+	ReturnCode string `json:"returnCode"`
+	Message    string `json:"message"`
+	State      string `json:"state"`
+	Data       []struct {
+		Context  string `json:"context"`
+		Time     string `json:"time"`
+		AreaName string `json:"areaName"`
+		Status   string `json:"status"`
+	} `json:"data"`
+}
+
+func (k *Kuaidi100) Track(ctx context.Context, carrier, trackingNo string) ([]*v1.TrackEvent, error) {
+	param, _ := json.Marshal(map[string]string{
+		"com":      carrier,
+		"num":      trackingNo,
+		"resultv2": "1",
+	})
+	sum := md5.Sum([]byte(string(param) + k.key + k.customer))
+	form := url.Values{
+		"customer": {k.customer},
+		"sign":     {strings.ToUpper(hex.EncodeToString(sum[:]))},
+		"param":    {string(param)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, kuaidi100QueryURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.WithCode(code.ErrCarrier, "创建快递100请求失败: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rsp, err := k.client.Do(req)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrCarrier, "请求快递100失败: %v", err)
+	}
+	defer rsp.Body.Close()
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrCarrier, "读取快递100响应失败: %v", err)
+	}
+	var ret kuaidi100Response
+	if err := json.Unmarshal(body, &ret); err != nil {
+		return nil, errors.WithCode(code.ErrCarrier, "解析快递100响应失败: %v", err)
+	}
+	// 查询失败时返回 result=false 和 returnCode，成功时没有 result 字段
+	if ret.Result != nil && !*ret.Result {
+		return nil, errors.WithCode(code.ErrCarrier, "快递100查询失败: %s %s", ret.ReturnCode, ret.Message)
+	}
+
+	// 快递100 的轨迹按时间倒序返回
+	events := make([]*v1.TrackEvent, 0, len(ret.Data))
+	for i := len(ret.Data) - 1; i >= 0; i-- {
+		item := ret.Data[i]
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", item.Time, time.Local)
+		if err != nil {
+			continue
+		}
+		events = append(events, &v1.TrackEvent{
+			Time:        t,
+			Status:      kuaidi100Status(item.Status),
+			Location:    item.AreaName,
+			Description: item.Context,
+		})
+	}
+	return events, nil
+}
+
+// kuaidi100Status 把快递100 的节点状态（在途、揽收、派件、签收、疑难、退回等）转换为统一的状态
+func kuaidi100Status(status string) string {
+	switch {
+	case strings.Contains(status, "退签"), strings.Contains(status, "拒签"),
+		strings.Contains(status, "疑难"), strings.Contains(status, "退回"):
+		return do.ShipmentStatusException
+	case strings.Contains(status, "签收"):
+		return do.ShipmentStatusDelivered
+	default:
+		return do.ShipmentStatusInTransit
+	}
+}
+
+var _ v1.Carrier = &Kuaidi100{}
@@ -0,0 +1,315 @@
+package cart
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// redisItem 游客购物车中的一个商品，hash field 为商品ID，有规格时为 商品ID:规格ID
+type redisItem struct {
+	Nums    int32   `json:"nums"`
+	Checked bool    `json:"checked"`
+	Price   float32 `json:"price,omitempty"` // 加入购物车时的单价
+}
+
+// redisCarts 游客购物车，每个游客一个 Redis hash，key 为前缀加游客ID
+// 游客ID代替用户ID传入，条目没有自增主键，ID 使用商品ID；每次写入都会续期
+type redisCarts struct {
+	client redis.UniversalClient
+	opts   *options.CartOptions
+}
+
+func NewRedisCarts(client redis.UniversalClient, opts *options.CartOptions) v1.GuestCartStore {
+	return &redisCarts{client: client, opts: opts}
+}
+
+// guestCreatedField 分配游客ID时写入购物车的占位字段，不是商品条目，读取时被跳过
+const guestCreatedField = "created"
+
+// maxGuestAttempts 随机游客ID与已有购物车冲突时的重试次数
+const maxGuestAttempts = 5
+
+// NewGuest 游客ID随机生成，不能从一个ID推出其他游客的ID；和用户ID一样是 int32，可以原样放进购物车的 User 字段
+// 用 HSETNX 写入占位字段占用这个ID，已经被其他游客占用时重新生成
+func (rc *redisCarts) NewGuest(ctx context.Context) (int32, error) {
+	for i := 0; i < maxGuestAttempts; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt32))
+		if err != nil {
+			return 0, errors.WithCode(code.ErrRedisLock, "生成游客ID失败: %v", err)
+		}
+		id := int32(n.Int64()) + 1
+		key := rc.key(uint64(id))
+		ok, err := rc.client.HSetNX(ctx, key, guestCreatedField, time.Now().Unix()).Result()
+		if err != nil {
+			return 0, errors.WithCode(code.ErrRedisLock, "生成游客ID失败: %v", err)
+		}
+		if !ok {
+			continue
+		}
+		if err := rc.client.Expire(ctx, key, rc.opts.GuestTTL).Err(); err != nil {
+			return 0, errors.WithCode(code.ErrRedisLock, "生成游客ID失败: %v", err)
+		}
+		return id, nil
+	}
+	return 0, errors.WithCode(code.ErrRedisLock, "生成游客ID失败: 连续%d次与已有游客冲突", maxGuestAttempts)
+}
+
+func (rc *redisCarts) key(guestID uint64) string {
+	return fmt.Sprintf("%s%d", rc.opts.KeyPrefix, guestID)
+}
+
+// itemKey 购物车条目的 hash field，没有规格的商品和以前一样只用商品ID
+type itemKey struct {
+	goods int32
+	sku   int32
+}
+
+func (k itemKey) field() string {
+	if k.sku == 0 {
+		return strconv.Itoa(int(k.goods))
+	}
+	return fmt.Sprintf("%d:%d", k.goods, k.sku)
+}
+
+func parseField(field string) (itemKey, bool) {
+	goods, sku, _ := strings.Cut(field, ":")
+	goodsID, err := strconv.ParseInt(goods, 10, 32)
+	if err != nil {
+		return itemKey{}, false
+	}
+	var skuID int64
+	if sku != "" {
+		if skuID, err = strconv.ParseInt(sku, 10, 32); err != nil {
+			return itemKey{}, false
+		}
+	}
+	return itemKey{goods: int32(goodsID), sku: int32(skuID)}, true
+}
+
+func (rc *redisCarts) items(ctx context.Context, guestID uint64) ([]*do.ShoppingCartDO, error) {
+	values, err := rc.client.HGetAll(ctx, rc.key(guestID)).Result()
+	if err != nil {
+		return nil, errors.WithCode(code.ErrRedisLock, "读取游客购物车失败: %v", err)
+	}
+	items := make([]*do.ShoppingCartDO, 0, len(values))
+	for field, value := range values {
+		k, ok := parseField(field)
+		if !ok {
+			continue
+		}
+		var item redisItem
+		if err := json.Unmarshal([]byte(value), &item); err != nil {
+			continue
+		}
+		items = append(items, toDO(guestID, k, item))
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Goods != items[j].Goods {
+			return items[i].Goods < items[j].Goods
+		}
+		return items[i].Sku < items[j].Sku
+	})
+	return items, nil
+}
+
+func (rc *redisCarts) get(ctx context.Context, guestID uint64, k itemKey) (*redisItem, error) {
+	value, err := rc.client.HGet(ctx, rc.key(guestID), k.field()).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, errors.WithCode(code.ErrShopCartItemNotFound, "购物车中没有该商品")
+	}
+	if err != nil {
+		return nil, errors.WithCode(code.ErrRedisLock, "读取游客购物车失败: %v", err)
+	}
+	var item redisItem
+	if err := json.Unmarshal([]byte(value), &item); err != nil {
+		return nil, errors.WithCode(code.ErrRedisLock, "游客购物车数据损坏: %v", err)
+	}
+	return &item, nil
+}
+
+// save 写入若干商品并续期整个购物车
+func (rc *redisCarts) save(ctx context.Context, guestID uint64, items map[itemKey]redisItem) error {
+	values := make(map[string]interface{}, len(items))
+	for k, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return errors.WithCode(code.ErrRedisLock, err.Error())
+		}
+		values[k.field()] = string(data)
+	}
+	key := rc.key(guestID)
+	pipe := rc.client.TxPipeline()
+	pipe.HSet(ctx, key, values)
+	pipe.Expire(ctx, key, rc.opts.GuestTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "写入游客购物车失败: %v", err)
+	}
+	return nil
+}
+
+func (rc *redisCarts) remove(ctx context.Context, guestID uint64, keys ...itemKey) error {
+	fields := make([]string, 0, len(keys))
+	for _, k := range keys {
+		fields = append(fields, k.field())
+	}
+	if err := rc.client.HDel(ctx, rc.key(guestID), fields...).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "删除游客购物车商品失败: %v", err)
+	}
+	return nil
+}
+
+func (rc *redisCarts) List(ctx context.Context, userID uint64, checked bool, meta metav1.ListMeta, orderby []string) (*do.ShoppingCartDOList, error) {
+	items, err := rc.items(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if checked {
+		selected := items[:0]
+		for _, item := range items {
+			if *item.Checked {
+				selected = append(selected, item)
+			}
+		}
+		items = selected
+	}
+	// 和数据库实现一样分页，orderby 对游客购物车没有意义，固定按商品ID和规格ID排序
+	ret := &do.ShoppingCartDOList{TotalCount: int64(len(items))}
+	offset, limit := meta.GetOffset(), meta.GetLimit()
+	if offset < len(items) {
+		end := offset + limit
+		if end > len(items) {
+			end = len(items)
+		}
+		ret.Items = items[offset:end]
+	}
+	return ret, nil
+}
+
+// Create 已有同一商品时累加数量，不会像数据库实现那样产生重复条目
+func (rc *redisCarts) Create(ctx context.Context, cartItem *do.ShoppingCartDO) (int32, error) {
+	guestID := uint64(cartItem.User)
+	item := redisItem{Nums: cartItem.Nums, Checked: cartItem.Checked == nil || *cartItem.Checked, Price: cartItem.Price}
+	k := itemKey{goods: cartItem.Goods, sku: cartItem.Sku}
+	exist, err := rc.get(ctx, guestID, k)
+	if err == nil {
+		item.Nums += exist.Nums
+	} else if !errors.IsCode(err, code.ErrShopCartItemNotFound) {
+		return 0, err
+	}
+	if err := rc.save(ctx, guestID, map[itemKey]redisItem{k: item}); err != nil {
+		return 0, err
+	}
+	return cartItem.Goods, nil
+}
+
+func (rc *redisCarts) Get(ctx context.Context, userID, goodsID, skuID uint64) (*do.ShoppingCartDO, error) {
+	k := itemKey{goods: int32(goodsID), sku: int32(skuID)}
+	item, err := rc.get(ctx, userID, k)
+	if err != nil {
+		return nil, err
+	}
+	return toDO(userID, k, *item), nil
+}
+
+// UpdateNum 和数据库实现一致，零值字段保持原值
+func (rc *redisCarts) UpdateNum(ctx context.Context, cartItem *do.ShoppingCartDO) error {
+	guestID := uint64(cartItem.User)
+	k := itemKey{goods: cartItem.Goods, sku: cartItem.Sku}
+	item, err := rc.get(ctx, guestID, k)
+	if err != nil {
+		return err
+	}
+	if cartItem.Nums != 0 {
+		item.Nums = cartItem.Nums
+	}
+	if cartItem.Checked != nil {
+		item.Checked = *cartItem.Checked
+	}
+	if cartItem.Price != 0 {
+		item.Price = cartItem.Price
+	}
+	return rc.save(ctx, guestID, map[itemKey]redisItem{k: *item})
+}
+
+func (rc *redisCarts) Delete(ctx context.Context, userID, goodsID, skuID uint64) error {
+	return rc.remove(ctx, userID, itemKey{goods: int32(goodsID), sku: int32(skuID)})
+}
+
+// ClearCheck 和数据库实现一致，清空整个购物车
+func (rc *redisCarts) ClearCheck(ctx context.Context, userID uint64) error {
+	if err := rc.client.Del(ctx, rc.key(userID)).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "清空游客购物车失败: %v", err)
+	}
+	return nil
+}
+
+func (rc *redisCarts) GetBatchByUser(ctx context.Context, userID int32) (*do.GetShoppingBatchResponse, error) {
+	items, err := rc.items(ctx, uint64(userID))
+	if err != nil {
+		return nil, err
+	}
+	selected := items[:0]
+	for _, item := range items {
+		if *item.Checked {
+			selected = append(selected, item)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, errors.WithCode(code.ErrNoGoodsSelect, "未选择商品")
+	}
+	return do.NewShoppingBatchResponse(selected), nil
+}
+
+// DeleteItems 游客购物车不在数据库中，忽略 txn
+func (rc *redisCarts) DeleteItems(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error {
+	if len(items) == 0 {
+		return nil
+	}
+	keys := make([]itemKey, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, itemKey{goods: item.Goods, sku: item.Sku})
+	}
+	return rc.remove(ctx, userID, keys...)
+}
+
+func (rc *redisCarts) Restore(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error {
+	for _, item := range items {
+		item.User = int32(userID)
+		if _, err := rc.Create(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toDO(guestID uint64, k itemKey, item redisItem) *do.ShoppingCartDO {
+	checked := item.Checked
+	cartItem := &do.ShoppingCartDO{
+		User:    int32(guestID),
+		Goods:   k.goods,
+		Sku:     k.sku,
+		Nums:    item.Nums,
+		Checked: &checked,
+		Price:   item.Price,
+	}
+	cartItem.ID = k.goods
+	return cartItem
+}
+
+var _ v1.GuestCartStore = &redisCarts{}
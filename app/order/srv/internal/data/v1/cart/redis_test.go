@@ -0,0 +1,77 @@
+package cart
+
+import (
+	"Advanced_Shop/app/pkg/options"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestParseField(t *testing.T) {
+	tests := []struct {
+		field string
+		want  itemKey
+		ok    bool
+	}{
+		{field: "12", want: itemKey{goods: 12}, ok: true},
+		{field: "12:34", want: itemKey{goods: 12, sku: 34}, ok: true},
+		{field: guestCreatedField, ok: false}, // 分配游客ID时的占位字段不是商品条目
+		{field: "12:x", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := parseField(tt.field)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseField(%q) = %+v, %v, want %+v, %v", tt.field, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// 集成测试需要真实的 Redis，设置 XSHOP_TEST_REDIS=127.0.0.1:6379 后运行
+func TestNewGuest(t *testing.T) {
+	addr := os.Getenv("XSHOP_TEST_REDIS")
+	if addr == "" {
+		t.Skip("未设置 XSHOP_TEST_REDIS，跳过游客购物车集成测试")
+	}
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Fatalf("连接 Redis %s 失败: %v", addr, err)
+	}
+	opts := options.NewCartOptions()
+	opts.KeyPrefix = fmt.Sprintf("test:cart:%d:", time.Now().UnixNano())
+	rc := NewRedisCarts(client, opts).(*redisCarts)
+
+	seen := make(map[int32]bool)
+	t.Cleanup(func() {
+		for id := range seen {
+			client.Del(ctx, rc.key(uint64(id)))
+		}
+		_ = client.Close()
+	})
+	for i := 0; i < 100; i++ {
+		id, err := rc.NewGuest(ctx)
+		if err != nil {
+			t.Fatalf("NewGuest err: %v", err)
+		}
+		if id <= 0 || seen[id] {
+			t.Fatalf("游客ID %d 非正数或重复", id)
+		}
+		seen[id] = true
+	}
+
+	for id := range seen {
+		ttl, err := client.TTL(ctx, rc.key(uint64(id))).Result()
+		if err != nil || ttl <= 0 {
+			t.Fatalf("游客%d的购物车没有过期时间: %v %v", id, ttl, err)
+		}
+		items, err := rc.items(ctx, uint64(id))
+		if err != nil || len(items) != 0 {
+			t.Fatalf("新游客的购物车应为空，items = %d, err = %v", len(items), err)
+		}
+		break
+	}
+}
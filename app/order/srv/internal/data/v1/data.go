@@ -1,8 +1,10 @@
 package v1
 
 import (
+	apb "Advanced_Shop/api/action/v1"
 	proto "Advanced_Shop/api/goods/v1"
 	proto2 "Advanced_Shop/api/inventory/v1"
+	"Advanced_Shop/app/pkg/payment"
 	"context"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	pbe "github.com/withlin/canal-go/protocol/entry"
@@ -12,21 +14,38 @@ import (
 type DBFactory interface {
 	Orders() OrderStore
 	ShopCarts() ShopCartStore
+	StatusHistories() OrderStatusHistoryStore
+	Refunds() RefundStore
+	Promotions() PromotionStore
+	PaymentMismatches() PaymentMismatchStore
+	PaymentNotifications() PaymentNotificationStore
+	Shipments() ShipmentStore
 	Goods() proto.GoodsClient
 	Inventorys() proto2.InventoryClient
+	Coupons() apb.CouponClient
 
 	Begin() *gorm.DB
+	DB() *gorm.DB
 }
 
 type MQFactory interface {
 	BuildGoodsMQMessage(eventType pbe.EventType, rowData *pbe.RowData, header *pbe.Header) (*primitive.Message, error)
 	Send(ctx context.Context, mqMsg *primitive.Message) (*primitive.SendResult, error)
-	SendDelayMsgWithRetry(ctx context.Context, msg *primitive.Message) (*primitive.SendResult, error)
+	// SendDelayMsgWithRetry 按延时等级发送延时消息，失败时按配置重试
+	SendDelayMsgWithRetry(ctx context.Context, msg *primitive.Message, level int) (*primitive.SendResult, error)
 	//Listen()
 }
 
 type DataFactory interface {
 	NewDB() DBFactory
 	NewMQ() MQFactory
-	Listen(ctx context.Context)
+	// Payment 按订单的支付方式选择支付渠道
+	Payment(payType string) (payment.PaymentProvider, error)
+	// PayTypes 已开启的支付方式
+	PayTypes() []string
+	DelayQueue() DelayQueue
+	// Carrier 查询物流轨迹的渠道，没有配置时为 nil
+	Carrier() Carrier
+	// GuestCarts 游客购物车，未开启时为 nil
+	GuestCarts() GuestCartStore
 }
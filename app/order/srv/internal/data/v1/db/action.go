@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+
+	proto "Advanced_Shop/api/action/v1"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/gnova/server/rpcserver"
+	"Advanced_Shop/gnova/server/rpcserver/clientinterceptors"
+
+	"Advanced_Shop/gnova/registry"
+)
+
+const actionserviceName = "discovery:///xshop-action-srv"
+
+func GetCouponClient(opts *options.RegistryOptions) proto.CouponClient {
+	discovery := NewDiscovery(opts)
+	couponClient := NewCouponServiceClient(discovery)
+	return couponClient
+}
+
+func NewCouponServiceClient(r registry.Discovery) proto.CouponClient {
+	conn, err := rpcserver.DialInsecure(
+		context.Background(),
+		rpcserver.WithEndpoint(actionserviceName),
+		rpcserver.WithDiscovery(r),
+		rpcserver.WithClientUnaryInterceptor(clientinterceptors.UnaryTracingInterceptor),
+	)
+	if err != nil {
+		panic(err)
+	}
+	c := proto.NewCouponClient(conn)
+	return c
+}
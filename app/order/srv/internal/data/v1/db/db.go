@@ -1,6 +1,7 @@
 package db
 
 import (
+	apb "Advanced_Shop/api/action/v1"
 	proto "Advanced_Shop/api/goods/v1"
 	proto2 "Advanced_Shop/api/inventory/v1"
 	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
@@ -21,8 +22,9 @@ import (
 type dataFactory struct {
 	db *gorm.DB
 
-	invClient   proto2.InventoryClient
-	goodsClient proto.GoodsClient
+	invClient    proto2.InventoryClient
+	goodsClient  proto.GoodsClient
+	couponClient apb.CouponClient
 }
 
 func (df *dataFactory) Orders() v1.OrderStore {
@@ -33,6 +35,30 @@ func (df *dataFactory) ShopCarts() v1.ShopCartStore {
 	return newShopCarts(df)
 }
 
+func (df *dataFactory) StatusHistories() v1.OrderStatusHistoryStore {
+	return newStatusHistories(df)
+}
+
+func (df *dataFactory) Refunds() v1.RefundStore {
+	return newRefunds(df)
+}
+
+func (df *dataFactory) PaymentMismatches() v1.PaymentMismatchStore {
+	return newPaymentMismatches(df)
+}
+
+func (df *dataFactory) PaymentNotifications() v1.PaymentNotificationStore {
+	return newPaymentNotifications(df)
+}
+
+func (df *dataFactory) Shipments() v1.ShipmentStore {
+	return newShipments(df)
+}
+
+func (df *dataFactory) Promotions() v1.PromotionStore {
+	return newPromotions(df)
+}
+
 func (df *dataFactory) Goods() proto.GoodsClient {
 	return df.goodsClient
 }
@@ -41,10 +67,18 @@ func (df *dataFactory) Inventorys() proto2.InventoryClient {
 	return df.invClient
 }
 
+func (df *dataFactory) Coupons() apb.CouponClient {
+	return df.couponClient
+}
+
 func (df *dataFactory) Begin() *gorm.DB {
 	return df.db.Begin()
 }
 
+func (df *dataFactory) DB() *gorm.DB {
+	return df.db
+}
+
 var _ v1.DBFactory = &dataFactory{}
 
 var (
@@ -90,11 +124,13 @@ func NewDBFactoryOr(mysqlOpts *options.MySQLOptions, registry *options.RegistryO
 		//服务发现
 		goodsClient := GetGoodsClient(registry)
 		invClient := GetInventoryClient(registry)
+		couponClient := GetCouponClient(registry)
 
 		data = &dataFactory{
-			db:          db,
-			goodsClient: goodsClient,
-			invClient:   invClient,
+			db:           db,
+			goodsClient:  goodsClient,
+			invClient:    invClient,
+			couponClient: couponClient,
 		}
 	})
 
@@ -0,0 +1,100 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentNotifications struct {
+	db *gorm.DB
+}
+
+func newPaymentNotifications(factory *dataFactory) *paymentNotifications {
+	return &paymentNotifications{
+		db: factory.db,
+	}
+}
+
+func (pn *paymentNotifications) Receive(ctx context.Context, notification *do.PaymentNotificationDO) (*do.PaymentNotificationDO, error) {
+	var existing *do.PaymentNotificationDO
+	err := pn.db.Transaction(func(tx *gorm.DB) error {
+		notification.NotifyCount = 1
+		// 同一笔交易的通知并发到达时，只有一个能插入，其余的按重复通知处理
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(notification)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			return nil
+		}
+		var model do.PaymentNotificationDO
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("pay_type = ? AND trade_no = ?", notification.PayType, notification.TradeNo).
+			Take(&model).Error
+		if err != nil {
+			return err
+		}
+		existing = &model
+		return tx.Model(&model).Update("notify_count", gorm.Expr("notify_count + 1")).Error
+	})
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	if existing != nil {
+		notification.ID = existing.ID
+		notification.CreatedAt = existing.CreatedAt
+		notification.NotifyCount = existing.NotifyCount + 1
+	}
+	return existing, nil
+}
+
+func (pn *paymentNotifications) Update(ctx context.Context, notification *do.PaymentNotificationDO) error {
+	err := pn.db.Model(&do.PaymentNotificationDO{}).
+		Where("id = ?", notification.ID).
+		Updates(map[string]interface{}{
+			"trade_status": notification.TradeStatus,
+			"amount":       notification.Amount,
+			"pay_time":     notification.PayTime,
+			"status":       notification.Status,
+			"remark":       notification.Remark,
+			"payload":      notification.Payload,
+		}).Error
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (pn *paymentNotifications) Get(ctx context.Context, ID int32) (*do.PaymentNotificationDO, error) {
+	var model do.PaymentNotificationDO
+	err := pn.db.Where("id = ?", ID).Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrPayNotificationNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &model, nil
+}
+
+func (pn *paymentNotifications) List(ctx context.Context, orderSn string, meta metav1.ListMeta) (*do.PaymentNotificationDOList, error) {
+	ret := &do.PaymentNotificationDOList{}
+	query := pn.db.Model(&do.PaymentNotificationDO{}).Where("order_sn = ?", orderSn)
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	err := query.Order("id").Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+var _ v1.PaymentNotificationStore = &paymentNotifications{}
@@ -12,6 +12,8 @@ import (
 	"Advanced_Shop/pkg/log"
 	"context"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
 type orders struct {
@@ -33,24 +35,10 @@ func (o *orders) Get(ctx context.Context, detail dto.OrderDetailRequest) (*dto.O
 		return nil, errors.WithCode(code.ErrOrderNotFound, "get order info error")
 	}
 	response := dto.OrderInfoResponse{
-		OrderInfoDO: do.OrderInfoDO{
-			Model:        gorm2.Model{ID: model.ID},
-			User:         model.User,
-			OrderSn:      model.OrderSn,
-			PayType:      model.PayType,
-			Status:       model.Status,
-			TradeNo:      model.TradeNo,
-			OrderMount:   model.OrderMount,
-			PayTime:      model.PayTime,
-			Address:      model.Address,
-			SignerName:   model.SignerName,
-			SignerMobile: model.SignerMobile,
-			Post:         model.Post,
-		},
+		OrderInfoDO: model,
 	}
 	// 找一下商品
-	var goodModels []*do.OrderGoodsModel
-	o.db.Where("`order` = ?", model.ID).Find(&goodModels)
+	goodModels, _ := o.orderGoods(o.db, &model)
 	response.OrderGoods = goodModels
 
 	return &response, nil
@@ -65,29 +53,60 @@ func (o *orders) GetByOrderSn(ctx context.Context, orderSn string) (*dto.OrderIn
 		return nil, errors.WithCode(code.ErrOrderNotFound, "get order info error")
 	}
 	response := dto.OrderInfoResponse{
-		OrderInfoDO: do.OrderInfoDO{
-			Model:        gorm2.Model{ID: model.ID},
-			User:         model.User,
-			OrderSn:      model.OrderSn,
-			PayType:      model.PayType,
-			Status:       model.Status,
-			TradeNo:      model.TradeNo,
-			OrderMount:   model.OrderMount,
-			PayTime:      model.PayTime,
-			Address:      model.Address,
-			SignerName:   model.SignerName,
-			SignerMobile: model.SignerMobile,
-			Post:         model.Post,
-		},
+		OrderInfoDO: model,
 	}
 	// 找一下商品
-	var goodModels []*do.OrderGoodsModel
-	o.db.Where("`order` = ?", model.ID).Find(&goodModels)
+	goodModels, _ := o.orderGoods(o.db, &model)
 	response.OrderGoods = goodModels
 
 	return &response, nil
 }
 
+func (o *orders) GetWithTx(ctx context.Context, txn *gorm.DB, orderSn string) (*dto.OrderInfoResponse, error) {
+	db := o.db
+	if txn != nil {
+		db = txn
+	}
+	var model do.OrderInfoDO
+	err := db.Where("order_sn = ?", orderSn).Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrOrderNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	goodModels, err := o.orderGoods(db, &model)
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &dto.OrderInfoResponse{OrderInfoDO: model, OrderGoods: goodModels}, nil
+}
+
+// orderGoods 订单的商品明细，明细挂在父订单上，子订单按 sub_order 查询
+func (o *orders) orderGoods(db *gorm.DB, order *do.OrderInfoDO) ([]*do.OrderGoodsModel, error) {
+	var goodModels []*do.OrderGoodsModel
+	query := db.Where("`order` = ?", order.ID)
+	if order.Parent != 0 {
+		query = db.Where("sub_order = ?", order.ID)
+	}
+	err := query.Find(&goodModels).Error
+	return goodModels, err
+}
+
+func (o *orders) UpdateStatusFrom(ctx context.Context, txn *gorm.DB, orderSn string, from []string, to string) (int64, error) {
+	db := o.db
+	if txn != nil {
+		db = txn
+	}
+	result := db.Model(&do.OrderInfoDO{}).
+		Where("order_sn = ? AND status IN (?)", orderSn, from).
+		Update("status", to)
+	if result.Error != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
 func (o *orders) ExistsByOrderSn(ctx context.Context, txn *gorm.DB, orderSn string) (bool, error) {
 
 	var model do.OrderInfoDO
@@ -99,18 +118,26 @@ func (o *orders) ExistsByOrderSn(ctx context.Context, txn *gorm.DB, orderSn stri
 	return true, nil
 }
 
-func (o *orders) List(ctx context.Context, userID uint64, meta metav1.ListMeta, orderby []string) (*do.OrderInfoDOList, error) {
+func (o *orders) List(ctx context.Context, userID uint64, view string, meta metav1.ListMeta, orderby []string) (*do.OrderInfoDOList, error) {
 	ret := &do.OrderInfoDOList{}
 	//分页
 	limit := meta.GetLimit()
 	offset := meta.GetOffset()
+	query := o.db.Model(&do.OrderInfoDO{}).Where(&do.OrderInfoDO{User: int32(userID)})
+	if view == do.OrderViewSub {
+		query = query.Where("split = ?", false)
+	} else {
+		query = query.Where("parent = ?", 0)
+	}
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
 	//排序
-	query := o.db.Model(do.OrderInfoDO{User: int32(userID)})
 	for _, value := range orderby {
 		query = query.Order(value)
 	}
 
-	d := query.Offset(offset).Limit(limit).Find(&ret.Items).Count(&ret.TotalCount)
+	d := query.Offset(offset).Limit(limit).Find(&ret.Items)
 	if d.Error != nil {
 		return nil, errors.WithCode(code2.ErrDatabase, d.Error.Error())
 	}
@@ -126,11 +153,21 @@ func (o *orders) Create(ctx context.Context, txn *gorm.DB, order *dto.OrderInfoR
 	orderModel := &do.OrderInfoDO{
 		User:         order.User,
 		OrderSn:      order.OrderSn,
+		PayType:      order.PayType,
+		Status:       do.OrderStatusPaying,
 		OrderMount:   order.OrderMount,
 		Address:      order.Address,
 		SignerName:   order.SignerName,
 		SignerMobile: order.SignerMobile,
 		Post:         order.Post,
+
+		GoodsAmount:    order.GoodsAmount,
+		DiscountAmount: order.DiscountAmount,
+		ShippingFee:    order.ShippingFee,
+		PayAmount:      order.PayAmount,
+		CouponCode:     order.CouponCode,
+		PriceDetail:    order.PriceDetail,
+		Split:          len(order.SubOrders) > 0,
 	}
 
 	err := db.Create(&orderModel).Error
@@ -139,15 +176,56 @@ func (o *orders) Create(ctx context.Context, txn *gorm.DB, order *dto.OrderInfoR
 		return errors.WithCode(code2.ErrDatabase, err.Error())
 	}
 
+	// 子订单的收货信息和支付方式与父订单相同，同一商品的不同规格可能从不同仓库发货，按商品和规格归属子订单
+	subOrders := make(map[[2]int32]int32)
+	for _, sub := range order.SubOrders {
+		subModel := &do.OrderInfoDO{
+			User:         order.User,
+			OrderSn:      sub.OrderSn,
+			PayType:      order.PayType,
+			Status:       do.OrderStatusPaying,
+			OrderMount:   sub.OrderMount,
+			Address:      order.Address,
+			SignerName:   order.SignerName,
+			SignerMobile: order.SignerMobile,
+
+			GoodsAmount:    sub.GoodsAmount,
+			DiscountAmount: sub.DiscountAmount,
+			ShippingFee:    sub.ShippingFee,
+			PayAmount:      sub.PayAmount,
+
+			Parent:    orderModel.ID,
+			ParentSn:  orderModel.OrderSn,
+			Merchant:  sub.Merchant,
+			Warehouse: sub.Warehouse,
+		}
+		if err := db.Create(subModel).Error; err != nil {
+			log.Errorf("create sub order failed, error: %v", err)
+			return errors.WithCode(code2.ErrDatabase, err.Error())
+		}
+		for i, goodsID := range sub.GoodIds {
+			var skuID int32
+			if i < len(sub.SkuIds) {
+				skuID = sub.SkuIds[i]
+			}
+			subOrders[[2]int32{goodsID, skuID}] = subModel.ID
+		}
+	}
+
 	var orderGoodsModels []do.OrderGoodsModel
 	for _, goodsDTO := range order.OrderGoods {
 		orderGoodsModels = append(orderGoodsModels, do.OrderGoodsModel{
 			Order:      orderModel.ID,
 			Goods:      goodsDTO.Goods,
+			Sku:        goodsDTO.Sku,
+			SkuSpecs:   goodsDTO.SkuSpecs,
 			GoodsName:  goodsDTO.GoodsName,
 			GoodsPrice: goodsDTO.GoodsPrice,
 			GoodImages: goodsDTO.GoodImages,
 			Nums:       goodsDTO.Nums,
+			Price:      goodsDTO.Price,
+			PayAmount:  goodsDTO.PayAmount,
+			SubOrder:   subOrders[[2]int32{goodsDTO.Goods, goodsDTO.Sku}],
 		})
 	}
 
@@ -161,12 +239,79 @@ func (o *orders) Create(ctx context.Context, txn *gorm.DB, order *dto.OrderInfoR
 	return nil
 }
 
-func (o *orders) UpdateStatus(ctx context.Context, orderSn string, status string) (int64, error) {
-	result := o.db.Model(&do.OrderInfoDO{}).
+func (o *orders) ListSubOrders(ctx context.Context, txn *gorm.DB, parentID int32) ([]*do.OrderInfoDO, error) {
+	db := o.db
+	if txn != nil {
+		db = txn
+	}
+	var ret []*do.OrderInfoDO
+	if err := db.Where("parent = ?", parentID).Order("id").Find(&ret).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (o *orders) Lock(ctx context.Context, txn *gorm.DB, orderSn string) error {
+	var model do.OrderInfoDO
+	err := txn.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").Where("order_sn = ?", orderSn).Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.WithCode(code.ErrOrderNotFound, err.Error())
+		}
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (o *orders) UpdatePayment(ctx context.Context, txn *gorm.DB, orderSn, tradeNo string, payTime time.Time) error {
+	db := o.db
+	if txn != nil {
+		db = txn
+	}
+	values := map[string]interface{}{"pay_time": payTime}
+	if tradeNo != "" {
+		values["trade_no"] = tradeNo
+	}
+	err := db.Model(&do.OrderInfoDO{}).
 		Where("order_sn = ?", orderSn).
-		Update("status", status)
+		Updates(values).Error
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
 
-	return result.RowsAffected, result.Error
+func (o *orders) ListPending(ctx context.Context, createdAfter, createdBefore time.Time, limit int) ([]*do.OrderInfoDO, error) {
+	var ret []*do.OrderInfoDO
+	err := o.db.Where("parent = 0 AND status IN (?) AND created_at > ? AND created_at < ?",
+		[]string{do.OrderStatusPaying, do.OrderStatusWaitBuyerPay}, createdAfter, createdBefore).
+		Order("id").Limit(limit).Find(&ret).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (o *orders) ListByOrderSns(ctx context.Context, orderSns []string) ([]*do.OrderInfoDO, error) {
+	var ret []*do.OrderInfoDO
+	if len(orderSns) == 0 {
+		return ret, nil
+	}
+	if err := o.db.Where("order_sn IN (?)", orderSns).Find(&ret).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (o *orders) ListPaid(ctx context.Context, payTypes []string, start, end time.Time) ([]*do.OrderInfoDO, error) {
+	var ret []*do.OrderInfoDO
+	err := o.db.Where("parent = 0 AND pay_type IN (?) AND pay_time >= ? AND pay_time < ?", payTypes, start, end).
+		Find(&ret).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
 }
 
 func (o *orders) TimeoutHandler(ctx context.Context, txn *gorm.DB, OrderSns string) do.MQMessageType {
@@ -177,19 +322,66 @@ func (o *orders) TimeoutHandler(ctx context.Context, txn *gorm.DB, OrderSns stri
 		return do.DirectPass
 	}
 	// 找到了  查一下 看看是不是已经支付了 支付的话不用管了
-	if orderModel.Status == "TRADE_SUCCESS" {
+	// 已支付、已关闭的订单状态机不允许再关闭，已取消的订单在取消时已经归还过库存
+	if !do.CanTransit(orderModel.Status, do.OrderStatusClosed) {
 		return do.DirectPass
 	}
 
 	// 说明没支付  我们要关闭， 然后发送消息给mq 让库存服务归还
-	orderModel.Status = "CLOSED"
-	err = txn.Save(&orderModel).Error
-	if err != nil {
-		log.Errorf("数据库操作失败 %v", err)
+	result := txn.Model(&do.OrderInfoDO{}).
+		Where("order_sn = ? AND status = ?", OrderSns, orderModel.Status).
+		Update("status", do.OrderStatusClosed)
+	if result.Error != nil {
+		log.Errorf("数据库操作失败 %v", result.Error)
 		return do.OptionFail // 返回1是有错
 	}
+	if result.RowsAffected == 0 {
+		// 状态在这期间被支付回调或取消修改了，重试时重新判断
+		return do.OptionFail
+	}
+	err = txn.Create(&do.OrderStatusHistoryDO{
+		OrderSn:    OrderSns,
+		FromStatus: orderModel.Status,
+		ToStatus:   do.OrderStatusClosed,
+		Operator:   do.OperatorTimeout,
+		Reason:     "超时未支付",
+	}).Error
+	if err != nil {
+		log.Errorf("记录订单状态变更失败 %v", err)
+		return do.OptionFail
+	}
+	if orderModel.Split && !o.closeSubOrders(txn, orderModel.ID) {
+		return do.OptionFail
+	}
 
 	return do.Continuing // 返回2 是继续向下走 说明要发消息 进行回收
 }
 
+// closeSubOrders 父订单超时关闭时一起关闭未支付的子订单，库存和优惠券按父订单归还
+func (o *orders) closeSubOrders(txn *gorm.DB, parentID int32) bool {
+	var subOrders []*do.OrderInfoDO
+	err := txn.Where("parent = ? AND status IN (?)", parentID, do.TransitFrom(do.OrderStatusClosed)).Find(&subOrders).Error
+	if err != nil {
+		log.Errorf("查询子订单失败 %v", err)
+		return false
+	}
+	for _, sub := range subOrders {
+		err := txn.Model(&do.OrderInfoDO{}).Where("id = ?", sub.ID).Update("status", do.OrderStatusClosed).Error
+		if err == nil {
+			err = txn.Create(&do.OrderStatusHistoryDO{
+				OrderSn:    sub.OrderSn,
+				FromStatus: sub.Status,
+				ToStatus:   do.OrderStatusClosed,
+				Operator:   do.OperatorTimeout,
+				Reason:     "父订单超时未支付",
+			}).Error
+		}
+		if err != nil {
+			log.Errorf("关闭子订单%s失败 %v", sub.OrderSn, err)
+			return false
+		}
+	}
+	return true
+}
+
 var _ v1.OrderStore = &orders{}
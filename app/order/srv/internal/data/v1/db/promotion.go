@@ -0,0 +1,57 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type promotions struct {
+	db *gorm.DB
+}
+
+func newPromotions(factory *dataFactory) *promotions {
+	return &promotions{
+		db: factory.db,
+	}
+}
+
+// effective 启用中且在有效期内的规则
+func (p *promotions) effective(now time.Time) *gorm.DB {
+	return p.db.Model(&do.PromotionDO{}).
+		Where("enabled = ?", true).
+		Where("start_at IS NULL OR start_at <= ?", now).
+		Where("end_at IS NULL OR end_at > ?", now)
+}
+
+func (p *promotions) Active(ctx context.Context, now time.Time) ([]*do.PromotionDO, error) {
+	var ret []*do.PromotionDO
+	err := p.effective(now).Where("code = ?", "").
+		Where("type IN ?", []string{do.PromotionCategoryDiscount, do.PromotionFreeShipping}).
+		Order("id").Find(&ret).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (p *promotions) GetByCode(ctx context.Context, couponCode string, now time.Time) (*do.PromotionDO, error) {
+	var promotion do.PromotionDO
+	err := p.effective(now).Where("code = ?", couponCode).
+		Where("type IN ?", []string{do.PromotionCouponFixed, do.PromotionCouponPercent, do.PromotionCouponThreshold}).
+		Take(&promotion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrCouponNotFound, "优惠券%s不存在或已过期", couponCode)
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &promotion, nil
+}
+
+var _ v1.PromotionStore = &promotions{}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentMismatches struct {
+	db *gorm.DB
+}
+
+func newPaymentMismatches(factory *dataFactory) *paymentMismatches {
+	return &paymentMismatches{
+		db: factory.db,
+	}
+}
+
+func (pm *paymentMismatches) Save(ctx context.Context, mismatches []*do.PaymentMismatchDO) error {
+	if len(mismatches) == 0 {
+		return nil
+	}
+	err := pm.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(mismatches, 100).Error
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+var _ v1.PaymentMismatchStore = &paymentMismatches{}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+)
+
+type refunds struct {
+	db *gorm.DB
+}
+
+func newRefunds(factory *dataFactory) *refunds {
+	return &refunds{
+		db: factory.db,
+	}
+}
+
+func (r *refunds) Create(ctx context.Context, txn *gorm.DB, refund *do.RefundDO) error {
+	db := r.db
+	if txn != nil {
+		db = txn
+	}
+	// 关联的商品明细由 gorm 一起写入
+	if err := db.Create(refund).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (r *refunds) Get(ctx context.Context, txn *gorm.DB, refundID, orderID int32) (*do.RefundDO, error) {
+	db := r.db
+	if txn != nil {
+		db = txn
+	}
+	query := db.Preload("Goods").Where("id = ?", refundID)
+	if orderID != 0 {
+		query = query.Where("`order` = ?", orderID)
+	}
+	var refund do.RefundDO
+	if err := query.Take(&refund).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrRefundNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &refund, nil
+}
+
+func (r *refunds) List(ctx context.Context, orderSn string, meta metav1.ListMeta) (*do.RefundDOList, error) {
+	ret := &do.RefundDOList{}
+	query := r.db.Model(&do.RefundDO{}).Where("order_sn = ?", orderSn)
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	err := query.Preload("Goods").Order("id desc").Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (r *refunds) RefundedNums(ctx context.Context, txn *gorm.DB, orderSn string, statuses []string) (map[int32]int32, error) {
+	db := r.db
+	if txn != nil {
+		db = txn
+	}
+	var rows []struct {
+		OrderGoods int32
+		Nums       int32
+	}
+	err := db.Model(&do.RefundGoodsDO{}).
+		Select("order_refund_goods.order_goods, SUM(order_refund_goods.nums) AS nums").
+		Joins("JOIN order_refunds ON order_refunds.id = order_refund_goods.refund").
+		Where("order_refunds.order_sn = ? AND order_refunds.status IN (?)", orderSn, statuses).
+		Where("order_refunds.deleted_at IS NULL").
+		Group("order_refund_goods.order_goods").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	ret := make(map[int32]int32, len(rows))
+	for _, row := range rows {
+		ret[row.OrderGoods] = row.Nums
+	}
+	return ret, nil
+}
+
+func (r *refunds) UpdateStatusFrom(ctx context.Context, txn *gorm.DB, refundID int32, from []string, to, remark string) (int64, error) {
+	db := r.db
+	if txn != nil {
+		db = txn
+	}
+	result := db.Model(&do.RefundDO{}).
+		Where("id = ? AND status IN (?)", refundID, from).
+		Updates(map[string]interface{}{"status": to, "remark": remark})
+	if result.Error != nil {
+		return 0, errors.WithCode(code2.ErrDatabase, result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+var _ v1.RefundStore = &refunds{}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	code2 "Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type shipments struct {
+	db *gorm.DB
+}
+
+func newShipments(factory *dataFactory) *shipments {
+	return &shipments{
+		db: factory.db,
+	}
+}
+
+func (s *shipments) Create(ctx context.Context, txn *gorm.DB, shipment *do.ShipmentDO) error {
+	db := s.db
+	if txn != nil {
+		db = txn
+	}
+	if err := db.Create(shipment).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (s *shipments) GetByOrderSn(ctx context.Context, orderSn string) (*do.ShipmentDO, error) {
+	var model do.ShipmentDO
+	err := s.db.Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("event_time, id")
+	}).Where("order_sn = ?", orderSn).Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.WithCode(code.ErrShipmentNotFound, err.Error())
+		}
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return &model, nil
+}
+
+func (s *shipments) ListToSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*do.ShipmentDO, error) {
+	var ret []*do.ShipmentDO
+	err := s.db.Where("status IN ?", []string{do.ShipmentStatusShipped, do.ShipmentStatusInTransit, do.ShipmentStatusException}).
+		Where("synced_at IS NULL OR synced_at < ?", syncedBefore).
+		Order("synced_at, id").Limit(limit).Find(&ret).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (s *shipments) ListToReceive(ctx context.Context, shippedBefore, deliveredBefore time.Time, limit int) ([]*do.ShipmentDO, error) {
+	var ret []*do.ShipmentDO
+	// 异常的运单（拒签、退回等）需要人工处理，不自动确认收货
+	err := s.db.Where("(status IN ? AND shipped_at < ?) OR (status = ? AND delivered_at < ?)",
+		[]string{do.ShipmentStatusShipped, do.ShipmentStatusInTransit, do.ShipmentStatusDelivered}, shippedBefore,
+		do.ShipmentStatusDelivered, deliveredBefore).
+		Order("id").Limit(limit).Find(&ret).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+func (s *shipments) Sync(ctx context.Context, shipment *do.ShipmentDO, events []*do.ShipmentEventDO) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if len(events) > 0 {
+			if err := tx.CreateInBatches(events, 100).Error; err != nil {
+				return err
+			}
+		}
+		// 运单可能已经被确认收货，只更新仍在跟踪的运单
+		return tx.Model(&do.ShipmentDO{}).
+			Where("id = ? AND status IN ?", shipment.ID, []string{do.ShipmentStatusShipped, do.ShipmentStatusInTransit, do.ShipmentStatusException}).
+			Updates(map[string]interface{}{
+				"status":        shipment.Status,
+				"delivered_at":  shipment.DeliveredAt,
+				"synced_at":     shipment.SyncedAt,
+				"last_event_at": shipment.LastEventAt,
+			}).Error
+	})
+	if err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (s *shipments) UpdateStatus(ctx context.Context, txn *gorm.DB, ID int32, status string) error {
+	db := s.db
+	if txn != nil {
+		db = txn
+	}
+	if err := db.Model(&do.ShipmentDO{}).Where("id = ?", ID).Update("status", status).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+var _ v1.ShipmentStore = &shipments{}
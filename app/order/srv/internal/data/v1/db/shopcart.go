@@ -23,13 +23,43 @@ func newShopCarts(factory *dataFactory) *shopCarts {
 	}
 }
 
-// DeleteByGoodsIDs 这个在事务中执行，以后可以使用消息队列来实现
-func (sc *shopCarts) DeleteByGoodsIDs(ctx context.Context, txn *gorm.DB, userID uint64, goodsIDs []int32) error {
+// DeleteItems 这个在事务中执行，以后可以使用消息队列来实现
+func (sc *shopCarts) DeleteItems(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error {
 	db := sc.db
 	if txn != nil {
 		db = txn
 	}
-	return db.Where("user = ? AND goods IN (?)", userID, goodsIDs).Delete(&do.ShoppingCartDO{}).Error
+	if len(items) == 0 {
+		return nil
+	}
+	pairs := make([][]interface{}, 0, len(items))
+	for _, item := range items {
+		pairs = append(pairs, []interface{}{item.Goods, item.Sku})
+	}
+	return db.Where("user = ? AND (goods, sku) IN ?", userID, pairs).Delete(&do.ShoppingCartDO{}).Error
+}
+
+func (sc *shopCarts) Restore(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error {
+	db := sc.db
+	if txn != nil {
+		db = txn
+	}
+	for _, item := range items {
+		result := db.Model(&do.ShoppingCartDO{}).
+			Where("user = ? AND goods = ? AND sku = ?", userID, item.Goods, item.Sku).
+			UpdateColumn("nums", gorm.Expr("nums + ?", item.Nums))
+		if result.Error != nil {
+			return errors.WithCode(code2.ErrDatabase, result.Error.Error())
+		}
+		if result.RowsAffected > 0 {
+			continue
+		}
+		item.User = int32(userID)
+		if err := db.Create(item).Error; err != nil {
+			return errors.WithCode(code2.ErrDatabase, err.Error())
+		}
+	}
+	return nil
 }
 
 func (sc *shopCarts) List(ctx context.Context, userID uint64, checked bool, meta metav1.ListMeta, orderby []string) (*do.ShoppingCartDOList, error) {
@@ -66,9 +96,9 @@ func (sc *shopCarts) Create(ctx context.Context, cartItem *do.ShoppingCartDO) (i
 	return cartItem.ID, nil
 }
 
-func (sc *shopCarts) Get(ctx context.Context, userID, goodsID uint64) (*do.ShoppingCartDO, error) {
+func (sc *shopCarts) Get(ctx context.Context, userID, goodsID, skuID uint64) (*do.ShoppingCartDO, error) {
 	var shopCart do.ShoppingCartDO
-	err := sc.db.WithContext(ctx).Where("user = ? AND goods = ?", userID, goodsID).First(&shopCart).Error
+	err := sc.db.WithContext(ctx).Where("user = ? AND goods = ? AND sku = ?", userID, goodsID, skuID).First(&shopCart).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.WithCode(code.ErrShopCartItemNotFound, err.Error())
@@ -79,7 +109,7 @@ func (sc *shopCarts) Get(ctx context.Context, userID, goodsID uint64) (*do.Shopp
 }
 
 func (sc *shopCarts) UpdateNum(ctx context.Context, cartItem *do.ShoppingCartDO) error {
-	cartInfo, err := sc.Get(ctx, uint64(cartItem.User), uint64(cartItem.Goods))
+	cartInfo, err := sc.Get(ctx, uint64(cartItem.User), uint64(cartItem.Goods), uint64(cartItem.Sku))
 	if err != nil {
 		return err
 	}
@@ -87,6 +117,7 @@ func (sc *shopCarts) UpdateNum(ctx context.Context, cartItem *do.ShoppingCartDO)
 	structMap := do.CartUpdateMap{
 		Nums:    cartItem.Nums,
 		Checked: cartItem.Checked,
+		Price:   cartItem.Price,
 	}
 	toMap := struct_to_map.StructToMap(structMap)
 	err = sc.db.Debug().Model(&cartInfo).Updates(toMap).Error
@@ -97,9 +128,9 @@ func (sc *shopCarts) UpdateNum(ctx context.Context, cartItem *do.ShoppingCartDO)
 	return nil
 }
 
-func (sc *shopCarts) Delete(ctx context.Context, userID uint64, goodID uint64) error {
+func (sc *shopCarts) Delete(ctx context.Context, userID, goodsID, skuID uint64) error {
 	var model do.ShoppingCartDO
-	err := sc.db.Where("user = ? and goods = ?", userID, goodID).Take(&model).Error
+	err := sc.db.Where("user = ? and goods = ? and sku = ?", userID, goodsID, skuID).Take(&model).Error
 	if err != nil {
 		log.Errorf("Delete cart info error: %v", err)
 		return errors.WithCode(code2.ErrDatabase, err.Error())
@@ -109,7 +140,7 @@ func (sc *shopCarts) Delete(ctx context.Context, userID uint64, goodID uint64) e
 
 func (sc *shopCarts) GetBatchByUser(ctx context.Context, userID int32) (*do.GetShoppingBatchResponse, error) {
 	check := true
-	var shopModels []do.ShoppingCartDO
+	var shopModels []*do.ShoppingCartDO
 	sc.db.Where(do.ShoppingCartDO{
 		User:    userID,
 		Checked: &check,
@@ -118,17 +149,7 @@ func (sc *shopCarts) GetBatchByUser(ctx context.Context, userID int32) (*do.GetS
 	if len(shopModels) == 0 {
 		return nil, errors.WithCode(code.ErrNoGoodsSelect, "未选择商品")
 	}
-	var goodsId []int32
-	goodNumMap := make(map[int32]int32)
-	for _, shopModel := range shopModels {
-		goodsId = append(goodsId, shopModel.Goods)
-		goodNumMap[shopModel.Goods] = shopModel.Nums
-	}
-	response := &do.GetShoppingBatchResponse{
-		GoodsId:    goodsId,
-		GoodNumMap: goodNumMap,
-	}
-	return response, nil
+	return do.NewShoppingBatchResponse(shopModels), nil
 }
 
 // ClearCheck 清空check状态
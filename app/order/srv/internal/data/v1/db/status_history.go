@@ -0,0 +1,47 @@
+package db
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	code2 "Advanced_Shop/gnova/code"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"gorm.io/gorm"
+)
+
+type statusHistories struct {
+	db *gorm.DB
+}
+
+func newStatusHistories(factory *dataFactory) *statusHistories {
+	return &statusHistories{
+		db: factory.db,
+	}
+}
+
+func (sh *statusHistories) Append(ctx context.Context, txn *gorm.DB, history *do.OrderStatusHistoryDO) error {
+	db := sh.db
+	if txn != nil {
+		db = txn
+	}
+	if err := db.Create(history).Error; err != nil {
+		return errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func (sh *statusHistories) List(ctx context.Context, orderSn string, meta metav1.ListMeta) (*do.OrderStatusHistoryDOList, error) {
+	ret := &do.OrderStatusHistoryDOList{}
+	query := sh.db.Model(&do.OrderStatusHistoryDO{}).Where("order_sn = ?", orderSn)
+	if err := query.Count(&ret.TotalCount).Error; err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	err := query.Order("id").Offset(meta.GetOffset()).Limit(meta.GetLimit()).Find(&ret.Items).Error
+	if err != nil {
+		return nil, errors.WithCode(code2.ErrDatabase, err.Error())
+	}
+	return ret, nil
+}
+
+var _ v1.OrderStatusHistoryStore = &statusHistories{}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"context"
+	"time"
+)
+
+// DelayHandler 处理到期的延时消息，返回错误时消息稍后重新投递
+type DelayHandler func(ctx context.Context, body []byte) error
+
+// DelayQueue 订单超时关闭使用的延时队列，投递和消费都是至少一次，处理函数需要幂等
+type DelayQueue interface {
+	// Push 投递延时消息，delay 之后交给 Consume 注册的处理函数
+	Push(ctx context.Context, body []byte, delay time.Duration) error
+
+	// Consume 在后台开始消费到期的消息，ctx 结束时停止
+	Consume(ctx context.Context, handler DelayHandler)
+}
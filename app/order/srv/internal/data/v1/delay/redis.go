@@ -0,0 +1,87 @@
+package delay
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	zlog "Advanced_Shop/pkg/log"
+	"context"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// claimDueScript 取出到期的消息，并把它们的分数推迟 retry 毫秒作为租约：
+// 处理成功后删除，处理失败或消费者宕机时租约到期会被重新取出，保证至少一次
+// KEYS[1] 队列；ARGV[1] 当前时间（毫秒），ARGV[2] 最多取出的条数，ARGV[3] 租约到期时间（毫秒）
+var claimDueScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for i = 1, #items do
+	redis.call('ZADD', KEYS[1], ARGV[3], items[i])
+end
+return items
+`)
+
+// redisQueue 基于 Redis ZSET 的延时队列，分数为到期时间（毫秒），成员为消息体
+// 消息体相同的消息会合并为一条，订单超时消息中带有订单号，不会误合并
+type redisQueue struct {
+	client redis.UniversalClient
+	opts   *options.DelayQueueOptions
+}
+
+func NewRedisQueue(client redis.UniversalClient, opts *options.DelayQueueOptions) v1.DelayQueue {
+	return &redisQueue{client: client, opts: opts}
+}
+
+func (q *redisQueue) Push(ctx context.Context, body []byte, delay time.Duration) error {
+	due := time.Now().Add(delay).UnixMilli()
+	if err := q.client.ZAdd(ctx, q.opts.Key, redis.Z{Score: float64(due), Member: string(body)}).Err(); err != nil {
+		return errors.WithCode(code.ErrRedisLock, "投递延时消息失败: %v", err)
+	}
+	return nil
+}
+
+func (q *redisQueue) Consume(ctx context.Context, handler v1.DelayHandler) {
+	go func() {
+		ticker := time.NewTicker(q.opts.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				zlog.Info("Redis延时队列消费者已停止")
+				return
+			case <-ticker.C:
+				q.poll(ctx, handler)
+			}
+		}
+	}()
+	zlog.Infof("Redis延时队列消费者启动成功，key: %s", q.opts.Key)
+}
+
+// poll 一次取出一批到期的消息，取满一批时继续取，避免积压时每个间隔只处理一批
+func (q *redisQueue) poll(ctx context.Context, handler v1.DelayHandler) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		items, err := claimDueScript.Run(ctx, q.client, []string{q.opts.Key},
+			now.UnixMilli(), q.opts.BatchSize, now.Add(q.opts.RetryDelay).UnixMilli()).StringSlice()
+		if err != nil {
+			zlog.Errorf("读取Redis延时队列失败: %v", err)
+			return
+		}
+		for _, item := range items {
+			if err := handler(ctx, []byte(item)); err != nil {
+				zlog.Errorf("延时消息处理失败，%s后重试: %v", q.opts.RetryDelay, err)
+				continue
+			}
+			if err := q.client.ZRem(ctx, q.opts.Key, item).Err(); err != nil {
+				// 删除失败时租约到期会重新处理一次，处理函数是幂等的
+				zlog.Errorf("删除已处理的延时消息失败: %v", err)
+			}
+		}
+		if len(items) < q.opts.BatchSize {
+			return
+		}
+	}
+}
+
+var _ v1.DelayQueue = &redisQueue{}
@@ -0,0 +1,122 @@
+package delay
+
+import (
+	"Advanced_Shop/app/pkg/options"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 集成测试需要真实的 Redis，设置 XSHOP_TEST_REDIS=127.0.0.1:6379 后运行
+func newTestQueue(t *testing.T) (*redisQueue, redis.UniversalClient) {
+	addr := os.Getenv("XSHOP_TEST_REDIS")
+	if addr == "" {
+		t.Skip("未设置 XSHOP_TEST_REDIS，跳过 Redis 延时队列集成测试")
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Fatalf("连接 Redis %s 失败: %v", addr, err)
+	}
+	opts := options.NewDelayQueueOptions()
+	opts.Key = fmt.Sprintf("test:order_delay:%d", time.Now().UnixNano())
+	opts.PollInterval = 20 * time.Millisecond
+	opts.RetryDelay = 200 * time.Millisecond
+	t.Cleanup(func() {
+		client.Del(context.Background(), opts.Key)
+		_ = client.Close()
+	})
+	return NewRedisQueue(client, opts).(*redisQueue), client
+}
+
+// recorder 记录处理函数收到的消息，前 fails 次处理返回错误
+type recorder struct {
+	mu    sync.Mutex
+	fails int
+	got   []string
+	at    []time.Time
+}
+
+func (r *recorder) handle(ctx context.Context, body []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.got = append(r.got, string(body))
+	r.at = append(r.at, time.Now())
+	if len(r.got) <= r.fails {
+		return fmt.Errorf("第%d次处理失败", len(r.got))
+	}
+	return nil
+}
+
+func (r *recorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.got)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("等待超时")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRedisQueue(t *testing.T) {
+	tests := []struct {
+		name  string
+		delay time.Duration
+		fails int // 处理函数前几次返回错误
+		want  int // 期望处理的次数
+	}{
+		{name: "到期后处理一次并删除", delay: 100 * time.Millisecond, want: 1},
+		{name: "处理失败时租约到期后重新投递", delay: 0, fails: 2, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, client := newTestQueue(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			r := &recorder{fails: tt.fails}
+			body := `{"OrderSns":"test-order"}`
+			pushed := time.Now()
+			if err := q.Push(ctx, []byte(body), tt.delay); err != nil {
+				t.Fatalf("Push err: %v", err)
+			}
+			q.Consume(ctx, r.handle)
+
+			waitFor(t, func() bool { return r.count() >= tt.want })
+			waitFor(t, func() bool { return client.ZCard(ctx, q.opts.Key).Val() == 0 })
+			// 多等几个轮询间隔，确认处理成功后不会再投递
+			time.Sleep(5 * q.opts.PollInterval)
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if len(r.got) != tt.want {
+				t.Fatalf("处理了%d次, want %d", len(r.got), tt.want)
+			}
+			if r.at[0].Sub(pushed) < tt.delay {
+				t.Fatalf("消息提前%s投递", tt.delay-r.at[0].Sub(pushed))
+			}
+			for i := 1; i < len(r.at); i++ {
+				// 取出时间略早于处理时间，留一个轮询间隔的误差
+				if gap := r.at[i].Sub(r.at[i-1]); gap < q.opts.RetryDelay-q.opts.PollInterval {
+					t.Fatalf("第%d次重新投递间隔%s，小于租约%s", i, gap, q.opts.RetryDelay)
+				}
+			}
+			for _, got := range r.got {
+				if got != body {
+					t.Fatalf("消息体 = %s, want %s", got, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package delay
+
+import (
+	v1 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/pkg/options"
+	zlog "Advanced_Shop/pkg/log"
+	"context"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"time"
+)
+
+// RocketMQ 开源版只支持固定的延时等级，下标加 1 即为等级
+var delayLevels = []time.Duration{
+	time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 3 * time.Minute, 4 * time.Minute, 5 * time.Minute,
+	6 * time.Minute, 7 * time.Minute, 8 * time.Minute, 9 * time.Minute, 10 * time.Minute,
+	20 * time.Minute, 30 * time.Minute, time.Hour, 2 * time.Hour,
+}
+
+// delayLevel 取不小于 delay 的最小等级，超过最大等级时按最大等级
+func delayLevel(delay time.Duration) int {
+	for i, d := range delayLevels {
+		if d >= delay {
+			return i + 1
+		}
+	}
+	return len(delayLevels)
+}
+
+// rocketMQQueue 基于 RocketMQ 延时消息的延时队列
+type rocketMQQueue struct {
+	mq     v1.MQFactory
+	mqOpts *options.RocketMQOptions
+}
+
+func NewRocketMQQueue(mq v1.MQFactory, mqOpts *options.RocketMQOptions) v1.DelayQueue {
+	return &rocketMQQueue{mq: mq, mqOpts: mqOpts}
+}
+
+func (q *rocketMQQueue) Push(ctx context.Context, body []byte, delay time.Duration) error {
+	_, err := q.mq.SendDelayMsgWithRetry(ctx, primitive.NewMessage(q.mqOpts.Topic, body), delayLevel(delay))
+	return err
+}
+
+func (q *rocketMQQueue) Consume(ctx context.Context, handler v1.DelayHandler) {
+	messgaes, err := rocketmq.NewPushConsumer(consumer.WithNameServer([]string{q.mqOpts.Addr()}),
+		consumer.WithGroupName(q.mqOpts.ConsumerGroupName),
+		// 最大重试次数
+		consumer.WithMaxReconsumeTimes(int32(q.mqOpts.MaxRetryTimes)),
+		// 重试延迟时间
+		consumer.WithSuspendCurrentQueueTimeMillis(time.Duration(q.mqOpts.BaseRetryDelay)),
+	)
+	if err != nil {
+		panic(err)
+	}
+	// 监听普通消息 订单时间超时   Topic 订阅这个
+	err = messgaes.Subscribe(q.mqOpts.Topic, consumer.MessageSelector{},
+		func(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+			// 只要一个需要重试就得重试
+			needRetry := false
+			for _, msg := range msgs {
+				if err := handler(ctx, msg.Body); err != nil {
+					zlog.Errorf("延时消息处理失败，msgId: %s, err: %v", msg.MsgId, err)
+					needRetry = true
+				}
+			}
+			if needRetry {
+				return consumer.ConsumeRetryLater, nil
+			}
+			return consumer.ConsumeSuccess, nil
+		})
+	if err != nil {
+		panic(err)
+	}
+	if err = messgaes.Start(); err != nil {
+		panic(err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		// 用超时机制包装Shutdown，避免无限阻塞
+		shutdownDone := make(chan error, 1)
+		go func() {
+			shutdownDone <- messgaes.Shutdown()
+		}()
+
+		// 设置10秒超时，避免Shutdown卡住
+		select {
+		case err := <-shutdownDone:
+			if err != nil {
+				zlog.Errorf("RocketMQ消费者关闭失败: %v\n", err)
+			} else {
+				zlog.Info("RocketMQ消费者已优雅关闭")
+			}
+		case <-time.After(10 * time.Second):
+			zlog.Error("RocketMQ消费者关闭超时（10秒），强制退出")
+		}
+	}()
+
+	zlog.Info("RocketMQ消费者启动成功，开始监听消息")
+}
+
+var _ v1.DelayQueue = &rocketMQQueue{}
@@ -119,7 +119,7 @@ func (mf *RocketMqFactory) Send(ctx context.Context, mqMsg *primitive.Message) (
 }
 
 // SendDelayMsgWithRetry 发送延迟消息  带重试
-func (mf *RocketMqFactory) SendDelayMsgWithRetry(ctx context.Context, msg *primitive.Message) (*primitive.SendResult, error) {
+func (mf *RocketMqFactory) SendDelayMsgWithRetry(ctx context.Context, msg *primitive.Message, level int) (*primitive.SendResult, error) {
 	var (
 		sendResult *primitive.SendResult
 		sendErr    error
@@ -127,7 +127,7 @@ func (mf *RocketMqFactory) SendDelayMsgWithRetry(ctx context.Context, msg *primi
 		delayMsg = primitive.NewMessage(mf.mqOpts.Topic, msg.Body)
 	)
 	// 1s 5s 10s 30s 1m 2m 3m 4m 5m 6m 7m 8m 9m 10m 20m 30m 1h 2h
-	delayMsg.WithDelayTimeLevel(7) // 30分钟延时
+	delayMsg.WithDelayTimeLevel(level)
 
 	var retryIdx int
 	// 执行重试逻辑
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+)
+
+type PaymentNotificationStore interface {
+	// Receive 保存收到的通知，已存在时只累加收到次数，返回保存前的记录，第一次收到时返回空
+	Receive(ctx context.Context, notification *do.PaymentNotificationDO) (*do.PaymentNotificationDO, error)
+
+	// Update 更新通知的交易状态和处理结果
+	Update(ctx context.Context, notification *do.PaymentNotificationDO) error
+
+	Get(ctx context.Context, ID int32) (*do.PaymentNotificationDO, error)
+
+	// List 按收到的时间正序返回订单的支付通知
+	List(ctx context.Context, orderSn string, meta metav1.ListMeta) (*do.PaymentNotificationDOList, error)
+}
@@ -6,19 +6,44 @@ import (
 	metav1 "Advanced_Shop/pkg/common/meta/v1"
 	"context"
 	"gorm.io/gorm"
+	"time"
 )
 
 type OrderStore interface {
 	Get(ctx context.Context, detail dto.OrderDetailRequest) (*dto.OrderInfoResponse, error)
 
-	List(ctx context.Context, userID uint64, meta metav1.ListMeta, orderby []string) (*do.OrderInfoDOList, error)
+	// List 用户的订单，view 为 do.OrderView*，userID 为 0 时不限制用户
+	List(ctx context.Context, userID uint64, view string, meta metav1.ListMeta, orderby []string) (*do.OrderInfoDOList, error)
 
+	// Create 创建订单和商品明细，有 SubOrders 时同时创建子订单
 	Create(ctx context.Context, txn *gorm.DB, order *dto.OrderInfoResponse) error
 
-	UpdateStatus(ctx context.Context, orderSn string, status string) (int64, error)
+	// ListSubOrders 父订单的子订单，按 ID 正序，不包含商品
+	ListSubOrders(ctx context.Context, txn *gorm.DB, parentID int32) ([]*do.OrderInfoDO, error)
 
 	GetByOrderSn(ctx context.Context, orderSn string) (*dto.OrderInfoResponse, error)
 
+	// GetWithTx 在事务中按订单号查询订单及商品
+	GetWithTx(ctx context.Context, txn *gorm.DB, orderSn string) (*dto.OrderInfoResponse, error)
+
+	// UpdateStatusFrom 只有当前状态在 from 中时才更新，返回影响行数
+	UpdateStatusFrom(ctx context.Context, txn *gorm.DB, orderSn string, from []string, to string) (int64, error)
+
+	// Lock 在事务中锁住订单行，串行化同一订单上的并发操作
+	Lock(ctx context.Context, txn *gorm.DB, orderSn string) error
+
+	// UpdatePayment 记录支付完成时间，tradeNo 不为空时同时记录渠道交易号
+	UpdatePayment(ctx context.Context, txn *gorm.DB, orderSn, tradeNo string, payTime time.Time) error
+
+	// ListPending 创建时间在 (createdAfter, createdBefore) 之间的待支付订单，按 ID 正序，不包含子订单
+	ListPending(ctx context.Context, createdAfter, createdBefore time.Time, limit int) ([]*do.OrderInfoDO, error)
+
+	// ListByOrderSns 按订单号批量查询订单，不包含商品
+	ListByOrderSns(ctx context.Context, orderSns []string) ([]*do.OrderInfoDO, error)
+
+	// ListPaid 支付方式在 payTypes 中、支付时间在 [start, end) 之间的订单，不包含子订单
+	ListPaid(ctx context.Context, payTypes []string, start, end time.Time) ([]*do.OrderInfoDO, error)
+
 	TimeoutHandler(ctx context.Context, txn *gorm.DB, OrderSns string) do.MQMessageType
 
 	ExistsByOrderSn(ctx context.Context, txn *gorm.DB, orderSn string) (bool, error)
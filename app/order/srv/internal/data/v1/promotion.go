@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"context"
+	"time"
+)
+
+type PromotionStore interface {
+	// Active 返回 now 时刻生效的自动促销规则（没有券码的分类折扣和满额包邮）
+	Active(ctx context.Context, now time.Time) ([]*do.PromotionDO, error)
+
+	// GetByCode 按券码查询 now 时刻生效的优惠券
+	GetByCode(ctx context.Context, code string, now time.Time) (*do.PromotionDO, error)
+}
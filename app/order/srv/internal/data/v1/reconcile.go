@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"context"
+)
+
+type PaymentMismatchStore interface {
+	// Save 保存对账差异，重复导入时已存在的记录保持不变
+	Save(ctx context.Context, mismatches []*do.PaymentMismatchDO) error
+}
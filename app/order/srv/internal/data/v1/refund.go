@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+	"gorm.io/gorm"
+)
+
+type RefundStore interface {
+	// Create 创建退款单和退款商品明细
+	Create(ctx context.Context, txn *gorm.DB, refund *do.RefundDO) error
+
+	// Get 查询退款单及商品明细，orderID 不为 0 时校验退款单属于该订单
+	Get(ctx context.Context, txn *gorm.DB, refundID, orderID int32) (*do.RefundDO, error)
+
+	// List 分页查询订单的退款单，按申请时间倒序
+	List(ctx context.Context, orderSn string, meta metav1.ListMeta) (*do.RefundDOList, error)
+
+	// RefundedNums 按订单商品明细汇总状态在 statuses 中的退款单的退款数量
+	RefundedNums(ctx context.Context, txn *gorm.DB, orderSn string, statuses []string) (map[int32]int32, error)
+
+	// UpdateStatusFrom 只有当前状态在 from 中时才更新，返回影响行数
+	UpdateStatusFrom(ctx context.Context, txn *gorm.DB, refundID int32, from []string, to, remark string) (int64, error)
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ShipmentStore interface {
+	Create(ctx context.Context, txn *gorm.DB, shipment *do.ShipmentDO) error
+
+	// GetByOrderSn 查询订单的运单和按时间正序的轨迹
+	GetByOrderSn(ctx context.Context, orderSn string) (*do.ShipmentDO, error)
+
+	// ListToSync 需要查询轨迹、且上次查询早于 syncedBefore 的运单，最久没有查询的在前
+	ListToSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*do.ShipmentDO, error)
+
+	// ListToReceive 发货早于 shippedBefore 或签收早于 deliveredBefore、还没有确认收货的运单，异常的运单不自动确认收货
+	ListToReceive(ctx context.Context, shippedBefore, deliveredBefore time.Time, limit int) ([]*do.ShipmentDO, error)
+
+	// Sync 保存新的轨迹节点并更新运单的状态和查询时间
+	Sync(ctx context.Context, shipment *do.ShipmentDO, events []*do.ShipmentEventDO) error
+
+	// UpdateStatus 更新运单状态
+	UpdateStatus(ctx context.Context, txn *gorm.DB, ID int32, status string) error
+}
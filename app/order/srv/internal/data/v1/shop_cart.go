@@ -11,10 +11,22 @@ import (
 type ShopCartStore interface {
 	List(ctx context.Context, userID uint64, checked bool, meta metav1.ListMeta, orderby []string) (*do.ShoppingCartDOList, error)
 	Create(ctx context.Context, cartItem *do.ShoppingCartDO) (int32, error)
-	Get(ctx context.Context, userID, goodsID uint64) (*do.ShoppingCartDO, error)
+	// Get 购物车条目由商品ID和规格ID确定，没有规格的商品规格ID为 0
+	Get(ctx context.Context, userID, goodsID, skuID uint64) (*do.ShoppingCartDO, error)
+	// UpdateNum 更新数量、选中状态和记录的单价，零值字段保持原值
 	UpdateNum(ctx context.Context, cartItem *do.ShoppingCartDO) error
-	Delete(ctx context.Context, userID uint64, goodID uint64) error
+	Delete(ctx context.Context, userID, goodsID, skuID uint64) error
 	ClearCheck(ctx context.Context, userID uint64) error
 	GetBatchByUser(ctx context.Context, userID int32) (*do.GetShoppingBatchResponse, error)
-	DeleteByGoodsIDs(ctx context.Context, txn *gorm.DB, userID uint64, goodsIDs []int32) error
+	// DeleteItems 按商品ID和规格ID删除下单的条目，同一商品没有下单的规格保留在购物车中
+	DeleteItems(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error
+	// Restore 把订单商品放回购物车，购物车里已有同一商品同一规格时累加数量
+	Restore(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error
+}
+
+// GuestCartStore 未登录用户的购物车，以游客ID代替用户ID
+type GuestCartStore interface {
+	ShopCartStore
+	// NewGuest 为第一次加购的游客分配ID
+	NewGuest(ctx context.Context) (int32, error)
 }
@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	metav1 "Advanced_Shop/pkg/common/meta/v1"
+	"context"
+	"gorm.io/gorm"
+)
+
+type OrderStatusHistoryStore interface {
+	// Append 在状态更新的同一个事务里追加变更记录
+	Append(ctx context.Context, txn *gorm.DB, history *do.OrderStatusHistoryDO) error
+
+	// List 按变更时间正序返回订单的状态变更记录
+	List(ctx context.Context, orderSn string, meta metav1.ListMeta) (*do.OrderStatusHistoryDOList, error)
+}
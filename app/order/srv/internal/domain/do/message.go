@@ -7,3 +7,22 @@ const (
 	OptionFail MQMessageType = 1
 	Continuing MQMessageType = 2
 )
+
+// 订单领域事件类型，通过发件箱发布到 OrderEventTopic，同时作为消息 Tag
+const (
+	OrderEventCreated = "order.created"
+	OrderEventPaid    = "order.paid"
+	OrderEventClosed  = "order.closed" // 超时关闭和取消都发这个事件，Status 区分
+)
+
+// OrderEvent 订单领域事件的消息体，金额单位为分
+type OrderEvent struct {
+	Event      string `json:"event"`
+	OrderSn    string `json:"order_sn"`
+	UserId     int32  `json:"user_id"`
+	Status     string `json:"status"`
+	PayAmount  int64  `json:"pay_amount"`
+	CouponCode string `json:"coupon_code,omitempty"`
+	Operator   string `json:"operator,omitempty"`
+	OccurredAt int64  `json:"occurred_at"` // unix 毫秒
+}
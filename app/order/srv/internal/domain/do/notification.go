@@ -0,0 +1,52 @@
+package do
+
+import (
+	"time"
+
+	"Advanced_Shop/app/pkg/gorm"
+)
+
+// 支付通知的处理结果
+const (
+	NotificationProcessed = "PROCESSED" // 已按通知变更订单状态，或订单已经处于该状态
+	NotificationIgnored   = "IGNORED"   // 订单状态不允许变更，比如已退款订单收到交易关闭通知
+	NotificationRejected  = "REJECTED"  // 订单不存在或金额不一致，需要人工处理，不再让渠道重发
+	NotificationFailed    = "FAILED"    // 处理失败，渠道重发或重放时会再次处理
+)
+
+// PaymentNotificationDO 支付通知，每个渠道的每笔交易只保存一条，记录收到的最新交易状态
+type PaymentNotificationDO struct {
+	gorm.Model
+	PayType     string     `gorm:"type:varchar(20);uniqueIndex:idx_payment_notification;comment:支付方式"`
+	TradeNo     string     `gorm:"type:varchar(100);uniqueIndex:idx_payment_notification;comment:渠道交易号"`
+	OrderSn     string     `gorm:"type:varchar(30);index;comment:订单编号"`
+	TradeStatus string     `gorm:"type:varchar(20);comment:交易状态"`
+	Amount      int64      `gorm:"comment:渠道金额（分）"`
+	PayTime     *time.Time `gorm:"comment:支付完成时间"`
+	Status      string     `gorm:"type:varchar(20);comment:处理结果（PROCESSED/IGNORED/REJECTED/FAILED）"`
+	Remark      string     `gorm:"type:varchar(200);comment:处理说明"`
+	NotifyCount int32      `gorm:"type:int;comment:收到的次数，包含重复通知"`
+	Payload     string     `gorm:"type:text;comment:验签后的通知原文"`
+}
+
+func (PaymentNotificationDO) TableName() string {
+	return "payment_notifications"
+}
+
+type PaymentNotificationDOList struct {
+	TotalCount int64                    `json:"totalCount,omitempty"`
+	Items      []*PaymentNotificationDO `json:"items"`
+}
+
+// tradeStatusRank 交易状态的先后顺序，渠道的通知可能乱序到达，排在后面的状态不会被前面的覆盖
+var tradeStatusRank = map[string]int{
+	OrderStatusWaitBuyerPay:  1,
+	OrderStatusTradeSuccess:  2,
+	OrderStatusTradeFinished: 3,
+	OrderStatusTradeClosed:   3,
+}
+
+// TradeStatusBefore 判断交易状态 a 是否在 b 之前
+func TradeStatusBefore(a, b string) bool {
+	return tradeStatusRank[a] < tradeStatusRank[b]
+}
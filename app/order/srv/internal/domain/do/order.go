@@ -19,19 +19,54 @@ func (g *GormList) Scan(value interface{}) error {
 	return json.Unmarshal(value.([]byte), &g)
 }
 
+// 订单状态，支付宝回调会直接写入 TRADE_* 状态，允许的流转见 status.go
+const (
+	OrderStatusPaying        = "PAYING"         // 待支付
+	OrderStatusWaitBuyerPay  = "WAIT_BUYER_PAY" // 支付宝交易已创建，等待买家付款
+	OrderStatusTradeSuccess  = "TRADE_SUCCESS"  // 支付成功
+	OrderStatusTradeFinished = "TRADE_FINISHED" // 支付宝交易结束，不可退款
+	OrderStatusTradeClosed   = "TRADE_CLOSED"   // 支付宝交易关闭
+	OrderStatusClosed        = "CLOSED"         // 超时未支付，已关闭
+	OrderStatusCancelled     = "CANCELLED"      // 用户取消或下单事务补偿
+	OrderStatusShipped       = "SHIPPED"        // 已发货
+	OrderStatusReceived      = "RECEIVED"       // 已收货
+	OrderStatusRefundSuccess = "REFUND_SUCCESS" // 退款成功
+)
+
+// 订单列表的展示方式，未拆分的订单在两种方式下都会出现
+const (
+	OrderViewParent = "parent" // 按支付单，拆分过的订单只展示父订单
+	OrderViewSub    = "sub"    // 按子订单，拆分过的订单只展示子订单
+)
+
 type OrderInfoDO struct {
 	gorm.Model
 	User         int32      `gorm:"type:int;index;comment:用户ID"`
 	OrderSn      string     `gorm:"type:varchar(30);index;comment:订单编号（唯一）"`
 	PayType      string     `gorm:"type:varchar(20);comment:支付方式（alipay/wechat）"`
-	Status       string     `gorm:"type:varchar(20);comment:订单状态（PAYING/TRADE_SUCCESS/CLOSED）"`
+	Status       string     `gorm:"type:varchar(20);comment:订单状态（PAYING/TRADE_SUCCESS/SHIPPED/RECEIVED/CLOSED/CANCELLED等）"`
 	TradeNo      string     `gorm:"type:varchar(100);comment:第三方支付交易号"`
-	OrderMount   float32    `gorm:"comment:订单总金额"`
+	OrderMount   float32    `gorm:"comment:订单总金额（元），由 PayAmount 换算"`
 	PayTime      *time.Time `gorm:"comment:支付时间"`
 	Address      string     `gorm:"type:varchar(100);comment:收货地址"`
 	SignerName   string     `gorm:"type:varchar(20);comment:签收人姓名"`
 	SignerMobile string     `gorm:"type:varchar(11);comment:签收人手机号"`
 	Post         string     `gorm:"type:varchar(20);comment:物流单号"`
+
+	// 价格明细，金额单位为分
+	GoodsAmount    int64               `gorm:"comment:商品总额（分）"`
+	DiscountAmount int64               `gorm:"comment:优惠总额（分）"`
+	ShippingFee    int64               `gorm:"comment:运费（分）"`
+	PayAmount      int64               `gorm:"comment:应付金额（分）"`
+	CouponCode     string              `gorm:"type:varchar(30);comment:使用的优惠券码"`
+	PriceDetail    PriceAdjustmentList `gorm:"type:json;comment:优惠明细"`
+
+	// 拆单，父订单负责支付，子订单各自发货、取消和退款
+	Parent    int32  `gorm:"type:int;index;default:0;comment:父订单ID，父订单和未拆分的订单为 0"`
+	ParentSn  string `gorm:"type:varchar(30);comment:父订单编号"`
+	Merchant  int32  `gorm:"type:int;default:0;comment:子订单所属商家ID"`
+	Warehouse int32  `gorm:"type:int;default:0;comment:子订单的发货仓库ID，0 为不指定"`
+	Split     bool   `gorm:"default:false;comment:是否已按商家或仓库拆分为子订单"`
 }
 
 // TableName 重写订单主表表名
@@ -39,14 +74,35 @@ func (OrderInfoDO) TableName() string {
 	return "orderinfo"
 }
 
+// PaySn 支付渠道中的订单号，子订单按父订单支付和退款
+func (o *OrderInfoDO) PaySn() string {
+	if o.ParentSn != "" {
+		return o.ParentSn
+	}
+	return o.OrderSn
+}
+
+// Payable 应付金额（分），计价上线之前的订单没有 PayAmount，按 OrderMount 换算
+func (o *OrderInfoDO) Payable() int64 {
+	if o.PayAmount == 0 {
+		return Cents(o.OrderMount)
+	}
+	return o.PayAmount
+}
+
 type OrderGoodsModel struct {
 	gorm.Model
 	Order      int32   `gorm:"type:int;index;comment:订单ID"`
 	Goods      int32   `gorm:"type:int;index;comment:商品ID"`
+	Sku        int32   `gorm:"type:int;default:0;comment:规格ID，0 为没有规格的商品"`
+	SkuSpecs   string  `gorm:"type:varchar(200);default:'';comment:下单时的规格描述"`
 	GoodsName  string  `gorm:"type:varchar(100);comment:商品名称"`
 	GoodsPrice float32 `gorm:"comment:商品单价"`
 	GoodImages string  `gorm:"type:varchar(100);comment:商品图片"`
 	Nums       int32   `gorm:"type:int;comment:商品数量"`
+	Price      int64   `gorm:"comment:商品单价（分）"`
+	PayAmount  int64   `gorm:"comment:分摊优惠后的实付金额（分），退款按它计算"`
+	SubOrder   int32   `gorm:"type:int;index;default:0;comment:所属子订单ID，未拆单为 0"`
 }
 
 // TableName 重写订单商品明细表名
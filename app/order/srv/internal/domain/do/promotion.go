@@ -0,0 +1,70 @@
+package do
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"time"
+
+	"Advanced_Shop/app/pkg/gorm"
+)
+
+// 促销规则类型，Code 为空的规则下单时自动生效，优惠券需要用户填写券码
+const (
+	PromotionCategoryDiscount = "category_discount" // 分类折扣
+	PromotionFreeShipping     = "free_shipping"     // 满额包邮
+	PromotionCouponFixed      = "coupon_fixed"      // 立减券
+	PromotionCouponPercent    = "coupon_percent"    // 折扣券
+	PromotionCouponThreshold  = "coupon_threshold"  // 满减券
+)
+
+// PromotionDO 促销规则，金额单位为分
+type PromotionDO struct {
+	gorm.Model
+	Name      string     `gorm:"type:varchar(50);comment:规则名称，展示在价格明细中"`
+	Type      string     `gorm:"type:varchar(20);comment:规则类型（category_discount/free_shipping/coupon_fixed/coupon_percent/coupon_threshold）"`
+	Code      string     `gorm:"type:varchar(30);index;comment:优惠券码，自动生效的规则为空"`
+	Category  int32      `gorm:"type:int;comment:限定的商品分类ID，0 表示不限"`
+	Threshold int64      `gorm:"comment:使用门槛（分），满减券和满额包邮使用"`
+	Amount    int64      `gorm:"comment:减免金额（分），立减券和满减券使用"`
+	Percent   int32      `gorm:"type:int;comment:折后百分比，85 表示八五折"`
+	StartAt   *time.Time `gorm:"comment:生效时间，为空不限"`
+	EndAt     *time.Time `gorm:"comment:失效时间，为空不限"`
+	Enabled   *bool      `gorm:"default:true;comment:是否启用"`
+}
+
+func (PromotionDO) TableName() string {
+	return "promotions"
+}
+
+// PriceAdjustment 价格明细中的一项优惠，Amount 为减免的金额（分）
+// 包邮规则的 Amount 为免去的运费，不计入订单的优惠总额
+type PriceAdjustment struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Amount int64  `json:"amount"`
+}
+
+type PriceAdjustmentList []PriceAdjustment
+
+func (l PriceAdjustmentList) Value() (driver.Value, error) {
+	return json.Marshal(l)
+}
+
+func (l *PriceAdjustmentList) Scan(value interface{}) error {
+	// 计价上线之前的订单没有优惠明细
+	if value == nil {
+		return nil
+	}
+	return json.Unmarshal(value.([]byte), l)
+}
+
+// Cents 商品服务的价格是以元为单位的 float32，计价前统一换算为分
+func Cents(yuan float32) int64 {
+	return int64(math.Round(float64(yuan) * 100))
+}
+
+// Yuan 分换算为元，只用于兼容以元为单位的旧字段和支付渠道
+func Yuan(cents int64) float32 {
+	return float32(cents) / 100
+}
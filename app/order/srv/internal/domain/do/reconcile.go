@@ -0,0 +1,38 @@
+package do
+
+import (
+	"Advanced_Shop/app/pkg/gorm"
+)
+
+// 对账差异类型
+const (
+	MismatchOrderMissing = "ORDER_MISSING"   // 渠道有支付成功的交易，订单不存在
+	MismatchOrderUnpaid  = "ORDER_UNPAID"    // 渠道已支付，订单不是已支付状态
+	MismatchAmount       = "AMOUNT_MISMATCH" // 渠道金额与订单应付金额不一致
+	MismatchTradeMissing = "TRADE_MISSING"   // 订单当天已支付，对账单中没有这笔交易
+)
+
+// 对账差异的处理状态
+const (
+	MismatchStatusOpen     = "OPEN"     // 需要人工处理
+	MismatchStatusResolved = "RESOLVED" // 导入时已自动补记
+)
+
+// PaymentMismatchDO 对账差异，同一账单日重复导入时按唯一索引去重
+type PaymentMismatchDO struct {
+	gorm.Model
+	PayType     string `gorm:"type:varchar(20);uniqueIndex:idx_payment_mismatch;comment:支付方式"`
+	BillDate    string `gorm:"type:varchar(10);uniqueIndex:idx_payment_mismatch;comment:账单日期（yyyy-MM-dd）"`
+	OrderSn     string `gorm:"type:varchar(30);uniqueIndex:idx_payment_mismatch;comment:订单编号"`
+	Type        string `gorm:"type:varchar(20);uniqueIndex:idx_payment_mismatch;comment:差异类型"`
+	TradeNo     string `gorm:"type:varchar(100);comment:渠道交易号"`
+	TradeAmount int64  `gorm:"comment:渠道金额（分）"`
+	OrderAmount int64  `gorm:"comment:订单应付金额（分）"`
+	OrderStatus string `gorm:"type:varchar(20);comment:导入时的订单状态"`
+	Status      string `gorm:"type:varchar(20);comment:处理状态（OPEN/RESOLVED）"`
+	Remark      string `gorm:"type:varchar(200);comment:备注"`
+}
+
+func (PaymentMismatchDO) TableName() string {
+	return "payment_mismatches"
+}
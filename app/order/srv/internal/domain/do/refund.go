@@ -0,0 +1,63 @@
+package do
+
+import (
+	"Advanced_Shop/app/pkg/gorm"
+)
+
+// 退款单状态
+const (
+	RefundStatusPending   = "PENDING"   // 用户已申请，等待审核
+	RefundStatusRejected  = "REJECTED"  // 审核拒绝
+	RefundStatusRefunding = "REFUNDING" // 审核通过，正在退款和归还库存
+	RefundStatusFailed    = "FAILED"    // 退款失败，可以重新审核通过
+	RefundStatusSuccess   = "SUCCESS"   // 退款完成
+)
+
+// RefundableStatus 只有已支付的订单可以申请退款，支付宝 TRADE_FINISHED 的交易不能再退款
+func RefundableStatus(status string) bool {
+	switch status {
+	case OrderStatusTradeSuccess, OrderStatusShipped, OrderStatusReceived:
+		return true
+	}
+	return false
+}
+
+// RefundDO 退款单，一次申请可以退订单中的部分商品
+type RefundDO struct {
+	gorm.Model
+	RefundSn string `gorm:"type:varchar(40);uniqueIndex;comment:退款单号，同时作为支付宝退款请求号"`
+	OrderSn  string `gorm:"type:varchar(30);index;comment:订单编号"`
+	Order    int32  `gorm:"type:int;comment:订单ID"`
+	User     int32  `gorm:"type:int;index;comment:用户ID"`
+	Status   string `gorm:"type:varchar(20);comment:退款状态（PENDING/REJECTED/REFUNDING/FAILED/SUCCESS）"`
+	Amount   int64  `gorm:"comment:退款金额（分）"`
+	Reason   string `gorm:"type:varchar(200);comment:申请原因"`
+	Remark   string `gorm:"type:varchar(200);comment:审核备注或失败原因"`
+
+	Goods []*RefundGoodsDO `gorm:"foreignKey:Refund"`
+}
+
+func (RefundDO) TableName() string {
+	return "order_refunds"
+}
+
+// RefundGoodsDO 退款商品明细，对应订单中的一条 OrderGoodsModel
+type RefundGoodsDO struct {
+	gorm.Model
+	Refund     int32  `gorm:"type:int;index;comment:退款单ID"`
+	OrderGoods int32  `gorm:"type:int;index;comment:订单商品明细ID"`
+	Goods      int32  `gorm:"type:int;comment:商品ID"`
+	Sku        int32  `gorm:"type:int;default:0;comment:规格ID"`
+	GoodsName  string `gorm:"type:varchar(100);comment:商品名称"`
+	Nums       int32  `gorm:"type:int;comment:退款数量"`
+	Amount     int64  `gorm:"comment:退款金额（分）"`
+}
+
+func (RefundGoodsDO) TableName() string {
+	return "order_refund_goods"
+}
+
+type RefundDOList struct {
+	TotalCount int64       `json:"totalCount,omitempty"`
+	Items      []*RefundDO `json:"items"`
+}
@@ -0,0 +1,58 @@
+package do
+
+import (
+	"time"
+
+	"Advanced_Shop/app/pkg/gorm"
+)
+
+// 运单状态，物流轨迹节点也使用这些状态
+const (
+	ShipmentStatusShipped   = "SHIPPED"    // 已发货，等待揽收
+	ShipmentStatusInTransit = "IN_TRANSIT" // 运输中
+	ShipmentStatusDelivered = "DELIVERED"  // 已签收
+	ShipmentStatusException = "EXCEPTION"  // 疑难、退回、拒签等异常
+	ShipmentStatusReceived  = "RECEIVED"   // 订单已确认收货，不再跟踪
+	ShipmentStatusClosed    = "CLOSED"     // 订单已退款等原因不能确认收货，不再跟踪
+)
+
+// ShipmentTracking 需要继续查询物流轨迹的运单状态
+func ShipmentTracking(status string) bool {
+	return status == ShipmentStatusShipped || status == ShipmentStatusInTransit || status == ShipmentStatusException
+}
+
+// ShipmentDO 订单的运单，一个订单只发一次货
+type ShipmentDO struct {
+	gorm.Model
+	OrderSn     string     `gorm:"type:varchar(30);uniqueIndex;comment:订单编号"`
+	Order       int32      `gorm:"type:int;comment:订单ID"`
+	User        int32      `gorm:"type:int;index;comment:用户ID"`
+	Carrier     string     `gorm:"type:varchar(20);comment:快递公司编码"`
+	TrackingNo  string     `gorm:"type:varchar(50);comment:运单号"`
+	Status      string     `gorm:"type:varchar(20);index;comment:运单状态（SHIPPED/IN_TRANSIT/DELIVERED/EXCEPTION/RECEIVED/CLOSED）"`
+	Operator    string     `gorm:"type:varchar(50);comment:发货人"`
+	ShippedAt   time.Time  `gorm:"comment:发货时间"`
+	DeliveredAt *time.Time `gorm:"comment:物流签收时间"`
+	SyncedAt    *time.Time `gorm:"comment:最近一次查询物流轨迹的时间"`
+	LastEventAt *time.Time `gorm:"comment:最新轨迹节点的时间，更早的节点不会重复保存"`
+
+	Events []*ShipmentEventDO `gorm:"foreignKey:Shipment"`
+}
+
+func (ShipmentDO) TableName() string {
+	return "order_shipments"
+}
+
+// ShipmentEventDO 物流轨迹节点
+type ShipmentEventDO struct {
+	gorm.Model
+	Shipment    int32     `gorm:"type:int;index;comment:运单ID"`
+	EventTime   time.Time `gorm:"comment:节点时间"`
+	Status      string    `gorm:"type:varchar(20);comment:节点状态"`
+	Location    string    `gorm:"type:varchar(100);comment:所在地"`
+	Description string    `gorm:"type:varchar(255);comment:节点描述"`
+}
+
+func (ShipmentEventDO) TableName() string {
+	return "order_shipment_events"
+}
@@ -7,18 +7,33 @@ type ShoppingCartDOList struct {
 	Items      []*ShoppingCartDO `json:"items"`
 }
 
+// GetShoppingBatchResponse 选中的购物车条目，同一商品的多个规格是多个条目，GoodsId 中不重复
 type GetShoppingBatchResponse struct {
-	GoodsId    []int32
-	GoodNumMap map[int32]int32
+	GoodsId []int32
+	Items   []*ShoppingCartDO
+}
+
+func NewShoppingBatchResponse(items []*ShoppingCartDO) *GetShoppingBatchResponse {
+	response := &GetShoppingBatchResponse{Items: items}
+	seen := make(map[int32]bool, len(items))
+	for _, item := range items {
+		if !seen[item.Goods] {
+			seen[item.Goods] = true
+			response.GoodsId = append(response.GoodsId, item.Goods)
+		}
+	}
+	return response
 }
 
 // ShoppingCartModel
 type ShoppingCartDO struct {
 	gorm.Model
-	User    int32 `gorm:"type:int;index;comment:用户ID"`
-	Goods   int32 `gorm:"type:int;index;comment:商品ID"`
-	Nums    int32 `gorm:"type:int;comment:商品数量"`
-	Checked *bool `gorm:"comment:是否勾选（结算）"`
+	User    int32   `gorm:"type:int;index;comment:用户ID"`
+	Goods   int32   `gorm:"type:int;index;comment:商品ID"`
+	Sku     int32   `gorm:"type:int;default:0;comment:规格ID，0 为没有规格的商品"`
+	Nums    int32   `gorm:"type:int;comment:商品数量"`
+	Checked *bool   `gorm:"comment:是否勾选（结算）"`
+	Price   float32 `gorm:"type:float;default:0;comment:加入购物车时的单价，0 表示未记录"`
 }
 
 // TableName 重写购物车表名
@@ -27,6 +42,7 @@ func (ShoppingCartDO) TableName() string {
 }
 
 type CartUpdateMap struct {
-	Nums    int32 `json:"nums" structs:"nums"`
-	Checked *bool `json:"checked" structs:"checked"`
+	Nums    int32   `json:"nums" structs:"nums"`
+	Checked *bool   `json:"checked" structs:"checked"`
+	Price   float32 `json:"price" structs:"price"`
 }
@@ -0,0 +1,81 @@
+package do
+
+import (
+	"fmt"
+
+	"Advanced_Shop/app/pkg/gorm"
+)
+
+// orderTransitions 订单状态机，key 为当前状态，value 为允许流转到的状态
+// 支付宝的 TRADE_* 状态由回调写入，可能跳过 WAIT_BUYER_PAY 直接到达
+var orderTransitions = map[string][]string{
+	OrderStatusPaying: {
+		OrderStatusWaitBuyerPay, OrderStatusTradeSuccess, OrderStatusTradeFinished, OrderStatusTradeClosed,
+		OrderStatusClosed, OrderStatusCancelled,
+	},
+	OrderStatusWaitBuyerPay: {
+		OrderStatusTradeSuccess, OrderStatusTradeFinished, OrderStatusTradeClosed,
+		OrderStatusClosed, OrderStatusCancelled,
+	},
+	OrderStatusTradeSuccess:  {OrderStatusTradeFinished, OrderStatusShipped, OrderStatusRefundSuccess},
+	OrderStatusTradeFinished: {OrderStatusShipped},
+	OrderStatusShipped:       {OrderStatusReceived, OrderStatusRefundSuccess},
+	OrderStatusReceived:      {OrderStatusRefundSuccess},
+}
+
+// CanTransit 判断订单能否从 from 流转到 to
+func CanTransit(from, to string) bool {
+	for _, s := range orderTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitFrom 返回可以流转到 to 的所有状态，用于带条件的状态更新
+func TransitFrom(to string) []string {
+	var ret []string
+	for from, targets := range orderTransitions {
+		for _, s := range targets {
+			if s == to {
+				ret = append(ret, from)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// 状态变更的操作方，用户操作记录为 user:<id>
+const (
+	OperatorSystem    = "system"    // 未指明操作方的内部调用
+	OperatorAlipay    = "alipay"    // 支付宝回调
+	OperatorTimeout   = "timeout"   // 超时未支付关闭
+	OperatorSaga      = "saga"      // 分布式事务补偿
+	OperatorReconcile = "reconcile" // 主动查询交易或对账补记
+	OperatorReceipt   = "receipt"   // 发货或签收超时自动确认收货
+)
+
+func OperatorUser(userID int32) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// OrderStatusHistoryDO 订单状态变更记录，只追加不修改
+type OrderStatusHistoryDO struct {
+	gorm.Model
+	OrderSn    string `gorm:"type:varchar(30);index;comment:订单编号"`
+	FromStatus string `gorm:"type:varchar(20);comment:变更前状态，新建订单为空"`
+	ToStatus   string `gorm:"type:varchar(20);comment:变更后状态"`
+	Operator   string `gorm:"type:varchar(50);comment:操作方（user:<id>/alipay/timeout/saga/system）"`
+	Reason     string `gorm:"type:varchar(200);comment:变更原因"`
+}
+
+func (OrderStatusHistoryDO) TableName() string {
+	return "order_status_history"
+}
+
+type OrderStatusHistoryDOList struct {
+	TotalCount int64                   `json:"totalCount,omitempty"`
+	Items      []*OrderStatusHistoryDO `json:"items"`
+}
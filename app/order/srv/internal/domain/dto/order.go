@@ -1,9 +1,13 @@
 package dto
 
-import "Advanced_Shop/app/order/srv/internal/domain/do"
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"time"
+)
 
 type OrderDTO struct {
 	do.OrderInfoDO
+	Province string // 收货省份，不落库，只用于库存服务选仓
 }
 
 type OrderDTOList struct {
@@ -15,6 +19,24 @@ type OrderInfoResponse struct {
 	do.OrderInfoDO
 	GoodIds    []int32
 	OrderGoods []*do.OrderGoodsModel
+	SubOrders  []*SubOrderDTO // 创建时按商家和仓库拆分的子订单
+}
+
+// SubOrderDTO 创建父订单时一起创建的子订单，GoodIds 和 SkuIds 一一对应，这些商品明细归属这个子订单
+type SubOrderDTO struct {
+	do.OrderInfoDO
+	GoodIds []int32
+	SkuIds  []int32
+}
+
+// OrderStatusDTO 一次状态变更请求，进入已支付状态时带上渠道交易号和支付时间
+type OrderStatusDTO struct {
+	OrderSn  string
+	Status   string
+	Operator string
+	Reason   string
+	TradeNo  string
+	PayTime  *time.Time
 }
 
 type OrderDetailRequest struct {
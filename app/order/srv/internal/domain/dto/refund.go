@@ -0,0 +1,7 @@
+package dto
+
+// RefundItem 申请退款的一条订单商品明细
+type RefundItem struct {
+	OrderGoods int32 // 订单商品明细ID
+	Nums       int32
+}
@@ -6,6 +6,7 @@ import (
 	v12 "Advanced_Shop/app/order/srv/internal/data/v1"
 	"Advanced_Shop/app/order/srv/internal/domain/do"
 	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
 	"Advanced_Shop/pkg/common/meta/v1"
 	"Advanced_Shop/pkg/errors"
 	"Advanced_Shop/pkg/log"
@@ -16,45 +17,69 @@ import (
 
 // CartSrv 购物车服务接口，对应数据层的所有核心操作
 type CartSrv interface {
-	// DeleteByGoodsIDs 批量删除指定用户的指定商品ID购物车记录（支持事务）
-	DeleteByGoodsIDs(ctx context.Context, txn *gorm.DB, userID uint64, goodsIDs []int32) error
+	// DeleteItems 批量删除指定用户的指定商品规格购物车记录（支持事务）
+	DeleteItems(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error
 	// List 分页查询用户购物车列表
 	List(ctx context.Context, userID uint64, checked bool, meta v1.ListMeta, orderby []string) (*do.ShoppingCartDOList, error)
 	// Create 添加商品到购物车
 	Create(ctx context.Context, cartItem *do.ShoppingCartDO) (int32, error)
-	// Get 根据用户ID和商品ID查询购物车单品
-	Get(ctx context.Context, userID, goodsID uint64) (*do.ShoppingCartDO, error)
+	// Get 根据用户ID、商品ID和规格ID查询购物车单品
+	Get(ctx context.Context, userID, goodsID, skuID uint64) (*do.ShoppingCartDO, error)
 	// UpdateNum 更新购物车商品数量和选中状态
 	UpdateNum(ctx context.Context, cartItem *do.ShoppingCartDO) error
-	// Delete 根据购物车ID删除单条记录
-	Delete(ctx context.Context, userID uint64, goodID uint64) error
+	// Delete 根据商品ID和规格ID删除单条记录
+	Delete(ctx context.Context, userID, goodsID, skuID uint64) error
 	// GetBatchByUser 获取用户选中的购物车商品批量信息
 	GetBatchByUser(ctx context.Context, userID int32) (*do.GetShoppingBatchResponse, error)
 	// ClearCheck 清空用户购物
 	ClearCheck(ctx context.Context, userID uint64) error
+	// Merge 把另一个购物车的商品并入用户的购物车，同一商品同一规格按配置的规则处理数量
+	Merge(ctx context.Context, userID uint64, items []*do.ShoppingCartDO) error
+	// Validate 按当前的商品信息和库存校验购物车，acceptPrice 为 true 时把记录的单价更新为当前价格
+	Validate(ctx context.Context, userID uint64, checkedOnly, acceptPrice bool) (*CartCheckResult, error)
 }
 
 // cartService 购物车服务实现结构体，依赖数据层工厂
+// 登录用户的购物车存在数据库中，游客购物车存在 Redis 中，两者共用这一套实现
 type cartService struct {
-	data v12.DataFactory // 数据层工厂
+	data   v12.DataFactory    // 数据层工厂
+	carts  v12.ShopCartStore  // 购物车存储
+	guests v12.GuestCartStore // 游客购物车存储，登录用户的购物车为 nil
+	opts   *options.CartOptions
 }
 
 // NewCartService 创建购物车服务实例
 func NewCartService(s *service) CartSrv {
 	return &cartService{
-		data: s.data,
+		data:  s.data,
+		carts: s.data.NewDB().ShopCarts(),
+		opts:  s.cart,
 	}
 }
 
-// DeleteByGoodsIDs 实现CartSrv接口的批量删除方法
-func (cs *cartService) DeleteByGoodsIDs(ctx context.Context, txn *gorm.DB, userID uint64, goodsIDs []int32) error {
-	if userID == 0 || len(goodsIDs) == 0 {
-		return errors.WithCode(code.ErrInvalidParameter, "用户ID或商品ID列表不能为空")
+// newGuestCartService 创建游客购物车服务实例，游客ID代替用户ID传入
+func newGuestCartService(s *service) CartSrv {
+	guests := s.data.GuestCarts()
+	if guests == nil {
+		return nil
+	}
+	return &cartService{
+		data:   s.data,
+		carts:  guests,
+		guests: guests,
+		opts:   s.cart,
+	}
+}
+
+// DeleteItems 实现CartSrv接口的批量删除方法
+func (cs *cartService) DeleteItems(ctx context.Context, txn *gorm.DB, userID uint64, items []*do.ShoppingCartDO) error {
+	if userID == 0 || len(items) == 0 {
+		return errors.WithCode(code.ErrInvalidParameter, "用户ID或商品列表不能为空")
 	}
 
-	err := cs.data.NewDB().ShopCarts().DeleteByGoodsIDs(ctx, txn, userID, goodsIDs)
+	err := cs.carts.DeleteItems(ctx, txn, userID, items)
 	if err != nil {
-		log.Errorf("CartSrv DeleteByGoodsIDs failed: userID=%d, goodsIDs=%v, err=%v", userID, goodsIDs, err)
+		log.Errorf("CartSrv DeleteItems failed: userID=%d, items=%d, err=%v", userID, len(items), err)
 		return err
 	}
 	return nil
@@ -67,7 +92,7 @@ func (cs *cartService) List(ctx context.Context, userID uint64, checked bool, me
 	}
 
 	// 调用数据层查询DO列表
-	doList, err := cs.data.NewDB().ShopCarts().List(ctx, userID, checked, meta, orderby)
+	doList, err := cs.carts.List(ctx, userID, checked, meta, orderby)
 	if err != nil {
 		log.Errorf("CartSrv List failed: userID=%d, checked=%v, err=%v", userID, checked, err)
 		return nil, err
@@ -77,19 +102,33 @@ func (cs *cartService) List(ctx context.Context, userID uint64, checked bool, me
 }
 
 // Create 实现CartSrv接口的创建购物车方法
+// 游客第一次加购时还没有游客ID，先分配一个并写回 cartItem.User
 func (cs *cartService) Create(ctx context.Context, cartItem *do.ShoppingCartDO) (int32, error) {
+	if cartItem != nil && cartItem.User == 0 && cs.guests != nil {
+		guestID, err := cs.guests.NewGuest(ctx)
+		if err != nil {
+			return 0, err
+		}
+		cartItem.User = guestID
+	}
 	if cartItem == nil || cartItem.User == 0 || cartItem.Goods == 0 {
 		return 0, errors.WithCode(code.ErrInvalidParameter, "购物车数据不能为空，用户ID和商品ID必须指定")
 	}
 
-	_, err := cs.data.NewDB().Goods().GetGoodsDetail(ctx, &proto.GoodInfoRequest{Id: cartItem.Goods})
+	goods, err := cs.data.NewDB().Goods().GetGoodsDetail(ctx, &proto.GoodInfoRequest{Id: cartItem.Goods})
+	if err != nil {
+		return 0, err
+	}
+	// 记录加购时的单价，校验购物车时用来提示降价或涨价
+	cartItem.Price, err = cs.itemPrice(ctx, goods, cartItem.Sku)
 	if err != nil {
 		return 0, err
 	}
 
-	// 检查库存
+	// 检查库存，有规格的商品按规格检查
 	detail, err := cs.data.NewDB().Inventorys().InvDetail(ctx, &proto1.GoodsInvInfo{
 		GoodsId: cartItem.Goods,
+		SkuId:   cartItem.Sku,
 	})
 	if err != nil {
 		return 0, err
@@ -98,7 +137,7 @@ func (cs *cartService) Create(ctx context.Context, cartItem *do.ShoppingCartDO)
 		return 0, errors.WithCode(code.ErrInvNotEnough, "库存不足")
 	}
 
-	id, err := cs.data.NewDB().ShopCarts().Create(ctx, cartItem)
+	id, err := cs.carts.Create(ctx, cartItem)
 	if err != nil {
 		log.Errorf("CartSrv Create failed: userID=%d, goodsID=%d, err=%v", cartItem.User, cartItem.Goods, err)
 		return 0, err
@@ -106,13 +145,34 @@ func (cs *cartService) Create(ctx context.Context, cartItem *do.ShoppingCartDO)
 	return id, nil
 }
 
+// itemPrice 有规格的商品必须选择规格，返回规格的单价；没有规格的商品返回商品的售价
+func (cs *cartService) itemPrice(ctx context.Context, goods *proto.GoodsInfoResponse, skuID int32) (float32, error) {
+	if skuID == 0 {
+		if goods.HasSku {
+			return 0, errors.WithCode(code.ErrSkuRequired, "商品%s需要选择规格", goods.Name)
+		}
+		return goods.ShopPrice, nil
+	}
+	skus, err := cs.data.NewDB().Goods().BatchGetSkus(ctx, &proto.BatchSkuIdInfo{Id: []int32{skuID}})
+	if err != nil {
+		return 0, err
+	}
+	if len(skus.Data) == 0 || skus.Data[0].GoodsId != goods.Id {
+		return 0, errors.WithCode(code.ErrSkuNotFound, "商品%s没有规格%d", goods.Name, skuID)
+	}
+	if !skus.Data[0].OnSale {
+		return 0, errors.WithCode(code.ErrCartGoodsOffSale, "商品%s的规格[%s]已停售", goods.Name, skus.Data[0].SpecText)
+	}
+	return skus.Data[0].Price, nil
+}
+
 // Get 实现CartSrv接口的查询单条购物车方法
-func (cs *cartService) Get(ctx context.Context, userID, goodsID uint64) (*do.ShoppingCartDO, error) {
+func (cs *cartService) Get(ctx context.Context, userID, goodsID, skuID uint64) (*do.ShoppingCartDO, error) {
 	if userID == 0 || goodsID == 0 {
 		return nil, errors.WithCode(code.ErrInvalidParameter, "用户ID和商品ID不能为空")
 	}
 
-	doItem, err := cs.data.NewDB().ShopCarts().Get(ctx, userID, goodsID)
+	doItem, err := cs.carts.Get(ctx, userID, goodsID, skuID)
 	if err != nil {
 		log.Errorf("CartSrv Get failed: userID=%d, goodsID=%d, err=%v", userID, goodsID, err)
 		return nil, err
@@ -126,7 +186,7 @@ func (cs *cartService) UpdateNum(ctx context.Context, cartItem *do.ShoppingCartD
 		return errors.WithCode(code.ErrInvalidParameter, "购物车数据不能为空，用户ID和商品ID必须指定")
 	}
 
-	err := cs.data.NewDB().ShopCarts().UpdateNum(ctx, cartItem)
+	err := cs.carts.UpdateNum(ctx, cartItem)
 	if err != nil {
 		log.Errorf("CartSrv UpdateNum failed: userID=%d, goodsID=%d, err=%v", cartItem.User, cartItem.Goods, err)
 		return err
@@ -135,12 +195,12 @@ func (cs *cartService) UpdateNum(ctx context.Context, cartItem *do.ShoppingCartD
 }
 
 // Delete 实现CartSrv接口的删除单条购物车方法
-func (cs *cartService) Delete(ctx context.Context, userID uint64, goodID uint64) error {
+func (cs *cartService) Delete(ctx context.Context, userID, goodsID, skuID uint64) error {
 	if userID == 0 {
 		return errors.WithCode(code.ErrInvalidParameter, "usrID不能为空")
 	}
 
-	err := cs.data.NewDB().ShopCarts().Delete(ctx, userID, goodID)
+	err := cs.carts.Delete(ctx, userID, goodsID, skuID)
 	if err != nil {
 		log.Errorf("CartSrv Delete failed: , err=%v", err)
 		return err
@@ -154,7 +214,7 @@ func (cs *cartService) GetBatchByUser(ctx context.Context, userID int32) (*do.Ge
 		return nil, errors.WithCode(code.ErrInvalidParameter, "用户ID不能为空")
 	}
 
-	doResp, err := cs.data.NewDB().ShopCarts().GetBatchByUser(ctx, userID)
+	doResp, err := cs.carts.GetBatchByUser(ctx, userID)
 	if err != nil {
 		log.Errorf("CartSrv GetBatchByUser failed: userID=%d, err=%v", userID, err)
 		return nil, err
@@ -169,10 +229,79 @@ func (cs *cartService) ClearCheck(ctx context.Context, userID uint64) error {
 		return errors.WithCode(code.ErrInvalidParameter, "用户ID不能为空")
 	}
 
-	err := cs.data.NewDB().ShopCarts().ClearCheck(ctx, userID)
+	err := cs.carts.ClearCheck(ctx, userID)
 	if err != nil {
 		log.Errorf("CartSrv ClearCheck failed: userID=%d, err=%v", userID, err)
 		return err
 	}
 	return nil
 }
+
+// Merge 实现CartSrv接口的合并方法，合并后的数量不超过当前库存，库存为 0 的商品不再放入购物车
+func (cs *cartService) Merge(ctx context.Context, userID uint64, items []*do.ShoppingCartDO) error {
+	if userID == 0 {
+		return errors.WithCode(code.ErrInvalidParameter, "用户ID不能为空")
+	}
+
+	for _, item := range items {
+		nums, checked := item.Nums, item.Checked != nil && *item.Checked
+		exist, err := cs.carts.Get(ctx, userID, uint64(item.Goods), uint64(item.Sku))
+		if err != nil && !errors.IsCode(err, code.ErrShopCartItemNotFound) {
+			return err
+		}
+		if exist != nil {
+			nums = mergeNums(cs.opts.MergeStrategy, exist.Nums, item.Nums)
+			checked = checked || (exist.Checked != nil && *exist.Checked)
+		}
+
+		detail, err := cs.data.NewDB().Inventorys().InvDetail(ctx, &proto1.GoodsInvInfo{
+			GoodsId: item.Goods,
+			SkuId:   item.Sku,
+		})
+		if err != nil {
+			return err
+		}
+		if detail.Num <= 0 {
+			log.Warnf("CartSrv Merge skip goods without stock: userID=%d, goodsID=%d, skuID=%d", userID, item.Goods, item.Sku)
+			continue
+		}
+		if nums > detail.Num {
+			nums = detail.Num
+		}
+
+		merged := &do.ShoppingCartDO{
+			User:    int32(userID),
+			Goods:   item.Goods,
+			Sku:     item.Sku,
+			Nums:    nums,
+			Checked: &checked,
+		}
+		// 用户购物车已有的商品保留原来记录的单价
+		if exist != nil {
+			err = cs.carts.UpdateNum(ctx, merged)
+		} else {
+			merged.Price = item.Price
+			_, err = cs.carts.Create(ctx, merged)
+		}
+		if err != nil {
+			log.Errorf("CartSrv Merge failed: userID=%d, goodsID=%d, err=%v", userID, item.Goods, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeNums 同一商品在两个购物车中都存在时的数量规则
+func mergeNums(strategy string, userNums, guestNums int32) int32 {
+	switch strategy {
+	case options.CartMergeMax:
+		if guestNums > userNums {
+			return guestNums
+		}
+		return userNums
+	case options.CartMergeGuest:
+		return guestNums
+	default:
+		return userNums + guestNums
+	}
+}
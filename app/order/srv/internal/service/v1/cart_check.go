@@ -0,0 +1,225 @@
+package service
+
+import (
+	proto "Advanced_Shop/api/goods/v1"
+	proto1 "Advanced_Shop/api/inventory/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// 读取整个购物车时按 ListMeta 的上限分页，每页 49 条，最多 19 页
+const (
+	cartPageSize = 49
+	cartMaxPage  = 19
+)
+
+// CartLineCheck 购物车中一件商品的校验结果
+type CartLineCheck struct {
+	Goods      int32
+	Sku        int32
+	SkuSpecs   string
+	Name       string
+	Nums       int32
+	Checked    bool
+	AddedPrice float32 // 加入购物车时的单价，0 表示没有记录
+	Price      float32 // 当前单价
+	Stock      int32
+	Limit      int32 // 限购数量，0 为不限购
+
+	PriceChanged      bool // 加购后价格有变化
+	OffSale           bool // 已下架或已删除，规格停售或已删除也算下架
+	InsufficientStock bool // 库存不足
+	LimitExceeded     bool // 超过限购数量
+}
+
+// Valid 价格变化也算作不通过，需要用户确认新价格后才能下单
+func (l *CartLineCheck) Valid() bool {
+	return !l.PriceChanged && !l.OffSale && !l.InsufficientStock && !l.LimitExceeded
+}
+
+// CartCheckResult 购物车的校验结果
+type CartCheckResult struct {
+	Lines []*CartLineCheck
+}
+
+func (r *CartCheckResult) Valid() bool {
+	for _, line := range r.Lines {
+		if !line.Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Err 校验不通过时按 下架、库存不足、超过限购、价格变化 的顺序返回第一类问题，错误信息中列出涉及的商品
+func (r *CartCheckResult) Err() error {
+	checks := []struct {
+		code  int
+		msg   string
+		match func(*CartLineCheck) bool
+	}{
+		{code.ErrCartGoodsOffSale, "商品已下架", func(l *CartLineCheck) bool { return l.OffSale }},
+		{code.ErrCartStockNotEnough, "商品库存不足", func(l *CartLineCheck) bool { return l.InsufficientStock }},
+		{code.ErrCartLimitExceeded, "商品超过限购数量", func(l *CartLineCheck) bool { return l.LimitExceeded }},
+		{code.ErrCartPriceChanged, "商品价格有变化，请确认后再下单", func(l *CartLineCheck) bool { return l.PriceChanged }},
+	}
+	for _, check := range checks {
+		var names []string
+		for _, line := range r.Lines {
+			if check.match(line) {
+				names = append(names, line.Name)
+			}
+		}
+		if len(names) > 0 {
+			return errors.WithCode(check.code, "%s: %s", check.msg, strings.Join(names, "、"))
+		}
+	}
+	return nil
+}
+
+// cartItems 读取购物车中的全部商品
+func cartItems(ctx context.Context, cart CartSrv, userID uint64) ([]*do.ShoppingCartDO, error) {
+	var items []*do.ShoppingCartDO
+	for page := 1; page <= cartMaxPage; page++ {
+		list, err := cart.List(ctx, userID, false, v1.ListMeta{Page: page, PageSize: cartPageSize}, nil)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, list.Items...)
+		if len(list.Items) < cartPageSize || int64(len(items)) >= list.TotalCount {
+			break
+		}
+	}
+	return items, nil
+}
+
+// purchaseLimit 规格设置了限购时按规格，否则按商品，都没有设置时使用全局默认值，0 为不限购
+func purchaseLimit(goods *proto.GoodsInfoResponse, sku *proto.SkuInfoResponse, fallback int32) int32 {
+	if sku != nil && sku.PurchaseLimit > 0 {
+		return sku.PurchaseLimit
+	}
+	if goods.PurchaseLimit > 0 {
+		return goods.PurchaseLimit
+	}
+	return fallback
+}
+
+// stockKey 按商品和规格查询库存，没有规格的商品规格为 0
+type stockKey [2]int32
+
+// Validate 实现CartSrv接口的校验方法，商品信息、规格和库存各批量查询一次
+func (cs *cartService) Validate(ctx context.Context, userID uint64, checkedOnly, acceptPrice bool) (*CartCheckResult, error) {
+	if userID == 0 {
+		return nil, errors.WithCode(code.ErrInvalidParameter, "用户ID不能为空")
+	}
+
+	items, err := cartItems(ctx, cs, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := &CartCheckResult{}
+	goodsIDs := make([]int32, 0, len(items))
+	var skuIDs []int32
+	for _, item := range items {
+		if checkedOnly && (item.Checked == nil || !*item.Checked) {
+			continue
+		}
+		goodsIDs = append(goodsIDs, item.Goods)
+		if item.Sku != 0 {
+			skuIDs = append(skuIDs, item.Sku)
+		}
+	}
+	if len(goodsIDs) == 0 {
+		return result, nil
+	}
+
+	goods, err := cs.data.NewDB().Goods().BatchGetGoods(ctx, &proto.BatchGoodsIdInfo{Id: goodsIDs})
+	if err != nil {
+		log.Errorf("CartSrv Validate get goods failed: goodsIDs=%v, err=%v", goodsIDs, err)
+		return nil, err
+	}
+	goodsMap := make(map[int32]*proto.GoodsInfoResponse, len(goods.Data))
+	for _, g := range goods.Data {
+		goodsMap[g.Id] = g
+	}
+	skuMap := make(map[int32]*proto.SkuInfoResponse, len(skuIDs))
+	if len(skuIDs) > 0 {
+		skus, err := cs.data.NewDB().Goods().BatchGetSkus(ctx, &proto.BatchSkuIdInfo{Id: skuIDs})
+		if err != nil {
+			log.Errorf("CartSrv Validate get skus failed: skuIDs=%v, err=%v", skuIDs, err)
+			return nil, err
+		}
+		for _, sku := range skus.Data {
+			skuMap[sku.Id] = sku
+		}
+	}
+	inv, err := cs.data.NewDB().Inventorys().BatchInvDetail(ctx, &proto1.BatchInvRequest{GoodsIds: goodsIDs, BySku: true})
+	if err != nil {
+		log.Errorf("CartSrv Validate get inventory failed: goodsIDs=%v, err=%v", goodsIDs, err)
+		return nil, err
+	}
+	stocks := make(map[stockKey]int32, len(inv.Data))
+	for _, info := range inv.Data {
+		stocks[stockKey{info.GoodsId, info.SkuId}] = info.Num
+	}
+
+	for _, item := range items {
+		if checkedOnly && (item.Checked == nil || !*item.Checked) {
+			continue
+		}
+		line := &CartLineCheck{
+			Goods:      item.Goods,
+			Sku:        item.Sku,
+			Nums:       item.Nums,
+			Checked:    item.Checked != nil && *item.Checked,
+			AddedPrice: item.Price,
+			Stock:      stocks[stockKey{item.Goods, item.Sku}],
+			Limit:      cs.opts.MaxNums,
+		}
+		if g, ok := goodsMap[item.Goods]; ok {
+			line.Name = g.Name
+			line.Price = g.ShopPrice
+			line.OffSale = g.OnSale != nil && !*g.OnSale
+			line.Limit = purchaseLimit(g, nil, cs.opts.MaxNums)
+			if item.Sku != 0 {
+				// 规格已删除、停售或不属于这个商品
+				sku, ok := skuMap[item.Sku]
+				if ok && sku.GoodsId == item.Goods {
+					line.Limit = purchaseLimit(g, sku, cs.opts.MaxNums)
+					line.Price = sku.Price
+					line.SkuSpecs = sku.SpecText
+					line.Name = g.Name + " " + sku.SpecText
+					line.OffSale = line.OffSale || !sku.OnSale
+				} else {
+					line.OffSale = true
+				}
+			} else if g.HasSku {
+				// 商品加购后新增了规格，需要重新选择
+				line.OffSale = true
+			}
+			line.PriceChanged = item.Price > 0 && do.Cents(item.Price) != do.Cents(line.Price)
+		} else {
+			// 商品已删除
+			line.Name = fmt.Sprintf("商品%d", item.Goods)
+			line.OffSale = true
+		}
+		line.InsufficientStock = item.Nums > line.Stock
+		line.LimitExceeded = line.Limit > 0 && item.Nums > line.Limit
+
+		if acceptPrice && line.PriceChanged {
+			err := cs.carts.UpdateNum(ctx, &do.ShoppingCartDO{User: int32(userID), Goods: item.Goods, Sku: item.Sku, Price: line.Price})
+			if err != nil {
+				return nil, err
+			}
+			line.AddedPrice, line.PriceChanged = line.Price, false
+		}
+		result.Lines = append(result.Lines, line)
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	proto "Advanced_Shop/api/goods/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"testing"
+)
+
+func TestPurchaseLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		goods    *proto.GoodsInfoResponse
+		sku      *proto.SkuInfoResponse
+		fallback int32
+		want     int32
+	}{
+		{name: "都没有设置时不限购", goods: &proto.GoodsInfoResponse{}, want: 0},
+		{name: "都没有设置时使用全局默认值", goods: &proto.GoodsInfoResponse{}, fallback: 99, want: 99},
+		{name: "商品限购优先于全局默认值", goods: &proto.GoodsInfoResponse{PurchaseLimit: 2}, fallback: 99, want: 2},
+		{name: "规格限购优先于商品", goods: &proto.GoodsInfoResponse{PurchaseLimit: 2}, sku: &proto.SkuInfoResponse{PurchaseLimit: 1}, want: 1},
+		{name: "规格没有设置时按商品", goods: &proto.GoodsInfoResponse{PurchaseLimit: 2}, sku: &proto.SkuInfoResponse{}, fallback: 99, want: 2},
+		{name: "规格限购可以大于商品", goods: &proto.GoodsInfoResponse{PurchaseLimit: 2}, sku: &proto.SkuInfoResponse{PurchaseLimit: 5}, want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := purchaseLimit(tt.goods, tt.sku, tt.fallback); got != tt.want {
+				t.Fatalf("purchaseLimit = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCartCheckResultErr(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []*CartLineCheck
+		code  int
+	}{
+		{
+			name:  "全部通过",
+			lines: []*CartLineCheck{{Name: "A", Nums: 100, Stock: 100}},
+		},
+		{
+			name: "下架优先于其他问题",
+			lines: []*CartLineCheck{
+				{Name: "A", LimitExceeded: true},
+				{Name: "B", OffSale: true, PriceChanged: true},
+			},
+			code: code.ErrCartGoodsOffSale,
+		},
+		{
+			name:  "超过限购",
+			lines: []*CartLineCheck{{Name: "A", LimitExceeded: true}, {Name: "B", PriceChanged: true}},
+			code:  code.ErrCartLimitExceeded,
+		},
+		{
+			name:  "价格变化",
+			lines: []*CartLineCheck{{Name: "A", PriceChanged: true}},
+			code:  code.ErrCartPriceChanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &CartCheckResult{Lines: tt.lines}
+			err := result.Err()
+			if tt.code == 0 {
+				if err != nil || !result.Valid() {
+					t.Fatalf("err = %v, valid = %v", err, result.Valid())
+				}
+				return
+			}
+			if !errors.IsCode(err, tt.code) {
+				t.Fatalf("err = %v, want code %d", err, tt.code)
+			}
+			if result.Valid() {
+				t.Fatal("校验不通过时 Valid 应为 false")
+			}
+		})
+	}
+}
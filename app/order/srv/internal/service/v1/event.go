@@ -0,0 +1,47 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/outbox"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 订单领域事件和订单状态在同一个事务里写入发件箱，事务回滚时事件不会发出
+// 支付和关闭事件按支付单发出，子订单跟随父订单变更时不再重复发
+
+// writeEvent 写入一条订单事件，status 为事件发生后的订单状态
+func (os *orderService) writeEvent(ctx context.Context, tx *gorm.DB, event string, order *do.OrderInfoDO, status, operator string) error {
+	return os.events.Write(ctx, tx, outbox.Event{
+		Topic: os.MqOpts.OrderEventTopic,
+		Type:  event,
+		Key:   order.OrderSn,
+		Payload: &do.OrderEvent{
+			Event:      event,
+			OrderSn:    order.OrderSn,
+			UserId:     order.User,
+			Status:     status,
+			PayAmount:  order.PayAmount,
+			CouponCode: order.CouponCode,
+			Operator:   operator,
+			OccurredAt: time.Now().UnixMilli(),
+		},
+	})
+}
+
+// paidEvent 从未支付进入已支付时发出，TRADE_SUCCESS -> TRADE_FINISHED 不再重复发
+func (os *orderService) paidEvent(ctx context.Context, tx *gorm.DB, change *StatusChange) error {
+	if paid(change.From) || change.Order.Parent != 0 {
+		return nil
+	}
+	return os.writeEvent(ctx, tx, do.OrderEventPaid, &change.Order.OrderInfoDO, change.To, change.Operator)
+}
+
+// closedEvent 订单被取消（用户取消、下单补偿）时发出，超时关闭不经过状态机，在 Timeout 中写入
+func (os *orderService) closedEvent(ctx context.Context, tx *gorm.DB, change *StatusChange) error {
+	if change.Order.Parent != 0 {
+		return nil
+	}
+	return os.writeEvent(ctx, tx, do.OrderEventClosed, &change.Order.OrderInfoDO, change.To, change.Operator)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+)
+
+// MergeGuestCart 用户登录后把游客购物车并入用户的购物车，合并成功后删除游客购物车
+// 每合并一件商品就从游客购物车中删除，中途失败时只保留没有合并的商品，下次登录接着合并，已合并的数量不会重复累加
+func MergeGuestCart(ctx context.Context, factory ServiceFactory, guestID, userID uint64) error {
+	guest := factory.GuestCart()
+	if guest == nil {
+		return errors.WithCode(code.ErrGuestCartDisabled, "未开启游客购物车")
+	}
+	if guestID == 0 || userID == 0 {
+		return errors.WithCode(code.ErrInvalidParameter, "游客ID和用户ID不能为空")
+	}
+
+	items, err := cartItems(ctx, guest, guestID)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	for _, item := range items {
+		if err := factory.Cart().Merge(ctx, userID, []*do.ShoppingCartDO{item}); err != nil {
+			return err
+		}
+		// 合并成功后删除失败时这一件商品下次会再合并一次，按求和规则会多算一次数量
+		if err := guest.Delete(ctx, guestID, uint64(item.Goods), uint64(item.Sku)); err != nil {
+			return err
+		}
+	}
+	// 商品都已删除，剩下的只有游客ID的占位，删除失败也会随过期时间清理
+	if err := guest.ClearCheck(ctx, guestID); err != nil {
+		log.Warnf("清空游客购物车失败: guestID=%d, err=%v", guestID, err)
+	}
+	log.Infof("游客购物车已合并: guestID=%d, userID=%d, items=%d", guestID, userID, len(items))
+	return nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"testing"
+)
+
+// memoryCart 按用户保存商品数量，Merge 按求和规则合并，failGoods 中的商品合并失败
+type memoryCart struct {
+	CartSrv
+	items     map[uint64]map[int32]int32
+	failGoods map[int32]bool
+	failClear bool
+}
+
+func newMemoryCart() *memoryCart {
+	return &memoryCart{items: map[uint64]map[int32]int32{}, failGoods: map[int32]bool{}}
+}
+
+func (c *memoryCart) add(userID uint64, goods, nums int32) {
+	if c.items[userID] == nil {
+		c.items[userID] = map[int32]int32{}
+	}
+	c.items[userID][goods] += nums
+}
+
+func (c *memoryCart) List(ctx context.Context, userID uint64, checked bool, meta v1.ListMeta, orderby []string) (*do.ShoppingCartDOList, error) {
+	list := &do.ShoppingCartDOList{}
+	for goods, nums := range c.items[userID] {
+		list.Items = append(list.Items, &do.ShoppingCartDO{User: int32(userID), Goods: goods, Nums: nums})
+	}
+	list.TotalCount = int64(len(list.Items))
+	return list, nil
+}
+
+func (c *memoryCart) Merge(ctx context.Context, userID uint64, items []*do.ShoppingCartDO) error {
+	for _, item := range items {
+		if c.failGoods[item.Goods] {
+			return errors.New("inventory unavailable")
+		}
+		c.add(userID, item.Goods, item.Nums)
+	}
+	return nil
+}
+
+func (c *memoryCart) Delete(ctx context.Context, userID, goodsID, skuID uint64) error {
+	delete(c.items[userID], int32(goodsID))
+	return nil
+}
+
+func (c *memoryCart) ClearCheck(ctx context.Context, userID uint64) error {
+	if c.failClear {
+		return errors.New("redis unavailable")
+	}
+	delete(c.items, userID)
+	return nil
+}
+
+type cartFactory struct {
+	ServiceFactory
+	user, guest *memoryCart
+}
+
+func (f *cartFactory) Cart() CartSrv {
+	return f.user
+}
+
+func (f *cartFactory) GuestCart() CartSrv {
+	return f.guest
+}
+
+func TestMergeGuestCartRetry(t *testing.T) {
+	const guestID, userID = 7, 1
+	tests := []struct {
+		name      string
+		failGoods int32
+		failClear bool
+		wantErr   bool
+	}{
+		{name: "合并成功", wantErr: false},
+		{name: "中途合并失败后重试", failGoods: 2, wantErr: true},
+		{name: "清空游客购物车失败", failClear: true, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &cartFactory{user: newMemoryCart(), guest: newMemoryCart()}
+			factory.user.add(userID, 1, 1)
+			factory.guest.add(guestID, 1, 2)
+			factory.guest.add(guestID, 2, 3)
+			factory.guest.add(guestID, 3, 4)
+			factory.user.failGoods[tt.failGoods] = true
+			factory.guest.failClear = tt.failClear
+
+			err := MergeGuestCart(context.Background(), factory, guestID, userID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// 故障恢复后再次登录，已经合并的商品不会重复累加
+			factory.user.failGoods = map[int32]bool{}
+			if err := MergeGuestCart(context.Background(), factory, guestID, userID); err != nil {
+				t.Fatal(err)
+			}
+			want := map[int32]int32{1: 3, 2: 3, 3: 4}
+			for goods, nums := range want {
+				if got := factory.user.items[userID][goods]; got != nums {
+					t.Fatalf("goods %d nums = %d, want %d", goods, got, nums)
+				}
+			}
+			if n := len(factory.guest.items[guestID]); n != 0 {
+				t.Fatalf("guest cart has %d items left", n)
+			}
+		})
+	}
+}
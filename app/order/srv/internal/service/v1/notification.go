@@ -0,0 +1,113 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/payment"
+	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"fmt"
+)
+
+// 支付通知处理：
+// 1. 网关验签后转发到这里，按支付方式和交易号保存，重复通知只累加收到次数，已处理过的相同状态直接返回
+// 2. 通知可能乱序到达，交易状态排在已记录状态之前的通知直接忽略，不会把订单状态改回去
+// 3. 通知的支付方式与订单的支付方式不一致时拒绝，避免用不验签的模拟支付通知把别的订单置为已支付
+//    通知金额与订单应付金额不一致时拒绝，OrderMount 由 PayAmount 换算，老订单按 OrderMount 比较
+// 4. 按状态机变更订单状态，状态机不允许的变更记为忽略；其他错误记为失败并返回错误，渠道会稍后重发
+// 5. 保存的通知可以由管理员重放，重放时跳过去重，重新执行 3、4 两步
+
+// Notify 处理网关验签后的支付通知，返回错误时网关应答失败让渠道重发
+func (ps *paymentService) Notify(ctx context.Context, notification *do.PaymentNotificationDO) (*do.PaymentNotificationDO, error) {
+	if notification.TradeNo == "" || notification.OrderSn == "" {
+		return nil, errors.WithCode(code2.ErrPayNotification, "支付通知缺少订单号或交易号")
+	}
+	if notification.PayType == "" {
+		notification.PayType = payment.PayTypeAlipay
+	}
+	existing, err := ps.data.NewDB().PaymentNotifications().Receive(ctx, notification)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		stale := do.TradeStatusBefore(notification.TradeStatus, existing.TradeStatus)
+		duplicate := notification.TradeStatus == existing.TradeStatus && existing.Status != do.NotificationFailed
+		if stale || duplicate {
+			log.Infof("%s交易%s的通知%s重复或乱序，已记录的状态为%s，跳过",
+				notification.PayType, notification.TradeNo, notification.TradeStatus, existing.TradeStatus)
+			existing.NotifyCount++
+			return existing, nil
+		}
+	}
+	return ps.process(ctx, notification)
+}
+
+func (ps *paymentService) Notifications(ctx context.Context, orderID int32, meta v1.ListMeta) (*do.PaymentNotificationDOList, error) {
+	order, err := ps.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	return ps.data.NewDB().PaymentNotifications().List(ctx, order.OrderSn, meta)
+}
+
+func (ps *paymentService) Replay(ctx context.Context, ID int32) (*do.PaymentNotificationDO, error) {
+	notification, err := ps.data.NewDB().PaymentNotifications().Get(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("重放%s交易%s的支付通知%d，上次处理结果%s", notification.PayType, notification.TradeNo, ID, notification.Status)
+	return ps.process(ctx, notification)
+}
+
+// process 处理通知并保存处理结果
+func (ps *paymentService) process(ctx context.Context, notification *do.PaymentNotificationDO) (*do.PaymentNotificationDO, error) {
+	var applyErr error
+	notification.Status, notification.Remark, applyErr = ps.apply(ctx, notification)
+	if err := ps.data.NewDB().PaymentNotifications().Update(ctx, notification); err != nil {
+		return nil, err
+	}
+	if applyErr != nil {
+		return nil, applyErr
+	}
+	return notification, nil
+}
+
+// apply 按通知变更订单状态，返回处理结果和说明，只有需要渠道重发的失败才返回错误
+func (ps *paymentService) apply(ctx context.Context, notification *do.PaymentNotificationDO) (string, string, error) {
+	order, err := ps.data.NewDB().Orders().GetByOrderSn(ctx, notification.OrderSn)
+	if err != nil {
+		log.Errorf("支付通知的订单%s不存在: %v", notification.OrderSn, err)
+		return do.NotificationRejected, "订单不存在", nil
+	}
+	payType := order.PayType
+	if payType == "" {
+		payType = payment.PayTypeAlipay
+	}
+	if notification.PayType != payType {
+		log.Errorf("订单%s的支付方式为%s，拒绝%s通知", order.OrderSn, payType, notification.PayType)
+		return do.NotificationRejected, fmt.Sprintf("订单支付方式为%s，不是%s", payType, notification.PayType), nil
+	}
+	if notification.Amount != order.Payable() {
+		log.Errorf("订单%s的%s通知金额%d与应付金额%d不一致", order.OrderSn, notification.PayType, notification.Amount, order.Payable())
+		return do.NotificationRejected, fmt.Sprintf("通知金额%d与应付金额%d不一致", notification.Amount, order.Payable()), nil
+	}
+	err = ps.orders.transitChange(ctx, nil, order.OrderSn, &StatusChange{
+		To:       notification.TradeStatus,
+		Operator: notification.PayType,
+		Reason:   notification.PayType + "交易号" + notification.TradeNo,
+		TradeNo:  notification.TradeNo,
+		PayTime:  notification.PayTime,
+	})
+	if errors.IsCode(err, code2.ErrOrderTransition) {
+		log.Warnf("订单%s忽略%s通知%s: %v", order.OrderSn, notification.PayType, notification.TradeStatus, err)
+		return do.NotificationIgnored, fmt.Sprintf("订单状态为%s，不能变更为%s", order.Status, notification.TradeStatus), nil
+	}
+	if err != nil {
+		log.Errorf("订单%s处理%s通知失败: %v", order.OrderSn, notification.PayType, err)
+		return do.NotificationFailed, "变更订单状态失败", err
+	}
+	return do.NotificationProcessed, "", nil
+}
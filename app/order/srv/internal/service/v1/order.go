@@ -1,6 +1,7 @@
 package service
 
 import (
+	apb "Advanced_Shop/api/action/v1"
 	proto3 "Advanced_Shop/api/goods/v1"
 	proto2 "Advanced_Shop/api/inventory/v1"
 	proto "Advanced_Shop/api/order/v1"
@@ -8,42 +9,108 @@ import (
 	"Advanced_Shop/app/order/srv/internal/domain/do"
 	"Advanced_Shop/app/order/srv/internal/domain/dto"
 	code2 "Advanced_Shop/app/pkg/code"
+	bgorm "Advanced_Shop/app/pkg/gorm"
 	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/app/pkg/outbox"
+	"Advanced_Shop/app/pkg/payment"
 	"Advanced_Shop/gnova/code"
 	v1 "Advanced_Shop/pkg/common/meta/v1"
 	"Advanced_Shop/pkg/errors"
 	"Advanced_Shop/pkg/log"
 	"context"
 	"encoding/json"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/dtm-labs/client/dtmgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"time"
 )
 
+// 营销服务，优惠券的核销和退回作为订单事务的分支
+const actionBusi = "discovery:///xshop-action-srv"
+
 type OrderSrv interface {
 	Get(ctx context.Context, orderSn dto.OrderDetailRequest) (*dto.OrderInfoResponse, error)
-	List(ctx context.Context, userID uint64, meta v1.ListMeta, orderby []string) (*dto.OrderDTOList, error)
-	Submit(ctx context.Context, order *dto.OrderDTO) (float32, error)
+	// List 用户的订单，view 为 do.OrderView*，为空时按支付单展示
+	List(ctx context.Context, userID uint64, view string, meta v1.ListMeta, orderby []string) (*dto.OrderDTOList, error)
+	// SubOrders 父订单拆分出的子订单
+	SubOrders(ctx context.Context, parentID int32) ([]*do.OrderInfoDO, error)
+	// Submit 下单，返回计价结果
+	Submit(ctx context.Context, order *dto.OrderDTO) (*PriceResult, error)
+	// Preview 按购物车选中的商品和优惠券试算价格，与提交订单使用相同的计价规则
+	Preview(ctx context.Context, userID int32, couponCode string) (*PriceResult, error)
 	Create(ctx context.Context, order *dto.OrderInfoResponse) error
-	CreateCom(ctx context.Context, order *dto.OrderDTO) error //这是create的补偿
-	UpdateStatus(ctx context.Context, orderSn string, status string) error
+	CreateCom(ctx context.Context, orderSn string) error //这是create的补偿
+	// Cancel 用户取消待支付订单，通过 DTM 关闭订单并归还库存；已付款未发货的子订单取消时申请退款，等待商家审核
+	Cancel(ctx context.Context, userID, orderID int32) error
+	// Close 取消订单的 Saga 分支，待支付订单置为已取消
+	Close(ctx context.Context, orderSn, operator string) error
+	// UpdateStatus 按状态机变更订单状态，operator 为空时记为 system
+	UpdateStatus(ctx context.Context, status *dto.OrderStatusDTO) error
+	// History 查询订单的状态变更记录，userID 为 0 时不限制用户
+	History(ctx context.Context, userID, orderID int32, meta v1.ListMeta) (*do.OrderStatusHistoryDOList, error)
 	GetByOrderSn(ctx context.Context, orderSn string) (*dto.OrderInfoResponse, error)
+	// Timeout 处理到期的订单超时消息，未支付的订单关闭并归还库存和优惠券
+	Timeout(ctx context.Context, body []byte) error
 }
 
 type orderService struct {
-	data    v12.DataFactory
-	dtmOpts *options.DtmOptions
-	MqOpts  *options.RocketMQOptions
+	data      v12.DataFactory
+	dtmOpts   *options.DtmOptions
+	MqOpts    *options.RocketMQOptions
+	delayOpts *options.DelayQueueOptions
+	reconcile *options.ReconcileOptions
+	events    outbox.Writer
+	machine   *StateMachine
+	pricing   *pricing
+	carts     CartSrv
 }
 
-func (os *orderService) CreateCom(ctx context.Context, order *dto.OrderDTO) error {
-	/*
-		1. 删除orderinfo表
-		2. 删除ordergoods表
-		3. 删除order找到对应的购物车条目，删除购物车条目
-	*/
-	//其实不用回滚
-	//你应该先查询订单是否已经存在，如果已经存在删除相关记录即可， 同时删除购物车记录
-	return nil
+// CreateCom 订单保留并置为已取消，已删除的购物车条目按订单商品放回
+// 与 Create 共用屏障，订单还没创建时的空补偿和补偿后才到达的 Create 都由屏障处理
+func (os *orderService) CreateCom(ctx context.Context, orderSn string) error {
+	log.Infof("订单%s创建补偿", orderSn)
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "创建屏障失败: %v", err)
+	}
+
+	return bgorm.CallWithGorm(barrier, os.data.NewDB().DB(), func(tx *gorm.DB) error {
+		order, err := os.data.NewDB().Orders().GetWithTx(ctx, tx, orderSn)
+		if err != nil {
+			if errors.IsCode(err, code2.ErrOrderNotFound) {
+				log.Infof("订单%s不存在，空补偿", orderSn)
+				return nil
+			}
+			return err
+		}
+		if !do.CanTransit(order.Status, do.OrderStatusCancelled) {
+			log.Infof("订单%s状态为%s，跳过补偿", orderSn, order.Status)
+			return nil
+		}
+
+		if err := os.transit(ctx, tx, orderSn, do.OrderStatusCancelled, do.OperatorSaga, "下单事务补偿"); err != nil {
+			return err
+		}
+
+		items := make([]*do.ShoppingCartDO, 0, len(order.OrderGoods))
+		for _, goods := range order.OrderGoods {
+			checked := true
+			items = append(items, &do.ShoppingCartDO{
+				Goods:   goods.Goods,
+				Sku:     goods.Sku,
+				Nums:    goods.Nums,
+				Checked: &checked,
+			})
+		}
+		if err := os.data.NewDB().ShopCarts().Restore(ctx, tx, uint64(order.User), items); err != nil {
+			log.Errorf("订单%s恢复购物车失败: %v", orderSn, err)
+			return err
+		}
+		log.Infof("订单%s创建补偿完成", orderSn)
+		return nil
+	})
 }
 
 func (os *orderService) Create(ctx context.Context, order *dto.OrderInfoResponse) error {
@@ -52,42 +119,171 @@ func (os *orderService) Create(ctx context.Context, order *dto.OrderInfoResponse
 		2. 生成ordergoods表
 		3. 根据order找到对应的购物车条目，删除购物车条目
 	*/
-	txn := os.data.NewDB().Begin()
-	defer func() {
-		if err := recover(); err != nil {
-			_ = txn.Rollback()
-			log.Error("新建订单事务进行中出现异常，回滚")
-			return
-		}
-	}()
-	// 幂等性
-	exists, err := os.data.NewDB().Orders().ExistsByOrderSn(ctx, txn, order.OrderSn)
-	if exists {
-		// 订单已存在，直接返回成功（幂等）
-		txn.Commit()
-		return nil
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "创建屏障失败: %v", err)
 	}
 
-	// 所有的创建在这里
-	err = os.data.NewDB().Orders().Create(ctx, txn, order)
+	err = bgorm.CallWithGorm(barrier, os.data.NewDB().DB(), func(tx *gorm.DB) error {
+		// 幂等性
+		_, err := os.data.NewDB().Orders().GetWithTx(ctx, tx, order.OrderSn)
+		if err == nil {
+			// 订单已存在，直接返回成功（幂等）
+			return nil
+		}
+		if !errors.IsCode(err, code2.ErrOrderNotFound) {
+			return err
+		}
+
+		// 所有的创建在这里
+		err = os.data.NewDB().Orders().Create(ctx, tx, order)
+		if err != nil {
+			log.Errorf("创建订单失败，err:%v", err)
+			return err // 这个不是abort 也就是说会不停的重试
+		}
+
+		err = os.data.NewDB().StatusHistories().Append(ctx, tx, &do.OrderStatusHistoryDO{
+			OrderSn:  order.OrderSn,
+			ToStatus: do.OrderStatusPaying,
+			Operator: do.OperatorUser(order.User),
+			Reason:   "创建订单",
+		})
+		if err != nil {
+			return err
+		}
+		for _, sub := range order.SubOrders {
+			err = os.data.NewDB().StatusHistories().Append(ctx, tx, &do.OrderStatusHistoryDO{
+				OrderSn:  sub.OrderSn,
+				ToStatus: do.OrderStatusPaying,
+				Operator: do.OperatorUser(order.User),
+				Reason:   "按商家拆分自订单" + order.OrderSn,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// 按商品和规格删除，同一商品没有下单的规格留在购物车中
+		items := make([]*do.ShoppingCartDO, 0, len(order.OrderGoods))
+		for _, goods := range order.OrderGoods {
+			items = append(items, &do.ShoppingCartDO{Goods: goods.Goods, Sku: goods.Sku})
+		}
+		err = os.data.NewDB().ShopCarts().DeleteItems(ctx, tx, uint64(order.User), items)
+		if err != nil {
+			log.Errorf("删除购物车失败，goodids:%v, err:%v", order.GoodIds, err)
+			return errors.WithCode(code.ErrDatabase, err.Error())
+		}
+		return os.writeEvent(ctx, tx, do.OrderEventCreated, &order.OrderInfoDO, do.OrderStatusPaying, do.OperatorUser(order.User))
+	})
 	if err != nil {
-		txn.Rollback()
-		log.Errorf("创建订单失败，err:%v", err)
-		return err // 这个不是abort 也就是说会不停的重试
+		return err
 	}
+	// 订单提交后再投递超时消息，不会在订单创建之前到期；投递失败时 DTM 重试这个分支，
+	// 屏障跳过已提交的事务，只重新投递，超时处理按订单号幂等
+	return os.pushTimeout(ctx, &order.OrderInfoDO)
+}
 
-	err = os.data.NewDB().ShopCarts().DeleteByGoodsIDs(ctx, txn, uint64(order.User), order.GoodIds)
+// pushTimeout 向延时队列投递订单超时消息，超时未支付时关闭订单
+func (os *orderService) pushTimeout(ctx context.Context, order *do.OrderInfoDO) error {
+	data, _ := json.Marshal(do.OrderMQMessageRequest{
+		Id:       order.ID,
+		UserId:   order.User,
+		Address:  order.Address,
+		Name:     order.SignerName,
+		Mobile:   order.SignerMobile,
+		Post:     order.Post,
+		OrderSns: order.OrderSn,
+	})
+	if err := os.data.DelayQueue().Push(ctx, data, os.delayOpts.OrderTimeout); err != nil {
+		log.Errorf("订单%s投递超时消息失败: %v", order.OrderSn, err)
+		return err
+	}
+	return nil
+}
+
+func (os *orderService) Cancel(ctx context.Context, userID, orderID int32) error {
+	order, err := os.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{UserID: userID, OrderID: orderID})
 	if err != nil {
-		txn.Rollback()
-		log.Errorf("删除购物车失败，goodids:%v, err:%v", order.GoodIds, err)
+		return err
+	}
+	if order.Parent != 0 {
+		return os.cancelSubOrder(ctx, userID, order)
+	}
+	if !do.CanTransit(order.Status, do.OrderStatusCancelled) {
+		return errors.WithCode(code2.ErrOrderCannotCancel, "订单%s状态为%s，不能取消", order.OrderSn, order.Status)
+	}
+	// 先关闭渠道中的交易，用户已经付款时关单失败，订单不能取消
+	if err := os.closeTrade(ctx, &order.OrderInfoDO); err != nil {
 		return err
 	}
 
-	txn.Commit()
+	// 先关闭订单再归还库存，订单已被支付时第一个分支失败，库存不会被归还
+	qsBusi := "discovery:///xshop-inventory-srv"
+	gBusi := "discovery:///xshop-order-srv"
+	rebackBranch := qsBusi + "/Inventory/Reback"
+	if os.dtmOpts.Mode == options.DtmModeTcc {
+		// TCC 模式下未支付订单的库存还处于冻结状态
+		rebackBranch = qsBusi + "/Inventory/CancelSell"
+	}
+	saga := dtmgrpc.NewSagaGrpc(os.dtmOpts.GrpcServer, "cancel_"+order.OrderSn).
+		Add(gBusi+"/Order/CloseOrder", "", &proto.OrderStatus{
+			OrderSn:  order.OrderSn,
+			Status:   do.OrderStatusCancelled,
+			Operator: do.OperatorUser(userID),
+		}).
+		Add(rebackBranch, "", &proto2.SellInfo{OrderSn: order.OrderSn})
+	if order.CouponCode != "" {
+		// 不是券包中的优惠券时营销服务直接跳过
+		saga.Add(actionBusi+"/Coupon/ReleaseCoupon", "", &apb.UserCouponRequest{
+			UserId:  order.User,
+			Code:    order.CouponCode,
+			OrderSn: order.OrderSn,
+		})
+	}
+	saga.WaitResult = true
+	if err := saga.Submit(); err != nil {
+		log.Errorf("订单%s取消失败: %v", order.OrderSn, err)
+		return err
+	}
+	log.Infof("订单%s已取消", order.OrderSn)
+	return nil
+}
 
+// closeTrade 关闭渠道中未支付的交易，防止订单关闭后用户仍然可以付款
+func (os *orderService) closeTrade(ctx context.Context, order *do.OrderInfoDO) error {
+	provider, err := os.data.Payment(order.PayType)
+	if err != nil {
+		if errors.IsCode(err, code2.ErrPayTypeUnsupported) {
+			// 本服务没有开启这个支付渠道，用户也无法通过这个渠道付款
+			log.Warnf("订单%s的支付方式%s未开启，跳过关单", order.OrderSn, order.PayType)
+			return nil
+		}
+		return err
+	}
+	if err := provider.CloseTrade(ctx, order.OrderSn); err != nil {
+		log.Errorf("订单%s关闭支付交易失败: %v", order.OrderSn, err)
+		return err
+	}
 	return nil
 }
 
+func (os *orderService) Close(ctx context.Context, orderSn, operator string) error {
+	err := os.transit(ctx, nil, orderSn, do.OrderStatusCancelled, operator, "用户取消订单")
+	if errors.IsCode(err, code2.ErrOrderTransition) || errors.IsCode(err, code2.ErrOrderNotFound) {
+		// 订单在取消过程中被支付或关闭，Saga 失败，后续的归还分支不会执行
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+func (os *orderService) History(ctx context.Context, userID, orderID int32, meta v1.ListMeta) (*do.OrderStatusHistoryDOList, error) {
+	order, err := os.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{UserID: userID, OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	return os.data.NewDB().StatusHistories().List(ctx, order.OrderSn, meta)
+}
+
 func (os *orderService) Get(ctx context.Context, detail dto.OrderDetailRequest) (*dto.OrderInfoResponse, error) {
 	orderInfo, err := os.data.NewDB().Orders().Get(ctx, detail)
 	if err != nil {
@@ -105,8 +301,8 @@ func (os *orderService) GetByOrderSn(ctx context.Context, orderSn string) (*dto.
 	return orderInfo, nil
 }
 
-func (os *orderService) List(ctx context.Context, userID uint64, meta v1.ListMeta, orderby []string) (*dto.OrderDTOList, error) {
-	orders, err := os.data.NewDB().Orders().List(ctx, userID, meta, orderby)
+func (os *orderService) List(ctx context.Context, userID uint64, view string, meta v1.ListMeta, orderby []string) (*dto.OrderDTOList, error) {
+	orders, err := os.data.NewDB().Orders().List(ctx, userID, view, meta, orderby)
 	if err != nil {
 		return nil, err
 	}
@@ -114,19 +310,19 @@ func (os *orderService) List(ctx context.Context, userID uint64, meta v1.ListMet
 	ret.TotalCount = orders.TotalCount
 	for _, value := range orders.Items {
 		ret.Items = append(ret.Items, &dto.OrderDTO{
-			*value,
+			OrderInfoDO: *value,
 		})
 	}
 	return &ret, nil
 }
 
-func (os *orderService) Submit(ctx context.Context, order *dto.OrderDTO) (float32, error) {
-
+// price 按购物车中选中的商品计价，返回计价结果和选中的商品ID
+func (os *orderService) price(ctx context.Context, userID int32, couponCode string) (*PriceResult, []int32, error) {
 	//先拿到 选中的 good ID
-	response, err := os.data.NewDB().ShopCarts().GetBatchByUser(ctx, order.User)
+	response, err := os.data.NewDB().ShopCarts().GetBatchByUser(ctx, userID)
 	if err != nil {
 		log.Errorf("购物车中没有商品，无法下单")
-		return 0, err
+		return nil, nil, err
 	}
 
 	goods, err := os.data.NewDB().Goods().BatchGetGoods(ctx, &proto3.BatchGoodsIdInfo{
@@ -134,64 +330,123 @@ func (os *orderService) Submit(ctx context.Context, order *dto.OrderDTO) (float3
 	})
 	if err != nil {
 		log.Errorf("批量获取商品信息失败，goodids: %v, err:%v", response.GoodsId, err)
-		return 0, err
+		return nil, nil, err
 	}
 
-	// 构建延时消息
-	model := do.OrderMQMessageRequest{
-		Id:       order.ID,
-		UserId:   order.User,
-		Address:  order.Address,
-		Name:     order.SignerName,
-		Mobile:   order.SignerMobile,
-		Post:     order.Post,
-		OrderSns: order.OrderSn,
+	goodsMap := make(map[int32]*proto3.GoodsInfoResponse, len(goods.Data))
+	for _, goodModel := range goods.Data {
+		goodsMap[goodModel.Id] = goodModel
+	}
+	skuMap, err := os.skus(ctx, response.Items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 每个购物车条目一行，有规格的商品按规格的单价计价
+	lines := make([]*PriceLine, 0, len(response.Items))
+	for _, item := range response.Items {
+		goodModel, ok := goodsMap[item.Goods]
+		if !ok {
+			continue
+		}
+		line := &PriceLine{
+			Goods:    goodModel.Id,
+			Category: goodModel.CategoryId,
+			Name:     goodModel.Name,
+			Image:    goodModel.GoodsFrontImage,
+			Price:    do.Cents(goodModel.ShopPrice),
+			Nums:     item.Nums,
+			ShipFree: goodModel.GetShipFree(),
+			Merchant: goodModel.GetMerchantId(),
+		}
+		if item.Sku != 0 {
+			sku, ok := skuMap[item.Sku]
+			if !ok || sku.GoodsId != item.Goods {
+				return nil, nil, errors.WithCode(code2.ErrSkuNotFound, "商品%s的规格%d不存在", goodModel.Name, item.Sku)
+			}
+			line.Sku, line.SkuSpecs = sku.Id, sku.SpecText
+			line.Price = do.Cents(sku.Price)
+			if sku.Image != "" {
+				line.Image = sku.Image
+			}
+		}
+		lines = append(lines, line)
+	}
+	result, err := os.pricing.Price(ctx, userID, lines, couponCode)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, response.GoodsId, nil
+}
+
+// skus 批量查询购物车条目的规格
+func (os *orderService) skus(ctx context.Context, items []*do.ShoppingCartDO) (map[int32]*proto3.SkuInfoResponse, error) {
+	var ids []int32
+	for _, item := range items {
+		if item.Sku != 0 {
+			ids = append(ids, item.Sku)
+		}
+	}
+	skuMap := make(map[int32]*proto3.SkuInfoResponse, len(ids))
+	if len(ids) == 0 {
+		return skuMap, nil
+	}
+	skus, err := os.data.NewDB().Goods().BatchGetSkus(ctx, &proto3.BatchSkuIdInfo{Id: ids})
+	if err != nil {
+		log.Errorf("批量获取商品规格失败，skuids: %v, err:%v", ids, err)
+		return nil, err
+	}
+	for _, sku := range skus.Data {
+		skuMap[sku.Id] = sku
+	}
+	return skuMap, nil
+}
+
+func (os *orderService) Preview(ctx context.Context, userID int32, couponCode string) (*PriceResult, error) {
+	result, _, err := os.price(ctx, userID, couponCode)
+	return result, err
+}
+
+func (os *orderService) Submit(ctx context.Context, order *dto.OrderDTO) (*PriceResult, error) {
+	// 先校验选中的商品，下架、库存不足、超过限购或价格有变化时不下单
+	check, err := os.carts.Validate(ctx, uint64(order.User), true, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := check.Err(); err != nil {
+		return nil, err
 	}
-	data, _ := json.Marshal(model)
-	// 延时消息
-	msg := primitive.NewMessage(os.MqOpts.Topic, data)
-	_, err = os.data.NewMQ().SendDelayMsgWithRetry(ctx, msg)
 
+	// 再计价，优惠券不可用时不开启事务
+	result, goodsIDs, err := os.price(ctx, order.User, order.CouponCode)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	os.planWarehouse(ctx, order.Province, result.Lines)
+	PriceSum := do.Yuan(result.PayAmount)
+	if order.PayType == "" {
+		order.PayType = payment.PayTypeAlipay
 	}
 
-	var PriceSum float32
-	// 生成表用的
-	var orderGoods []*do.OrderGoodsModel
+	// 订单服务用的
+	var orderItems []*proto.OrderItemResponse
 	// 库存微服务用的
 	var goodsInfo []*proto2.GoodsInvInfo
-	for _, goodModel := range goods.Data {
-		PriceSum += goodModel.ShopPrice * float32(response.GoodNumMap[goodModel.Id])
-		orderGoods = append(orderGoods, &do.OrderGoodsModel{
-			Goods:      goodModel.Id,
-			GoodsName:  goodModel.Name,
-			GoodsPrice: goodModel.ShopPrice,
-			GoodImages: goodModel.GoodsFrontImage,
-			Nums:       response.GoodNumMap[goodModel.Id],
-		})
+	for _, line := range result.Lines {
+		orderItems = append(orderItems, line.Item())
 		// 库存服务接收参数
 		goodsInfo = append(goodsInfo, &proto2.GoodsInvInfo{
-			GoodsId: goodModel.Id,
-			Num:     response.GoodNumMap[goodModel.Id],
-		})
-	}
-
-	// 构建 请求体
-	var orderItems []*proto.OrderItemResponse
-	for _, good := range orderGoods {
-		orderItems = append(orderItems, &proto.OrderItemResponse{
-			GoodsId:    good.Goods,
-			GoodsName:  good.GoodsName,
-			GoodsImage: good.GoodImages,
-			GoodsPrice: good.GoodsPrice,
-			Nums:       good.Nums,
+			GoodsId:     line.Goods,
+			SkuId:       line.Sku,
+			Num:         line.Nums,
+			WarehouseId: line.Warehouse,
 		})
 	}
 	// 库存服务
 	req := &proto2.SellInfo{
 		GoodsInfo: goodsInfo,
 		OrderSn:   order.OrderSn,
+		Province:  order.Province,
 	}
 	// 订单服务
 	oReq := &proto.CreateRequest{
@@ -203,40 +458,134 @@ func (os *orderService) Submit(ctx context.Context, order *dto.OrderDTO) (float3
 		Mobile:     order.SignerMobile,
 		Post:       order.Post,
 		OrderItems: orderItems,
-		GoodsId:    response.GoodsId, // 用于删除 购物车的
+		GoodsId:    goodsIDs, // 用于删除 购物车的
+		Price:      result.Breakdown(),
+		PayType:    order.PayType,
+		SubOrders:  splitOrder(result),
+	}
+	// 营销服务，使用券包中的优惠券时先核销，库存扣减失败时补偿分支把优惠券退回券包
+	var couponReq *apb.UserCouponRequest
+	if result.UserCoupon {
+		couponReq = &apb.UserCouponRequest{
+			UserId:  order.User,
+			Code:    result.CouponCode,
+			OrderSn: order.OrderSn,
+		}
 	}
 
-	log.Info("开启saga......")
 	qsBusi := "discovery:///xshop-inventory-srv"
 	gBusi := "discovery:///xshop-order-srv"
-	saga := dtmgrpc.NewSagaGrpc(os.dtmOpts.GrpcServer, order.OrderSn).
-		Add(qsBusi+"/Inventory/Sell", qsBusi+"/Inventory/Reback", req).
+	if os.dtmOpts.Mode == options.DtmModeTcc {
+		log.Info("开启tcc......")
+		err = dtmgrpc.TccGlobalTransaction(os.dtmOpts.GrpcServer, order.OrderSn, func(tcc *dtmgrpc.TccGrpc) error {
+			if couponReq != nil {
+				err := tcc.CallBranch(couponReq, actionBusi+"/Coupon/RedeemCoupon", "", actionBusi+"/Coupon/ReleaseCoupon", &emptypb.Empty{})
+				if err != nil {
+					return err
+				}
+			}
+			// confirm 留空：TCC 的 confirm 在下单成功后立即执行，而冻结的库存要一直保留到支付成功，
+			// 超时未支付时由 CancelSell 释放。支付成功后由状态机守卫 confirmSell 转为已售，重试方式见 confirmSell
+			err := tcc.CallBranch(req, qsBusi+"/Inventory/TrySell", "", qsBusi+"/Inventory/CancelSell", &emptypb.Empty{})
+			if err != nil {
+				return err
+			}
+			return tcc.CallBranch(oReq, gBusi+"/Order/CreateOrder", "", gBusi+"/Order/CreateOrderCom", &emptypb.Empty{})
+		})
+		return result, err
+	}
+
+	log.Info("开启saga......")
+	saga := dtmgrpc.NewSagaGrpc(os.dtmOpts.GrpcServer, order.OrderSn)
+	if couponReq != nil {
+		saga.Add(actionBusi+"/Coupon/RedeemCoupon", actionBusi+"/Coupon/ReleaseCoupon", couponReq)
+	}
+	saga.Add(qsBusi+"/Inventory/Sell", qsBusi+"/Inventory/Reback", req).
 		Add(gBusi+"/Order/CreateOrder", gBusi+"/Order/CreateOrderCom", oReq)
 	saga.WaitResult = true
 	err = saga.Submit()
 	//通过OrderSn查询一下， 当前的状态如何状态一直值Submitted那么就你一直不要给前端返回， 如果是failed那么你提示给前端说下单失败，重新下单
 
-	return PriceSum, err
+	return result, err
+}
+
+func (os *orderService) UpdateStatus(ctx context.Context, status *dto.OrderStatusDTO) error {
+	operator := status.Operator
+	if operator == "" {
+		operator = do.OperatorSystem
+	}
+	return os.transitChange(ctx, nil, status.OrderSn, &StatusChange{
+		To:       status.Status,
+		Operator: operator,
+		Reason:   status.Reason,
+		TradeNo:  status.TradeNo,
+		PayTime:  status.PayTime,
+	})
 }
 
-func (os *orderService) UpdateStatus(ctx context.Context, orderSn string, status string) error {
-	row, err := os.data.NewDB().Orders().UpdateStatus(ctx, orderSn, status)
+// confirmSell TCC 模式下支付成功才把冻结库存转为已售，ConfirmSell 本身是幂等的
+// 作为进入已支付状态的守卫，确认失败时订单保持待支付，下面几条路径都会再次进入已支付状态并重试确认：
+// 1. 支付通知处理结果记为失败并返回错误，渠道重发的通知不会被去重，也可以通过 Replay 重放
+// 2. 支付主动查询和对账单导入查到已支付的待支付订单时补记支付
+func (os *orderService) confirmSell(ctx context.Context, change *StatusChange) error {
+	// 子订单的库存记在父订单上，由父订单确认
+	if os.dtmOpts.Mode != options.DtmModeTcc || paid(change.From) || change.Order.Parent != 0 {
+		return nil
+	}
+	_, err := os.data.NewDB().Inventorys().ConfirmSell(ctx, &proto2.SellInfo{OrderSn: change.Order.OrderSn})
 	if err != nil {
-		return errors.WithCode(code.ErrDatabase, err.Error())
+		log.Errorf("订单%s确认冻结库存失败: %v", change.Order.OrderSn, err)
+		return err
+	}
+	return nil
+}
+
+// recordPayment 第一次进入已支付状态时记录支付时间和渠道交易号
+func (os *orderService) recordPayment(ctx context.Context, tx *gorm.DB, change *StatusChange) error {
+	if paid(change.From) {
+		return nil
 	}
-	if row == 0 {
-		return errors.WithCode(code2.ErrOrderStatus, "<UNK>")
+	payTime := time.Now()
+	if change.PayTime != nil {
+		payTime = *change.PayTime
 	}
+	return os.data.NewDB().Orders().UpdatePayment(ctx, tx, change.Order.OrderSn, change.TradeNo, payTime)
+}
 
-	return nil
+func paid(status string) bool {
+	return status == do.OrderStatusTradeSuccess || status == do.OrderStatusTradeFinished
+}
+
+// unpaid 等待用户付款的状态
+func unpaid(status string) bool {
+	return status == do.OrderStatusPaying || status == do.OrderStatusWaitBuyerPay
 }
 
 func newOrderService(sv *service) *orderService {
-	return &orderService{
-		data:    sv.data,
-		dtmOpts: sv.dtmopts,
-		MqOpts:  sv.MqOpts,
+	os := &orderService{
+		data:      sv.data,
+		dtmOpts:   sv.dtmopts,
+		MqOpts:    sv.MqOpts,
+		delayOpts: sv.delay,
+		reconcile: sv.reconcile,
+		events:    outbox.NewWriter(sv.outbox),
+		machine:   NewStateMachine(),
+		pricing:   &pricing{data: sv.data, opts: sv.pricing},
+		carts:     NewCartService(sv),
+	}
+	for _, s := range []string{do.OrderStatusTradeSuccess, do.OrderStatusTradeFinished} {
+		os.machine.Guard(s, os.confirmSell)
+		os.machine.OnEnter(s, os.recordPayment)
+		os.machine.OnEnter(s, os.paidEvent)
+	}
+	for _, s := range []string{do.OrderStatusCancelled, do.OrderStatusClosed} {
+		os.machine.OnEnter(s, os.closedEvent)
+	}
+	for _, s := range []string{do.OrderStatusTradeSuccess, do.OrderStatusTradeFinished, do.OrderStatusTradeClosed,
+		do.OrderStatusCancelled, do.OrderStatusClosed} {
+		os.machine.OnEnter(s, os.syncSubOrders)
 	}
+	return os
 }
 
 var _ OrderSrv = &orderService{}
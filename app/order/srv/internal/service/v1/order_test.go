@@ -0,0 +1,88 @@
+package service
+
+import (
+	proto2 "Advanced_Shop/api/inventory/v1"
+	v12 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+// flakyInventory ConfirmSell 前 fail 次调用失败
+type flakyInventory struct {
+	proto2.InventoryClient
+	fail  int
+	calls []string
+}
+
+func (c *flakyInventory) ConfirmSell(ctx context.Context, in *proto2.SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.calls = append(c.calls, in.OrderSn)
+	if len(c.calls) <= c.fail {
+		return nil, errors.New("inventory unavailable")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+type inventoryDB struct {
+	v12.DBFactory
+	inv *flakyInventory
+}
+
+func (db *inventoryDB) Inventorys() proto2.InventoryClient {
+	return db.inv
+}
+
+type inventoryData struct {
+	v12.DataFactory
+	db *inventoryDB
+}
+
+func (d *inventoryData) NewDB() v12.DBFactory {
+	return d.db
+}
+
+func TestConfirmSellRetry(t *testing.T) {
+	inv := &flakyInventory{fail: 1}
+	os := &orderService{
+		data:    &inventoryData{db: &inventoryDB{inv: inv}},
+		dtmOpts: &options.DtmOptions{Mode: options.DtmModeTcc},
+		machine: NewStateMachine(),
+	}
+	os.machine.Guard(do.OrderStatusTradeSuccess, os.confirmSell)
+
+	order := &dto.OrderInfoResponse{OrderInfoDO: do.OrderInfoDO{OrderSn: "sn1"}}
+	change := func() *StatusChange {
+		return &StatusChange{Order: order, From: do.OrderStatusWaitBuyerPay, To: do.OrderStatusTradeSuccess}
+	}
+
+	// 确认失败时拒绝变更，订单保持待支付，支付通知按失败处理等待渠道重发
+	if err := os.machine.Check(context.Background(), change()); err == nil {
+		t.Fatal("want error when ConfirmSell fails")
+	}
+	// 重发的通知再次进入已支付状态时重新确认
+	if err := os.machine.Check(context.Background(), change()); err != nil {
+		t.Fatal(err)
+	}
+	if len(inv.calls) != 2 {
+		t.Fatalf("ConfirmSell calls = %v, want 2", inv.calls)
+	}
+
+	// 子订单和已支付订单的后续变更不再确认
+	sub := &dto.OrderInfoResponse{OrderInfoDO: do.OrderInfoDO{OrderSn: "sn1-1", Parent: 1}}
+	for _, c := range []*StatusChange{
+		{Order: sub, From: do.OrderStatusWaitBuyerPay, To: do.OrderStatusTradeSuccess},
+		{Order: order, From: do.OrderStatusTradeSuccess, To: do.OrderStatusTradeFinished},
+	} {
+		if err := os.confirmSell(context.Background(), c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(inv.calls) != 2 {
+		t.Fatalf("ConfirmSell calls = %v, want 2", inv.calls)
+	}
+}
@@ -0,0 +1,390 @@
+package service
+
+import (
+	apb "Advanced_Shop/api/action/v1"
+	gpb "Advanced_Shop/api/goods/v1"
+	proto "Advanced_Shop/api/order/v1"
+	v12 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"time"
+)
+
+// 计价流程，金额全部以分为单位的整数计算：
+// 1. 商品单价由元换算为分，商品总额 = 单价 * 数量
+// 2. 分类折扣按商品逐件打折
+// 3. 优惠券作用在折后金额上，减免金额按商品折后金额比例分摊到每件商品，退款按分摊后的金额退
+// 4. 订单中全是包邮商品，或者折后金额满足满额包邮规则时免运费，否则收取配置的运费
+
+// PriceLine 参与计价的一件商品
+type PriceLine struct {
+	Goods     int32
+	Sku       int32  // 规格ID，没有规格的商品为 0
+	SkuSpecs  string // 规格描述
+	Category  int32
+	Parents   []int32 // 促销限定的分类中包含该商品分类的上级分类
+	Name      string
+	Image     string
+	Price     int64 // 单价（分）
+	Nums      int32
+	ShipFree  bool
+	Merchant  int32 // 所属商家，下单时按商家拆分子订单
+	Warehouse int32 // 库存服务预选的发货仓库，0 为不指定，下单时按仓库拆分子订单
+	Discount  int64 // 分摊到这件商品的优惠（分）
+}
+
+func (l *PriceLine) Subtotal() int64 {
+	return l.Price * int64(l.Nums)
+}
+
+// Payable 扣除已分摊优惠后的金额
+func (l *PriceLine) Payable() int64 {
+	return l.Subtotal() - l.Discount
+}
+
+// Item 转换为订单商品明细
+func (l *PriceLine) Item() *proto.OrderItemResponse {
+	return &proto.OrderItemResponse{
+		GoodsId:    l.Goods,
+		SkuId:      l.Sku,
+		SkuSpecs:   l.SkuSpecs,
+		GoodsName:  l.Name,
+		GoodsImage: l.Image,
+		GoodsPrice: do.Yuan(l.Price),
+		Nums:       l.Nums,
+		Price:      l.Price,
+		PayAmount:  l.Payable(),
+	}
+}
+
+// PriceResult 计价结果，即订单的价格明细
+type PriceResult struct {
+	Lines          []*PriceLine
+	GoodsAmount    int64
+	DiscountAmount int64
+	ShippingFee    int64
+	PayAmount      int64
+	CouponCode     string
+	UserCoupon     bool // 使用的是券包中的优惠券，下单时需要核销
+	Adjustments    do.PriceAdjustmentList
+}
+
+// Breakdown 转换为接口中的价格明细
+func (r *PriceResult) Breakdown() *proto.PriceBreakdown {
+	ret := &proto.PriceBreakdown{
+		GoodsAmount:    r.GoodsAmount,
+		DiscountAmount: r.DiscountAmount,
+		ShippingFee:    r.ShippingFee,
+		PayAmount:      r.PayAmount,
+		CouponCode:     r.CouponCode,
+	}
+	for _, adj := range r.Adjustments {
+		ret.Adjustments = append(ret.Adjustments, &proto.PriceAdjustment{Type: adj.Type, Name: adj.Name, Amount: adj.Amount})
+	}
+	return ret
+}
+
+// discount 记录一项优惠并按比例分摊到 lines
+func (r *PriceResult) discount(promotion *do.PromotionDO, lines []*PriceLine, amount int64) {
+	if amount <= 0 {
+		return
+	}
+	allocate(lines, amount)
+	r.DiscountAmount += amount
+	r.Adjustments = append(r.Adjustments, do.PriceAdjustment{Type: promotion.Type, Name: promotion.Name, Amount: amount})
+}
+
+// PriceRule 计价规则，按顺序作用在同一个计价结果上
+type PriceRule interface {
+	Apply(result *PriceResult) error
+}
+
+// categoryDiscount 分类折扣，每件商品按自己的折后金额打折
+type categoryDiscount struct {
+	promotion *do.PromotionDO
+}
+
+func (r *categoryDiscount) Apply(result *PriceResult) error {
+	off := 100 - clampPercent(r.promotion.Percent)
+	var total int64
+	for _, line := range matchCategory(result.Lines, r.promotion.Category) {
+		amount := line.Payable() * int64(off) / 100
+		line.Discount += amount
+		total += amount
+	}
+	if total > 0 {
+		result.DiscountAmount += total
+		result.Adjustments = append(result.Adjustments, do.PriceAdjustment{
+			Type: r.promotion.Type, Name: r.promotion.Name, Amount: total,
+		})
+	}
+	return nil
+}
+
+// coupon 立减券、折扣券和满减券，限定分类时只作用在该分类的商品上
+type coupon struct {
+	promotion *do.PromotionDO
+}
+
+func (r *coupon) Apply(result *PriceResult) error {
+	p := r.promotion
+	lines := matchCategory(result.Lines, p.Category)
+	var base int64
+	for _, line := range lines {
+		base += line.Payable()
+	}
+	if base <= 0 {
+		return errors.WithCode(code2.ErrCouponNotApplicable, "订单中没有优惠券%s可用的商品", p.Code)
+	}
+
+	var amount int64
+	switch p.Type {
+	case do.PromotionCouponFixed:
+		amount = p.Amount
+	case do.PromotionCouponPercent:
+		amount = base * int64(100-clampPercent(p.Percent)) / 100
+	case do.PromotionCouponThreshold:
+		if base < p.Threshold {
+			return errors.WithCode(code2.ErrCouponNotApplicable, "优惠券%s需满%.2f元可用，当前%.2f元",
+				p.Code, do.Yuan(p.Threshold), do.Yuan(base))
+		}
+		amount = p.Amount
+	}
+	// 优惠不能超过可优惠的金额
+	if amount > base {
+		amount = base
+	}
+	result.CouponCode = p.Code
+	result.discount(p, lines, amount)
+	return nil
+}
+
+// shipping 运费，全部商品包邮或满足任一满额包邮规则时免运费
+type shipping struct {
+	fee      int64
+	freeRule []*do.PromotionDO
+}
+
+func (r *shipping) Apply(result *PriceResult) error {
+	result.ShippingFee = 0
+	var charged bool
+	for _, line := range result.Lines {
+		if !line.ShipFree {
+			charged = true
+			break
+		}
+	}
+	if !charged || r.fee == 0 {
+		return nil
+	}
+	for _, rule := range r.freeRule {
+		var base int64
+		for _, line := range matchCategory(result.Lines, rule.Category) {
+			base += line.Payable()
+		}
+		if base > 0 && base >= rule.Threshold {
+			result.Adjustments = append(result.Adjustments, do.PriceAdjustment{Type: rule.Type, Name: rule.Name, Amount: r.fee})
+			return nil
+		}
+	}
+	result.ShippingFee = r.fee
+	return nil
+}
+
+// Price 按规则顺序计价
+func Price(lines []*PriceLine, rules ...PriceRule) (*PriceResult, error) {
+	result := &PriceResult{Lines: lines}
+	for _, line := range lines {
+		result.GoodsAmount += line.Subtotal()
+	}
+	for _, rule := range rules {
+		if err := rule.Apply(result); err != nil {
+			return nil, err
+		}
+	}
+	result.PayAmount = result.GoodsAmount - result.DiscountAmount + result.ShippingFee
+	return result, nil
+}
+
+// pricing 从促销规则表加载当前生效的规则，按 分类折扣 -> 优惠券 -> 运费 的顺序计价
+type pricing struct {
+	data v12.DataFactory
+	opts *options.PricingOptions
+}
+
+func (p *pricing) Price(ctx context.Context, userID int32, lines []*PriceLine, couponCode string) (*PriceResult, error) {
+	now := time.Now()
+	promotions, err := p.data.NewDB().Promotions().Active(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []PriceRule
+	ship := &shipping{fee: p.opts.ShippingFee}
+	categories := map[int32]bool{}
+	for _, promotion := range promotions {
+		switch promotion.Type {
+		case do.PromotionCategoryDiscount:
+			rules = append(rules, &categoryDiscount{promotion: promotion})
+		case do.PromotionFreeShipping:
+			ship.freeRule = append(ship.freeRule, promotion)
+		default:
+			continue
+		}
+		categories[promotion.Category] = true
+	}
+	var userCoupon bool
+	if couponCode != "" {
+		promotion, err := p.userCoupon(ctx, userID, couponCode)
+		if err == nil {
+			userCoupon = true
+		} else if errors.IsCode(err, code2.ErrUserCouponNotFound) {
+			// 券包中没有这张券，按促销规则表中的公共券码处理
+			promotion, err = p.data.NewDB().Promotions().GetByCode(ctx, couponCode, now)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &coupon{promotion: promotion})
+		categories[promotion.Category] = true
+	}
+	rules = append(rules, ship)
+	if err := p.resolveParents(ctx, lines, categories); err != nil {
+		return nil, err
+	}
+	result, err := Price(lines, rules...)
+	if err != nil {
+		return nil, err
+	}
+	result.UserCoupon = userCoupon
+	return result, nil
+}
+
+// 券包优惠券类型和促销规则类型的对应关系
+var userCouponTypes = map[string]string{
+	"fixed":     do.PromotionCouponFixed,
+	"percent":   do.PromotionCouponPercent,
+	"threshold": do.PromotionCouponThreshold,
+}
+
+// userCoupon 从营销服务查询用户券包中的优惠券，转换为优惠券规则
+func (p *pricing) userCoupon(ctx context.Context, userID int32, code string) (*do.PromotionDO, error) {
+	rsp, err := p.data.NewDB().Coupons().GetUserCoupon(ctx, &apb.UserCouponRequest{UserId: userID, Code: code})
+	if err != nil {
+		return nil, errors.FromGrpcError(err)
+	}
+	if rsp.Status != "UNUSED" || rsp.Template == nil {
+		return nil, errors.WithCode(code2.ErrCouponNotApplicable, "优惠券%s已使用或已过期", code)
+	}
+	typ, ok := userCouponTypes[rsp.Template.Type]
+	if !ok {
+		return nil, errors.WithCode(code2.ErrCouponNotApplicable, "不支持的优惠券类型%s", rsp.Template.Type)
+	}
+	return &do.PromotionDO{
+		Name:      rsp.Template.Name,
+		Type:      typ,
+		Code:      rsp.Code,
+		Category:  rsp.Template.Category,
+		Threshold: rsp.Template.Threshold,
+		Amount:    rsp.Template.Amount,
+		Percent:   rsp.Template.Percent,
+	}, nil
+}
+
+// resolveParents 查询促销限定分类下的所有子分类，把命中的上级分类记到商品的 Parents 中，
+// 这样限定在一级、二级分类上的促销也作用在其下三级分类的商品上
+func (p *pricing) resolveParents(ctx context.Context, lines []*PriceLine, categories map[int32]bool) error {
+	for _, line := range lines {
+		line.Parents = nil
+	}
+	for category := range categories {
+		if category == 0 {
+			continue
+		}
+		rsp, err := p.data.NewDB().Goods().GetSubCategory(ctx, &gpb.CategoryListRequest{Id: category})
+		if err != nil {
+			log.Errorf("查询分类%d的子分类失败: %v", category, err)
+			return errors.FromGrpcError(err)
+		}
+		children := map[int32]bool{}
+		collectCategories(rsp.SubCategorys, children)
+		for _, line := range lines {
+			if children[line.Category] {
+				line.Parents = append(line.Parents, category)
+			}
+		}
+	}
+	return nil
+}
+
+// collectCategories 递归收集分类树中的所有分类ID
+func collectCategories(categories []*gpb.CategoryInfoResponse, ids map[int32]bool) {
+	for _, c := range categories {
+		ids[c.Id] = true
+		collectCategories(c.SubCategorys, ids)
+	}
+}
+
+// matchCategory 筛选属于 category 的商品，包括 category 的子分类下的商品，category 为 0 时不限分类
+func matchCategory(lines []*PriceLine, category int32) []*PriceLine {
+	if category == 0 {
+		return lines
+	}
+	var ret []*PriceLine
+	for _, line := range lines {
+		if line.Category == category {
+			ret = append(ret, line)
+			continue
+		}
+		for _, parent := range line.Parents {
+			if parent == category {
+				ret = append(ret, line)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// allocate 按折后金额比例把 amount 分摊到各件商品，除不尽的零头逐分补给还有余额的商品
+func allocate(lines []*PriceLine, amount int64) {
+	var base int64
+	for _, line := range lines {
+		base += line.Payable()
+	}
+	if base <= 0 {
+		return
+	}
+	shares := make([]int64, len(lines))
+	remain := amount
+	for i, line := range lines {
+		shares[i] = amount * line.Payable() / base
+		remain -= shares[i]
+	}
+	for remain > 0 {
+		for i, line := range lines {
+			if remain == 0 {
+				break
+			}
+			if line.Payable()-shares[i] > 0 {
+				shares[i]++
+				remain--
+			}
+		}
+	}
+	for i, line := range lines {
+		line.Discount += shares[i]
+	}
+}
+
+// clampPercent 折后百分比，未配置时视为不打折
+func clampPercent(percent int32) int32 {
+	if percent <= 0 || percent > 100 {
+		return 100
+	}
+	return percent
+}
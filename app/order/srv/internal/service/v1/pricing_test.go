@@ -0,0 +1,187 @@
+package service
+
+import (
+	gpb "Advanced_Shop/api/goods/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"testing"
+)
+
+func TestPrice(t *testing.T) {
+	discount := func(category, percent int32) PriceRule {
+		return &categoryDiscount{promotion: &do.PromotionDO{Type: do.PromotionCategoryDiscount, Category: category, Percent: percent}}
+	}
+	couponRule := func(typ string, category int32, threshold, amount int64, percent int32) PriceRule {
+		return &coupon{promotion: &do.PromotionDO{Type: typ, Code: "C1", Category: category, Threshold: threshold, Amount: amount, Percent: percent}}
+	}
+	ship := func(threshold ...int64) PriceRule {
+		r := &shipping{fee: 800}
+		for _, th := range threshold {
+			r.freeRule = append(r.freeRule, &do.PromotionDO{Type: do.PromotionFreeShipping, Threshold: th})
+		}
+		return r
+	}
+
+	tests := []struct {
+		name         string
+		lines        []*PriceLine
+		rules        []PriceRule
+		wantDiscount int64
+		wantShipping int64
+		wantPay      int64
+		wantCode     int
+	}{
+		{
+			name: "分类折扣只作用在该分类的商品上",
+			lines: []*PriceLine{
+				{Goods: 1, Category: 1, Price: 10000, Nums: 1},
+				{Goods: 2, Category: 2, Price: 5000, Nums: 1},
+			},
+			rules:        []PriceRule{discount(1, 80)},
+			wantDiscount: 2000,
+			wantPay:      13000,
+		},
+		{
+			name:         "子分类的商品参与上级分类的折扣",
+			lines:        []*PriceLine{{Goods: 1, Category: 11, Parents: []int32{1}, Price: 10000, Nums: 1}},
+			rules:        []PriceRule{discount(1, 80)},
+			wantDiscount: 2000,
+			wantPay:      8000,
+		},
+		{
+			name:         "未配置折扣比例时不打折",
+			lines:        []*PriceLine{{Goods: 1, Category: 1, Price: 10000, Nums: 1}},
+			rules:        []PriceRule{discount(1, 0)},
+			wantDiscount: 0,
+			wantPay:      10000,
+		},
+		{
+			name:         "立减券超过可优惠金额时封顶",
+			lines:        []*PriceLine{{Goods: 1, Price: 1000, Nums: 1}},
+			rules:        []PriceRule{couponRule(do.PromotionCouponFixed, 0, 0, 5000, 0)},
+			wantDiscount: 1000,
+			wantPay:      0,
+		},
+		{
+			name:         "折扣券按可优惠金额打折",
+			lines:        []*PriceLine{{Goods: 1, Price: 5000, Nums: 2}},
+			rules:        []PriceRule{couponRule(do.PromotionCouponPercent, 0, 0, 0, 90)},
+			wantDiscount: 1000,
+			wantPay:      9000,
+		},
+		{
+			name:     "满减券未达门槛不可用",
+			lines:    []*PriceLine{{Goods: 1, Price: 5000, Nums: 1}},
+			rules:    []PriceRule{couponRule(do.PromotionCouponThreshold, 0, 10000, 1000, 0)},
+			wantCode: code2.ErrCouponNotApplicable,
+		},
+		{
+			name:     "订单中没有优惠券限定分类的商品",
+			lines:    []*PriceLine{{Goods: 1, Category: 1, Price: 5000, Nums: 1}},
+			rules:    []PriceRule{couponRule(do.PromotionCouponFixed, 3, 0, 1000, 0)},
+			wantCode: code2.ErrCouponNotApplicable,
+		},
+		{
+			name:     "满减门槛按分类折扣后的金额判断",
+			lines:    []*PriceLine{{Goods: 1, Category: 1, Price: 12000, Nums: 1}},
+			rules:    []PriceRule{discount(1, 80), couponRule(do.PromotionCouponThreshold, 0, 10000, 1000, 0)},
+			wantCode: code2.ErrCouponNotApplicable,
+		},
+		{
+			name:         "先分类折扣再用满减券",
+			lines:        []*PriceLine{{Goods: 1, Category: 1, Price: 20000, Nums: 1}},
+			rules:        []PriceRule{discount(1, 90), couponRule(do.PromotionCouponThreshold, 0, 10000, 3000, 0), ship()},
+			wantDiscount: 5000,
+			wantShipping: 800,
+			wantPay:      15800,
+		},
+		{
+			name:         "没有包邮规则时收取运费",
+			lines:        []*PriceLine{{Goods: 1, Price: 1000, Nums: 1}},
+			rules:        []PriceRule{ship()},
+			wantShipping: 800,
+			wantPay:      1800,
+		},
+		{
+			name: "全部是包邮商品时免运费",
+			lines: []*PriceLine{
+				{Goods: 1, Price: 1000, Nums: 1, ShipFree: true},
+				{Goods: 2, Price: 1000, Nums: 1, ShipFree: true},
+			},
+			rules:   []PriceRule{ship()},
+			wantPay: 2000,
+		},
+		{
+			name: "部分商品包邮时仍收取运费",
+			lines: []*PriceLine{
+				{Goods: 1, Price: 1000, Nums: 1, ShipFree: true},
+				{Goods: 2, Price: 1000, Nums: 1},
+			},
+			rules:        []PriceRule{ship()},
+			wantShipping: 800,
+			wantPay:      2800,
+		},
+		{
+			name:    "满额包邮",
+			lines:   []*PriceLine{{Goods: 1, Price: 10000, Nums: 1}},
+			rules:   []PriceRule{ship(9900)},
+			wantPay: 10000,
+		},
+		{
+			name:         "满额包邮按折后金额判断",
+			lines:        []*PriceLine{{Goods: 1, Category: 1, Price: 10000, Nums: 1}},
+			rules:        []PriceRule{discount(1, 90), ship(9900)},
+			wantDiscount: 1000,
+			wantShipping: 800,
+			wantPay:      9800,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Price(tt.lines, tt.rules...)
+			if tt.wantCode != 0 {
+				if !errors.IsCode(err, tt.wantCode) {
+					t.Fatalf("err = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.DiscountAmount != tt.wantDiscount {
+				t.Errorf("discount = %d, want %d", result.DiscountAmount, tt.wantDiscount)
+			}
+			if result.ShippingFee != tt.wantShipping {
+				t.Errorf("shipping = %d, want %d", result.ShippingFee, tt.wantShipping)
+			}
+			if result.PayAmount != tt.wantPay {
+				t.Errorf("pay = %d, want %d", result.PayAmount, tt.wantPay)
+			}
+			var payable int64
+			for _, line := range result.Lines {
+				payable += line.Payable()
+			}
+			if payable != result.GoodsAmount-result.DiscountAmount {
+				t.Errorf("lines payable = %d, want %d", payable, result.GoodsAmount-result.DiscountAmount)
+			}
+		})
+	}
+}
+
+func TestCollectCategories(t *testing.T) {
+	tree := []*gpb.CategoryInfoResponse{
+		{Id: 11, SubCategorys: []*gpb.CategoryInfoResponse{{Id: 111}, {Id: 112}}},
+		{Id: 12},
+	}
+	ids := map[int32]bool{}
+	collectCategories(tree, ids)
+	for _, id := range []int32{11, 111, 112, 12} {
+		if !ids[id] {
+			t.Fatalf("category %d not collected: %v", id, ids)
+		}
+	}
+	if len(ids) != 4 {
+		t.Fatalf("ids = %v, want 4 categories", ids)
+	}
+}
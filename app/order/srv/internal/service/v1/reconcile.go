@@ -0,0 +1,284 @@
+package service
+
+import (
+	v12 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/app/pkg/payment"
+	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"time"
+)
+
+// 支付对账，弥补丢失的支付通知：
+// 1. 超时关闭之前先向渠道查询交易，见 Timeout
+// 2. 后台定时查询即将超时的待支付订单，已支付的补记交易号、支付时间和状态
+// 3. 每天导入前一天的对账单，渠道与订单不一致的记录到 payment_mismatches 表
+
+// 对账单中的订单按批查询
+const statementBatchSize = 500
+
+type PaymentSrv interface {
+	// Reconcile 主动查询即将超时的待支付订单，返回补记支付的订单数
+	Reconcile(ctx context.Context) (int, error)
+	// ImportStatement 导入 payType 渠道 date 当天的对账单，返回差异数
+	ImportStatement(ctx context.Context, payType string, date time.Time) (int, error)
+	// ImportStatements 导入所有已开启渠道 date 当天的对账单
+	ImportStatements(ctx context.Context, date time.Time) error
+
+	// Notify 处理网关验签后的支付通知，按支付方式和交易号幂等
+	Notify(ctx context.Context, notification *do.PaymentNotificationDO) (*do.PaymentNotificationDO, error)
+	// Notifications 订单收到的支付通知
+	Notifications(ctx context.Context, orderID int32, meta v1.ListMeta) (*do.PaymentNotificationDOList, error)
+	// Replay 重新处理保存的支付通知，跳过去重
+	Replay(ctx context.Context, ID int32) (*do.PaymentNotificationDO, error)
+}
+
+type paymentService struct {
+	data      v12.DataFactory
+	orders    *orderService
+	delayOpts *options.DelayQueueOptions
+	opts      *options.ReconcileOptions
+}
+
+func newPaymentService(sv *service) *paymentService {
+	return &paymentService{
+		data:      sv.data,
+		orders:    newOrderService(sv),
+		delayOpts: sv.delay,
+		opts:      sv.reconcile,
+	}
+}
+
+// syncTrade 向渠道查询订单的交易，已支付时按渠道的交易补记支付，返回订单是否已支付
+func (os *orderService) syncTrade(ctx context.Context, order *do.OrderInfoDO) (bool, error) {
+	provider, err := os.data.Payment(order.PayType)
+	if err != nil {
+		if errors.IsCode(err, code2.ErrPayTypeUnsupported) {
+			// 本服务没有开启这个支付渠道，用户也无法通过这个渠道付款
+			return false, nil
+		}
+		return false, err
+	}
+	trade, err := provider.QueryTrade(ctx, order.OrderSn)
+	if err != nil {
+		return false, err
+	}
+	if !paid(trade.Status) {
+		return false, nil
+	}
+	if trade.Amount > 0 && trade.Amount != order.Payable() {
+		return false, errors.WithCode(code2.ErrPayment, "订单%s渠道支付金额%d与应付金额%d不一致", order.OrderSn, trade.Amount, order.Payable())
+	}
+	err = os.transitChange(ctx, nil, order.OrderSn, &StatusChange{
+		To:       trade.Status,
+		Operator: do.OperatorReconcile,
+		Reason:   "主动查询交易补记支付",
+		TradeNo:  trade.TradeNo,
+		PayTime:  trade.PayTime,
+	})
+	if err != nil {
+		return false, err
+	}
+	log.Warnf("订单%s的支付通知丢失，已按渠道交易%s补记支付", order.OrderSn, trade.TradeNo)
+	return true, nil
+}
+
+func (ps *paymentService) Reconcile(ctx context.Context) (int, error) {
+	now := time.Now()
+	// 超时消息没有及时处理的订单也一起查询，最多回看一天
+	before := now.Add(ps.opts.Ahead - ps.delayOpts.OrderTimeout)
+	after := now.Add(-ps.delayOpts.OrderTimeout - 24*time.Hour)
+	orders, err := ps.data.NewDB().Orders().ListPending(ctx, after, before, ps.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, order := range orders {
+		ok, err := ps.orders.syncTrade(ctx, order)
+		if err != nil {
+			log.Errorf("订单%s主动查询交易失败: %v", order.OrderSn, err)
+			continue
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (ps *paymentService) ImportStatement(ctx context.Context, payType string, date time.Time) (int, error) {
+	provider, err := ps.data.Payment(payType)
+	if err != nil {
+		return 0, err
+	}
+	trades, err := provider.Statement(ctx, date)
+	if err != nil {
+		return 0, err
+	}
+	billDate := date.Format("2006-01-02")
+
+	orders := make(map[string]*do.OrderInfoDO, len(trades))
+	for i := 0; i < len(trades); i += statementBatchSize {
+		var orderSns []string
+		for _, trade := range trades[i:min(i+statementBatchSize, len(trades))] {
+			orderSns = append(orderSns, trade.OrderSn)
+		}
+		list, err := ps.data.NewDB().Orders().ListByOrderSns(ctx, orderSns)
+		if err != nil {
+			return 0, err
+		}
+		for _, order := range list {
+			orders[order.OrderSn] = order
+		}
+	}
+
+	var mismatches []*do.PaymentMismatchDO
+	seen := make(map[string]bool, len(trades))
+	for _, trade := range trades {
+		seen[trade.OrderSn] = true
+		mismatch := &do.PaymentMismatchDO{
+			PayType:     payType,
+			BillDate:    billDate,
+			OrderSn:     trade.OrderSn,
+			TradeNo:     trade.TradeNo,
+			TradeAmount: trade.Amount,
+			Status:      do.MismatchStatusOpen,
+		}
+		order, ok := orders[trade.OrderSn]
+		if !ok {
+			mismatch.Type = do.MismatchOrderMissing
+			mismatches = append(mismatches, mismatch)
+			continue
+		}
+		mismatch.OrderAmount = order.Payable()
+		mismatch.OrderStatus = order.Status
+		if trade.Amount != order.Payable() {
+			mismatch.Type = do.MismatchAmount
+			mismatches = append(mismatches, mismatch)
+			continue
+		}
+		if order.PayTime != nil {
+			continue
+		}
+		mismatch.Type = do.MismatchOrderUnpaid
+		if !unpaid(order.Status) {
+			// 订单已经关闭，库存和优惠券都已归还，只能给用户退款
+			mismatch.Remark = "订单已关闭，需要给用户退款"
+		} else if err := ps.statementPaid(ctx, order, trade); err != nil {
+			log.Errorf("订单%s按对账单补记支付失败: %v", order.OrderSn, err)
+			mismatch.Remark = "按对账单补记支付失败"
+		} else {
+			mismatch.Status = do.MismatchStatusResolved
+			mismatch.Remark = "已按对账单补记支付"
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+
+	// 订单当天已支付，对账单中却没有这笔交易
+	payTypes := []string{payType}
+	if payType == payment.PayTypeAlipay {
+		// 引入多渠道之前的订单没有支付方式
+		payTypes = append(payTypes, "")
+	}
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	paidOrders, err := ps.data.NewDB().Orders().ListPaid(ctx, payTypes, start, start.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+	for _, order := range paidOrders {
+		if seen[order.OrderSn] {
+			continue
+		}
+		mismatches = append(mismatches, &do.PaymentMismatchDO{
+			PayType:     payType,
+			BillDate:    billDate,
+			OrderSn:     order.OrderSn,
+			Type:        do.MismatchTradeMissing,
+			TradeNo:     order.TradeNo,
+			OrderAmount: order.Payable(),
+			OrderStatus: order.Status,
+			Status:      do.MismatchStatusOpen,
+		})
+	}
+
+	if err := ps.data.NewDB().PaymentMismatches().Save(ctx, mismatches); err != nil {
+		return 0, err
+	}
+	for _, m := range mismatches {
+		log.Warnf("%s对账单%s差异: 订单%s %s，渠道金额%d，订单金额%d，订单状态%s",
+			payType, billDate, m.OrderSn, m.Type, m.TradeAmount, m.OrderAmount, m.OrderStatus)
+	}
+	log.Infof("%s对账单%s导入完成，交易%d笔，差异%d笔", payType, billDate, len(trades), len(mismatches))
+	return len(mismatches), nil
+}
+
+// statementPaid 对账单中已支付的待支付订单，按对账单补记支付
+func (ps *paymentService) statementPaid(ctx context.Context, order *do.OrderInfoDO, trade *payment.Trade) error {
+	return ps.orders.transitChange(ctx, nil, order.OrderSn, &StatusChange{
+		To:       do.OrderStatusTradeSuccess,
+		Operator: do.OperatorReconcile,
+		Reason:   "对账单补记支付",
+		TradeNo:  trade.TradeNo,
+		PayTime:  trade.PayTime,
+	})
+}
+
+func (ps *paymentService) ImportStatements(ctx context.Context, date time.Time) error {
+	var lastErr error
+	for _, payType := range ps.data.PayTypes() {
+		if _, err := ps.ImportStatement(ctx, payType, date); err != nil {
+			log.Errorf("导入%s对账单%s失败: %v", payType, date.Format("2006-01-02"), err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// StartReconciler 在后台定时主动查询交易和导入对账单，ctx 结束时停止
+func StartReconciler(ctx context.Context, srv PaymentSrv, opts *options.ReconcileOptions) {
+	if opts.Enable {
+		go func() {
+			ticker := time.NewTicker(opts.Interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if n, err := srv.Reconcile(ctx); err != nil {
+						log.Errorf("主动查询交易失败: %v", err)
+					} else if n > 0 {
+						log.Infof("主动查询交易补记支付%d笔", n)
+					}
+				}
+			}
+		}()
+		log.Info("支付主动查询启动成功")
+	}
+	if opts.StatementEnable {
+		go func() {
+			for {
+				now := time.Now()
+				next := time.Date(now.Year(), now.Month(), now.Day(), opts.StatementHour, 0, 0, 0, time.Local)
+				if !next.After(now) {
+					next = next.AddDate(0, 0, 1)
+				}
+				timer := time.NewTimer(next.Sub(now))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+					_ = srv.ImportStatements(ctx, next.AddDate(0, 0, -1))
+				}
+			}
+		}()
+		log.Info("对账单导入启动成功")
+	}
+}
+
+var _ PaymentSrv = &paymentService{}
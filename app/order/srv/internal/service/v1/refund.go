@@ -0,0 +1,269 @@
+package service
+
+import (
+	proto2 "Advanced_Shop/api/inventory/v1"
+	v12 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/app/pkg/payment"
+	v1 "Advanced_Shop/pkg/common/meta/v1"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"fmt"
+	"github.com/dtm-labs/client/dtmgrpc"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 退款流程：
+// 1. 用户按订单商品明细申请退款，累计申请数量不能超过购买数量，退款单进入 PENDING
+// 2. 管理员拒绝时进入 REJECTED，数量释放出来可以重新申请
+// 3. 管理员通过时先调用支付渠道退款，再通过 DTM 归还退货商品的库存，全部成功后进入 SUCCESS
+// 4. 任何一步失败进入 FAILED，重新通过时退款单号和 gid 不变，支付渠道和库存服务都不会重复处理
+// 5. 订单商品全部退款成功后，订单状态变更为 REFUND_SUCCESS
+// 拆分过的订单按子订单申请退款，支付渠道的退款和库存归还按父订单进行
+
+type RefundSrv interface {
+	// Apply 用户申请退款
+	Apply(ctx context.Context, userID, orderID int32, items []dto.RefundItem, reason string) (*do.RefundDO, error)
+
+	// Approve 审核通过并执行退款，operator 为审核人
+	Approve(ctx context.Context, orderID, refundID int32, operator string) (*do.RefundDO, error)
+
+	// Reject 审核拒绝
+	Reject(ctx context.Context, orderID, refundID int32, remark, operator string) (*do.RefundDO, error)
+
+	// List 查询订单的退款单，userID 为 0 时不限制用户
+	List(ctx context.Context, userID, orderID int32, meta v1.ListMeta) (*do.RefundDOList, error)
+}
+
+type refundService struct {
+	data    v12.DataFactory
+	dtmOpts *options.DtmOptions
+	orders  *orderService
+}
+
+// 除审核拒绝外的退款单都占用可退数量
+var refundHoldingStatus = []string{do.RefundStatusPending, do.RefundStatusRefunding, do.RefundStatusFailed, do.RefundStatusSuccess}
+
+func (rs *refundService) Apply(ctx context.Context, userID, orderID int32, items []dto.RefundItem, reason string) (*do.RefundDO, error) {
+	order, err := rs.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{UserID: userID, OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	if !do.RefundableStatus(order.Status) {
+		return nil, errors.WithCode(code2.ErrRefundStatus, "订单%s状态为%s，不能申请退款", order.OrderSn, order.Status)
+	}
+	if order.Split {
+		return nil, errors.WithCode(code2.ErrRefundStatus, "订单%s已按商家拆分，请按子订单申请退款", order.OrderSn)
+	}
+	if len(items) == 0 {
+		return nil, errors.WithCode(code2.ErrInvalidParameter, "没有选择退款商品")
+	}
+
+	lines := make(map[int32]*do.OrderGoodsModel, len(order.OrderGoods))
+	for _, line := range order.OrderGoods {
+		lines[line.ID] = line
+	}
+	nums := make(map[int32]int32, len(items))
+	for _, item := range items {
+		if _, ok := lines[item.OrderGoods]; !ok || item.Nums <= 0 {
+			return nil, errors.WithCode(code2.ErrInvalidParameter, "订单%s没有商品明细%d或数量不合法", order.OrderSn, item.OrderGoods)
+		}
+		nums[item.OrderGoods] += item.Nums
+	}
+
+	refund := &do.RefundDO{
+		RefundSn: fmt.Sprintf("R%d%d", time.Now().UnixNano(), userID),
+		OrderSn:  order.OrderSn,
+		Order:    order.ID,
+		User:     order.User,
+		Status:   do.RefundStatusPending,
+		Reason:   reason,
+	}
+	err = rs.data.NewDB().DB().Transaction(func(tx *gorm.DB) error {
+		// 锁住订单，同一订单的退款申请串行校验可退数量
+		if err := rs.data.NewDB().Orders().Lock(ctx, tx, order.OrderSn); err != nil {
+			return err
+		}
+		refunded, err := rs.data.NewDB().Refunds().RefundedNums(ctx, tx, order.OrderSn, refundHoldingStatus)
+		if err != nil {
+			return err
+		}
+		for _, line := range order.OrderGoods {
+			n, ok := nums[line.ID]
+			if !ok {
+				continue
+			}
+			if refunded[line.ID]+n > line.Nums {
+				return errors.WithCode(code2.ErrRefundExceeded, "商品%s最多还能退%d件", line.GoodsName, line.Nums-refunded[line.ID])
+			}
+			amount := refundAmount(line, refunded[line.ID], n)
+			refund.Amount += amount
+			refund.Goods = append(refund.Goods, &do.RefundGoodsDO{
+				OrderGoods: line.ID,
+				Goods:      line.Goods,
+				Sku:        line.Sku,
+				GoodsName:  line.GoodsName,
+				Nums:       n,
+				Amount:     amount,
+			})
+		}
+		return rs.data.NewDB().Refunds().Create(ctx, tx, refund)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("订单%s申请退款%s，金额%d分", order.OrderSn, refund.RefundSn, refund.Amount)
+	return refund, nil
+}
+
+func (rs *refundService) Approve(ctx context.Context, orderID, refundID int32, operator string) (*do.RefundDO, error) {
+	refund, err := rs.data.NewDB().Refunds().Get(ctx, nil, refundID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := rs.data.NewDB().Refunds().UpdateStatusFrom(ctx, nil, refund.ID,
+		[]string{do.RefundStatusPending, do.RefundStatusFailed}, do.RefundStatusRefunding, "")
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, errors.WithCode(code2.ErrRefundStatus, "退款单%s状态为%s，不能审核通过", refund.RefundSn, refund.Status)
+	}
+	log.Infof("退款单%s由%s审核通过", refund.RefundSn, operator)
+
+	if err := rs.refund(ctx, refund); err != nil {
+		log.Errorf("退款单%s退款失败: %v", refund.RefundSn, err)
+		remark := err.Error()
+		if len(remark) > 200 {
+			remark = remark[:200]
+		}
+		if _, uerr := rs.data.NewDB().Refunds().UpdateStatusFrom(ctx, nil, refund.ID,
+			[]string{do.RefundStatusRefunding}, do.RefundStatusFailed, remark); uerr != nil {
+			log.Errorf("退款单%s标记失败出错: %v", refund.RefundSn, uerr)
+		}
+		return nil, err
+	}
+
+	err = rs.data.NewDB().DB().Transaction(func(tx *gorm.DB) error {
+		if err := rs.data.NewDB().Orders().Lock(ctx, tx, refund.OrderSn); err != nil {
+			return err
+		}
+		if _, err := rs.data.NewDB().Refunds().UpdateStatusFrom(ctx, tx, refund.ID,
+			[]string{do.RefundStatusRefunding}, do.RefundStatusSuccess, ""); err != nil {
+			return err
+		}
+		refunded, err := rs.data.NewDB().Refunds().RefundedNums(ctx, tx, refund.OrderSn, []string{do.RefundStatusSuccess})
+		if err != nil {
+			return err
+		}
+		order, err := rs.data.NewDB().Orders().GetWithTx(ctx, tx, refund.OrderSn)
+		if err != nil {
+			return err
+		}
+		for _, line := range order.OrderGoods {
+			if refunded[line.ID] < line.Nums {
+				return nil
+			}
+		}
+		return rs.orders.transit(ctx, tx, refund.OrderSn, do.OrderStatusRefundSuccess, operator, "全部商品已退款")
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("退款单%s退款成功", refund.RefundSn)
+	return rs.data.NewDB().Refunds().Get(ctx, nil, refund.ID, 0)
+}
+
+// refund 先按订单的支付方式退钱再归还库存，库存归还失败时退款单停在 FAILED，重新审核通过会重试 Saga
+func (rs *refundService) refund(ctx context.Context, refund *do.RefundDO) error {
+	order, err := rs.data.NewDB().Orders().GetByOrderSn(ctx, refund.OrderSn)
+	if err != nil {
+		return err
+	}
+	total := order.Payable()
+	if order.Parent != 0 {
+		// 子订单的钱是随父订单一起付的，按父订单的交易退款
+		parent, err := rs.data.NewDB().Orders().GetByOrderSn(ctx, order.ParentSn)
+		if err != nil {
+			return err
+		}
+		total = parent.Payable()
+	}
+	provider, err := rs.data.Payment(order.PayType)
+	if err != nil {
+		return err
+	}
+	err = provider.Refund(ctx, &payment.RefundRequest{
+		OrderSn:  order.PaySn(),
+		RefundSn: refund.RefundSn,
+		Amount:   refund.Amount,
+		Total:    total,
+		Reason:   refund.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 库存扣减记录在父订单上
+	sellInfo := &proto2.SellInfo{OrderSn: order.PaySn()}
+	for _, goods := range refund.Goods {
+		sellInfo.GoodsInfo = append(sellInfo.GoodsInfo, &proto2.GoodsInvInfo{GoodsId: goods.Goods, SkuId: goods.Sku, Num: goods.Nums})
+	}
+	qsBusi := "discovery:///xshop-inventory-srv"
+	saga := dtmgrpc.NewSagaGrpc(rs.dtmOpts.GrpcServer, "refund_"+refund.RefundSn).
+		Add(qsBusi+"/Inventory/ReturnGoods", "", sellInfo)
+	saga.WaitResult = true
+	return saga.Submit()
+}
+
+// refundAmount 按分摊优惠后的实付金额退款，已退 done 件时再退 n 件
+// 按累计数量计算差额，多次部分退款的合计等于整行实付金额，不会因为取整多退或少退，单位为分
+func refundAmount(line *do.OrderGoodsModel, done, n int32) int64 {
+	if line.Price == 0 {
+		// 计价上线之前的订单按单价退
+		return do.Cents(line.GoodsPrice) * int64(n)
+	}
+	before := line.PayAmount * int64(done) / int64(line.Nums)
+	after := line.PayAmount * int64(done+n) / int64(line.Nums)
+	return after - before
+}
+
+func (rs *refundService) Reject(ctx context.Context, orderID, refundID int32, remark, operator string) (*do.RefundDO, error) {
+	refund, err := rs.data.NewDB().Refunds().Get(ctx, nil, refundID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := rs.data.NewDB().Refunds().UpdateStatusFrom(ctx, nil, refund.ID,
+		[]string{do.RefundStatusPending}, do.RefundStatusRejected, remark)
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, errors.WithCode(code2.ErrRefundStatus, "退款单%s状态为%s，不能拒绝", refund.RefundSn, refund.Status)
+	}
+	log.Infof("退款单%s由%s审核拒绝: %s", refund.RefundSn, operator, remark)
+	return rs.data.NewDB().Refunds().Get(ctx, nil, refund.ID, 0)
+}
+
+func (rs *refundService) List(ctx context.Context, userID, orderID int32, meta v1.ListMeta) (*do.RefundDOList, error) {
+	order, err := rs.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{UserID: userID, OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	return rs.data.NewDB().Refunds().List(ctx, order.OrderSn, meta)
+}
+
+func newRefundService(sv *service) *refundService {
+	return &refundService{
+		data:    sv.data,
+		dtmOpts: sv.dtmopts,
+		orders:  newOrderService(sv),
+	}
+}
+
+var _ RefundSrv = &refundService{}
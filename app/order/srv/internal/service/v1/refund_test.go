@@ -0,0 +1,51 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"testing"
+)
+
+func TestRefundAmount(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  do.OrderGoodsModel
+		parts []int32 // 依次退款的件数
+		want  []int64 // 分
+	}{
+		{
+			name:  "整行一次退完",
+			line:  do.OrderGoodsModel{Nums: 2, Price: 1000, PayAmount: 1800},
+			parts: []int32{2},
+			want:  []int64{1800},
+		},
+		{
+			name:  "分摊后不能整除，多次部分退款合计等于实付",
+			line:  do.OrderGoodsModel{Nums: 3, Price: 1000, PayAmount: 1000},
+			parts: []int32{1, 1, 1},
+			want:  []int64{333, 333, 334},
+		},
+		{
+			name:  "大额订单按分计算不丢精度",
+			line:  do.OrderGoodsModel{Nums: 3, Price: 41152263, PayAmount: 123456789},
+			parts: []int32{1, 2},
+			want:  []int64{41152263, 82304526},
+		},
+		{
+			name:  "计价上线之前的订单按单价退",
+			line:  do.OrderGoodsModel{Nums: 3, GoodsPrice: 12.5},
+			parts: []int32{2},
+			want:  []int64{2500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var done int32
+			for i, n := range tt.parts {
+				if got := refundAmount(&tt.line, done, n); got != tt.want[i] {
+					t.Fatalf("第%d次退%d件 = %v, want %v", i+1, n, got, tt.want[i])
+				}
+				done += n
+			}
+		})
+	}
+}
@@ -8,24 +8,54 @@ import (
 type ServiceFactory interface {
 	Orders() OrderSrv
 	Cart() CartSrv
+	// GuestCart 游客购物车，未开启时为 nil
+	GuestCart() CartSrv
+	Refunds() RefundSrv
+	Payments() PaymentSrv
+	Shipments() ShipmentSrv
 }
 
 type service struct {
-	data    v1.DataFactory
-	dtmopts *options.DtmOptions
-	MqOpts  *options.RocketMQOptions
+	data      v1.DataFactory
+	dtmopts   *options.DtmOptions
+	MqOpts    *options.RocketMQOptions
+	pricing   *options.PricingOptions
+	delay     *options.DelayQueueOptions
+	outbox    *options.OutboxOptions
+	reconcile *options.ReconcileOptions
+	shipping  *options.ShippingOptions
+	cart      *options.CartOptions
 }
 
 func (s *service) Cart() CartSrv {
 	return NewCartService(s)
 }
 
+func (s *service) GuestCart() CartSrv {
+	return newGuestCartService(s)
+}
+
 func (s *service) Orders() OrderSrv {
 	return newOrderService(s)
 }
 
+func (s *service) Refunds() RefundSrv {
+	return newRefundService(s)
+}
+
+func (s *service) Payments() PaymentSrv {
+	return newPaymentService(s)
+}
+
+func (s *service) Shipments() ShipmentSrv {
+	return newShipmentService(s)
+}
+
 var _ ServiceFactory = &service{}
 
-func NewService(data v1.DataFactory, dtmopts *options.DtmOptions, mqOpts *options.RocketMQOptions) ServiceFactory {
-	return &service{data: data, dtmopts: dtmopts, MqOpts: mqOpts}
+func NewService(data v1.DataFactory, dtmopts *options.DtmOptions, mqOpts *options.RocketMQOptions,
+	pricing *options.PricingOptions, delay *options.DelayQueueOptions, outbox *options.OutboxOptions,
+	reconcile *options.ReconcileOptions, shipping *options.ShippingOptions, cart *options.CartOptions) ServiceFactory {
+	return &service{data: data, dtmopts: dtmopts, MqOpts: mqOpts, pricing: pricing, delay: delay, outbox: outbox,
+		reconcile: reconcile, shipping: shipping, cart: cart}
 }
@@ -0,0 +1,205 @@
+package service
+
+import (
+	v12 "Advanced_Shop/app/order/srv/internal/data/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 发货和物流跟踪：
+// 1. 管理员发货时记录快递公司和运单号，订单变为已发货
+// 2. 后台定时向物流渠道查询轨迹，保存新的节点
+// 3. 物流签收或发货后超过配置的时间仍未确认收货的订单，自动确认收货
+
+type ShipmentSrv interface {
+	// Ship 发货，operator 为发货人
+	Ship(ctx context.Context, orderID int32, carrier, trackingNo, operator string) (*do.ShipmentDO, error)
+
+	// Get 查询订单的运单和物流轨迹，userID 为 0 时不限制用户
+	Get(ctx context.Context, userID, orderID int32) (*do.ShipmentDO, error)
+
+	// Sync 查询到期运单的物流轨迹，返回查询的运单数
+	Sync(ctx context.Context) (int, error)
+
+	// AutoReceive 自动确认收货，返回确认收货的订单数
+	AutoReceive(ctx context.Context) (int, error)
+}
+
+type shipmentService struct {
+	data   v12.DataFactory
+	orders *orderService
+	opts   *options.ShippingOptions
+}
+
+func newShipmentService(sv *service) *shipmentService {
+	return &shipmentService{
+		data:   sv.data,
+		orders: newOrderService(sv),
+		opts:   sv.shipping,
+	}
+}
+
+func (ss *shipmentService) Ship(ctx context.Context, orderID int32, carrier, trackingNo, operator string) (*do.ShipmentDO, error) {
+	order, err := ss.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	if order.Split {
+		return nil, errors.WithCode(code2.ErrOrderCannotShip, "订单%s已按商家拆分，请按子订单发货", order.OrderSn)
+	}
+	if !do.CanTransit(order.Status, do.OrderStatusShipped) {
+		return nil, errors.WithCode(code2.ErrOrderCannotShip, "订单%s当前状态%s不能发货", order.OrderSn, order.Status)
+	}
+	if _, err := ss.data.NewDB().Shipments().GetByOrderSn(ctx, order.OrderSn); err == nil {
+		return nil, errors.WithCode(code2.ErrOrderCannotShip, "订单%s已经发货", order.OrderSn)
+	} else if !errors.IsCode(err, code2.ErrShipmentNotFound) {
+		return nil, err
+	}
+
+	shipment := &do.ShipmentDO{
+		OrderSn:    order.OrderSn,
+		Order:      order.ID,
+		User:       order.User,
+		Carrier:    carrier,
+		TrackingNo: trackingNo,
+		Status:     do.ShipmentStatusShipped,
+		Operator:   operator,
+		ShippedAt:  time.Now(),
+	}
+	// 运单和订单状态在同一个事务里，订单号上的唯一索引挡住并发的重复发货
+	err = ss.data.NewDB().DB().Transaction(func(tx *gorm.DB) error {
+		if err := ss.data.NewDB().Shipments().Create(ctx, tx, shipment); err != nil {
+			return err
+		}
+		return ss.orders.transit(ctx, tx, order.OrderSn, do.OrderStatusShipped, operator, carrier+" "+trackingNo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+func (ss *shipmentService) Get(ctx context.Context, userID, orderID int32) (*do.ShipmentDO, error) {
+	order, err := ss.data.NewDB().Orders().Get(ctx, dto.OrderDetailRequest{UserID: userID, OrderID: orderID})
+	if err != nil {
+		return nil, err
+	}
+	return ss.data.NewDB().Shipments().GetByOrderSn(ctx, order.OrderSn)
+}
+
+func (ss *shipmentService) Sync(ctx context.Context) (int, error) {
+	// 没有配置物流渠道时不查询轨迹，只按发货时间自动确认收货
+	if ss.data.Carrier() == nil {
+		return 0, nil
+	}
+	shipments, err := ss.data.NewDB().Shipments().ListToSync(ctx, time.Now().Add(-ss.opts.SyncInterval), ss.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, shipment := range shipments {
+		if err := ss.sync(ctx, shipment); err != nil {
+			// 单个运单查询失败不影响其他运单，下次轮询重试
+			log.Errorf("查询订单%s的物流轨迹失败: %v", shipment.OrderSn, err)
+		}
+	}
+	return len(shipments), nil
+}
+
+// sync 查询一个运单的轨迹，只保存比上次最新节点更晚的节点，运单状态取最新节点的状态
+func (ss *shipmentService) sync(ctx context.Context, shipment *do.ShipmentDO) error {
+	events, err := ss.data.Carrier().Track(ctx, shipment.Carrier, shipment.TrackingNo)
+	if err != nil {
+		return err
+	}
+	var models []*do.ShipmentEventDO
+	for _, event := range events {
+		if shipment.LastEventAt != nil && !event.Time.After(*shipment.LastEventAt) {
+			continue
+		}
+		models = append(models, &do.ShipmentEventDO{
+			Shipment:    shipment.ID,
+			EventTime:   event.Time,
+			Status:      event.Status,
+			Location:    event.Location,
+			Description: event.Description,
+		})
+		eventTime := event.Time
+		shipment.LastEventAt = &eventTime
+		shipment.Status = event.Status
+		if event.Status == do.ShipmentStatusDelivered && shipment.DeliveredAt == nil {
+			shipment.DeliveredAt = &eventTime
+		}
+	}
+	now := time.Now()
+	shipment.SyncedAt = &now
+	return ss.data.NewDB().Shipments().Sync(ctx, shipment, models)
+}
+
+func (ss *shipmentService) AutoReceive(ctx context.Context) (int, error) {
+	now := time.Now()
+	shipments, err := ss.data.NewDB().Shipments().ListToReceive(ctx,
+		now.Add(-ss.opts.AutoReceiveAfter), now.Add(-ss.opts.DeliveredReceiveAfter), ss.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	received := 0
+	for _, shipment := range shipments {
+		reason := "发货超时自动确认收货"
+		if shipment.DeliveredAt != nil {
+			reason = "物流签收后自动确认收货"
+		}
+		err := ss.data.NewDB().DB().Transaction(func(tx *gorm.DB) error {
+			if err := ss.data.NewDB().Shipments().UpdateStatus(ctx, tx, shipment.ID, do.ShipmentStatusReceived); err != nil {
+				return err
+			}
+			return ss.orders.transit(ctx, tx, shipment.OrderSn, do.OrderStatusReceived, do.OperatorReceipt, reason)
+		})
+		if errors.IsCode(err, code2.ErrOrderTransition) {
+			// 订单已经退款等，不能再确认收货，运单不再跟踪
+			log.Warnf("订单%s不能自动确认收货: %v", shipment.OrderSn, err)
+			err = ss.data.NewDB().Shipments().UpdateStatus(ctx, nil, shipment.ID, do.ShipmentStatusClosed)
+		} else if err == nil {
+			received++
+		}
+		if err != nil {
+			log.Errorf("订单%s自动确认收货失败: %v", shipment.OrderSn, err)
+		}
+	}
+	return received, nil
+}
+
+// StartShipmentTracker 在后台定时查询物流轨迹并自动确认收货，ctx 结束时停止
+func StartShipmentTracker(ctx context.Context, srv ShipmentSrv, opts *options.ShippingOptions) {
+	if !opts.TrackEnable {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(opts.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := srv.Sync(ctx); err != nil {
+					log.Errorf("查询物流轨迹失败: %v", err)
+				}
+				if n, err := srv.AutoReceive(ctx); err != nil {
+					log.Errorf("自动确认收货失败: %v", err)
+				} else if n > 0 {
+					log.Infof("自动确认收货%d笔", n)
+				}
+			}
+		}
+	}()
+	log.Info("物流跟踪启动成功")
+}
+
+var _ ShipmentSrv = &shipmentService{}
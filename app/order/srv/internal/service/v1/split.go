@@ -0,0 +1,173 @@
+package service
+
+import (
+	proto2 "Advanced_Shop/api/inventory/v1"
+	proto "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"fmt"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+// 拆单：购物车中的商品属于多个商家或者从多个仓库发货时，按商家和仓库拆分出子订单
+// 1. 父订单负责支付，用户按父订单付款；支付成功、超时关闭和整单取消都作用在父订单上，再同步到子订单
+// 2. 子订单负责履约，各自发货、确认收货和退款；已付款未发货的子订单可以单独申请取消，按子订单的实付金额退款
+// 3. 商品明细、库存扣减记录和优惠券仍然挂在父订单上，商品明细通过 SubOrder 归属子订单
+// 4. 下单前由库存服务为每件商品预选一个能整单发出的仓库，扣减时只从这个仓库扣；没有单个仓库够发的商品不指定仓库，
+//    扣减时再拆到多个仓库，这些商品归入同一商家下仓库为 0 的子订单
+
+// splitKey 子订单按商家和发货仓库划分
+type splitKey struct {
+	merchant  int32
+	warehouse int32
+}
+
+// planWarehouse 为每件商品预选发货仓库，预选失败时不指定仓库，下单仍按原来的方式在扣减时选仓
+func (os *orderService) planWarehouse(ctx context.Context, province string, lines []*PriceLine) {
+	req := &proto2.SellInfo{Province: province}
+	for _, line := range lines {
+		req.GoodsInfo = append(req.GoodsInfo, &proto2.GoodsInvInfo{GoodsId: line.Goods, SkuId: line.Sku, Num: line.Nums})
+	}
+	planned, err := os.data.NewDB().Inventorys().PlanWarehouse(ctx, req)
+	if err != nil {
+		log.Errorf("预选发货仓库失败，不按仓库拆单: %v", err)
+		return
+	}
+	warehouses := make(map[[2]int32]int32, len(planned.GoodsInfo))
+	for _, info := range planned.GoodsInfo {
+		warehouses[[2]int32{info.GoodsId, info.SkuId}] = info.WarehouseId
+	}
+	for _, line := range lines {
+		line.Warehouse = warehouses[[2]int32{line.Goods, line.Sku}]
+	}
+}
+
+// splitOrder 按商家和发货仓库拆分计价结果，只有一组时不拆单
+// 子订单的商品金额和优惠取自商品行，运费按子订单的折后金额比例分摊，最后一个子订单取余数，子订单应付金额之和等于父订单
+func splitOrder(result *PriceResult) []*proto.SubOrderRequest {
+	groups := make(map[splitKey][]*PriceLine)
+	var keys []splitKey
+	for _, line := range result.Lines {
+		key := splitKey{merchant: line.Merchant, warehouse: line.Warehouse}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], line)
+	}
+	if len(keys) < 2 {
+		return nil
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].merchant != keys[j].merchant {
+			return keys[i].merchant < keys[j].merchant
+		}
+		return keys[i].warehouse < keys[j].warehouse
+	})
+
+	var base int64
+	for _, line := range result.Lines {
+		base += line.Payable()
+	}
+	prefix := time.Now().UnixNano()
+	remain := result.ShippingFee
+	subOrders := make([]*proto.SubOrderRequest, 0, len(keys))
+	for i, key := range keys {
+		price := &proto.PriceBreakdown{}
+		sub := &proto.SubOrderRequest{
+			OrderSn:     fmt.Sprintf("S%d%02d", prefix, i+1),
+			MerchantId:  key.merchant,
+			WarehouseId: key.warehouse,
+			Price:       price,
+		}
+		for _, line := range groups[key] {
+			sub.GoodsId = append(sub.GoodsId, line.Goods)
+			sub.SkuId = append(sub.SkuId, line.Sku)
+			price.GoodsAmount += line.Subtotal()
+			price.DiscountAmount += line.Discount
+		}
+		if i == len(keys)-1 {
+			price.ShippingFee = remain
+		} else if base > 0 {
+			price.ShippingFee = result.ShippingFee * (price.GoodsAmount - price.DiscountAmount) / base
+		}
+		remain -= price.ShippingFee
+		price.PayAmount = price.GoodsAmount - price.DiscountAmount + price.ShippingFee
+		subOrders = append(subOrders, sub)
+	}
+	return subOrders
+}
+
+// syncSubOrders 父订单支付成功或关闭时，子订单跟随变更；已经发货或退款的子订单不再跟随
+func (os *orderService) syncSubOrders(ctx context.Context, tx *gorm.DB, change *StatusChange) error {
+	if !change.Order.Split {
+		return nil
+	}
+	subOrders, err := os.data.NewDB().Orders().ListSubOrders(ctx, tx, change.Order.ID)
+	if err != nil {
+		return err
+	}
+	payTime := change.PayTime
+	if payTime == nil && paid(change.To) {
+		now := time.Now()
+		payTime = &now
+	}
+	for _, sub := range subOrders {
+		if !unpaid(sub.Status) && !paid(sub.Status) || !do.CanTransit(sub.Status, change.To) {
+			continue
+		}
+		err := os.transitChange(ctx, tx, sub.OrderSn, &StatusChange{
+			To:       change.To,
+			Operator: change.Operator,
+			Reason:   "父订单" + change.Order.OrderSn + "状态变更",
+			TradeNo:  change.TradeNo,
+			PayTime:  payTime,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelSubOrder 单独取消已付款未发货的子订单，为子订单中还没有退款的全部商品申请退款
+// 退款单与普通售后一样等待商家审核，审核通过后退款并归还库存
+// 付款之前子订单与其他子订单共用一笔支付，只能取消整个父订单
+func (os *orderService) cancelSubOrder(ctx context.Context, userID int32, order *dto.OrderInfoResponse) error {
+	if unpaid(order.Status) {
+		return errors.WithCode(code2.ErrOrderCannotCancel, "子订单%s与其他子订单共用一笔支付，付款前只能取消父订单%s", order.OrderSn, order.ParentSn)
+	}
+	if order.Status != do.OrderStatusTradeSuccess {
+		return errors.WithCode(code2.ErrOrderCannotCancel, "子订单%s状态为%s，不能取消", order.OrderSn, order.Status)
+	}
+	refunded, err := os.data.NewDB().Refunds().RefundedNums(ctx, nil, order.OrderSn, refundHoldingStatus)
+	if err != nil {
+		return err
+	}
+	var items []dto.RefundItem
+	for _, line := range order.OrderGoods {
+		if n := line.Nums - refunded[line.ID]; n > 0 {
+			items = append(items, dto.RefundItem{OrderGoods: line.ID, Nums: n})
+		}
+	}
+	if len(items) == 0 {
+		return errors.WithCode(code2.ErrOrderCannotCancel, "子订单%s的商品已经全部申请退款", order.OrderSn)
+	}
+
+	refunds := &refundService{data: os.data, dtmOpts: os.dtmOpts, orders: os}
+	refund, err := refunds.Apply(ctx, userID, order.ID, items, "用户取消子订单")
+	if err != nil {
+		return err
+	}
+	log.Infof("子订单%s申请取消，退款单%s等待审核", order.OrderSn, refund.RefundSn)
+	return nil
+}
+
+func (os *orderService) SubOrders(ctx context.Context, parentID int32) ([]*do.OrderInfoDO, error) {
+	return os.data.NewDB().Orders().ListSubOrders(ctx, nil, parentID)
+}
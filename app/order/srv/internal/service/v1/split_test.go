@@ -0,0 +1,180 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"reflect"
+	"testing"
+)
+
+func TestSplitOrder(t *testing.T) {
+	tests := []struct {
+		name        string
+		lines       []*PriceLine
+		shippingFee int64
+		want        []splitKey
+		wantGoods   [][]int32
+		wantSkus    [][]int32
+		wantFees    []int64
+	}{
+		{
+			name: "同一商家同一仓库不拆单",
+			lines: []*PriceLine{
+				{Goods: 1, Price: 1000, Nums: 1, Merchant: 1, Warehouse: 2},
+				{Goods: 2, Price: 500, Nums: 2, Merchant: 1, Warehouse: 2},
+			},
+			shippingFee: 800,
+		},
+		{
+			name: "按商家拆单，运费按折后金额分摊",
+			lines: []*PriceLine{
+				{Goods: 1, Price: 3000, Nums: 1, Merchant: 2, Discount: 1000},
+				{Goods: 2, Price: 1000, Nums: 1, Merchant: 1},
+			},
+			shippingFee: 1000,
+			want:        []splitKey{{merchant: 1}, {merchant: 2}},
+			wantGoods:   [][]int32{{2}, {1}},
+			wantSkus:    [][]int32{{0}, {0}},
+			wantFees:    []int64{333, 667},
+		},
+		{
+			name: "同一商家按仓库拆单，同一商品的不同规格可以分到不同子订单",
+			lines: []*PriceLine{
+				{Goods: 1, Sku: 11, Price: 1000, Nums: 1, Merchant: 1, Warehouse: 3},
+				{Goods: 1, Sku: 12, Price: 1000, Nums: 1, Merchant: 1, Warehouse: 2},
+				{Goods: 2, Price: 1000, Nums: 1, Merchant: 1},
+			},
+			shippingFee: 1000,
+			want:        []splitKey{{merchant: 1}, {merchant: 1, warehouse: 2}, {merchant: 1, warehouse: 3}},
+			wantGoods:   [][]int32{{2}, {1}, {1}},
+			wantSkus:    [][]int32{{0}, {12}, {11}},
+			wantFees:    []int64{333, 333, 334},
+		},
+		{
+			name: "全部优惠后金额为 0 时运费由最后一个子订单承担",
+			lines: []*PriceLine{
+				{Goods: 1, Price: 1000, Nums: 1, Merchant: 1, Discount: 1000},
+				{Goods: 2, Price: 1000, Nums: 1, Merchant: 2, Discount: 1000},
+			},
+			shippingFee: 600,
+			want:        []splitKey{{merchant: 1}, {merchant: 2}},
+			wantGoods:   [][]int32{{1}, {2}},
+			wantSkus:    [][]int32{{0}, {0}},
+			wantFees:    []int64{0, 600},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Price(tt.lines, &fixedShipping{fee: tt.shippingFee})
+			if err != nil {
+				t.Fatal(err)
+			}
+			subOrders := splitOrder(result)
+			if len(subOrders) != len(tt.want) {
+				t.Fatalf("sub orders = %d, want %d", len(subOrders), len(tt.want))
+			}
+			var pay, goods, discount, fee int64
+			for i, sub := range subOrders {
+				if got := (splitKey{merchant: sub.MerchantId, warehouse: sub.WarehouseId}); got != tt.want[i] {
+					t.Errorf("sub[%d] key = %+v, want %+v", i, got, tt.want[i])
+				}
+				if !reflect.DeepEqual(sub.GoodsId, tt.wantGoods[i]) || !reflect.DeepEqual(sub.SkuId, tt.wantSkus[i]) {
+					t.Errorf("sub[%d] goods = %v skus = %v, want %v %v", i, sub.GoodsId, sub.SkuId, tt.wantGoods[i], tt.wantSkus[i])
+				}
+				price := sub.Price
+				if price.ShippingFee != tt.wantFees[i] {
+					t.Errorf("sub[%d] shipping fee = %d, want %d", i, price.ShippingFee, tt.wantFees[i])
+				}
+				if price.PayAmount != price.GoodsAmount-price.DiscountAmount+price.ShippingFee {
+					t.Errorf("sub[%d] pay amount = %d, breakdown %+v", i, price.PayAmount, price)
+				}
+				pay += price.PayAmount
+				goods += price.GoodsAmount
+				discount += price.DiscountAmount
+				fee += price.ShippingFee
+			}
+			if len(subOrders) > 0 && (pay != result.PayAmount || goods != result.GoodsAmount ||
+				discount != result.DiscountAmount || fee != result.ShippingFee) {
+				t.Fatalf("子订单合计 pay=%d goods=%d discount=%d fee=%d，父订单 %+v", pay, goods, discount, fee, result)
+			}
+		})
+	}
+}
+
+// fixedShipping 测试用的运费规则，Discount 已经写在商品行上，这里把它计入订单优惠
+type fixedShipping struct {
+	fee int64
+}
+
+func (r *fixedShipping) Apply(result *PriceResult) error {
+	for _, line := range result.Lines {
+		result.DiscountAmount += line.Discount
+	}
+	result.ShippingFee = r.fee
+	return nil
+}
+
+func TestAllocateDiscount(t *testing.T) {
+	tests := []struct {
+		name   string
+		lines  []*PriceLine
+		amount int64
+		want   []int64
+	}{
+		{
+			name:   "按折后金额比例分摊",
+			lines:  []*PriceLine{{Price: 3000, Nums: 1}, {Price: 1000, Nums: 1}},
+			amount: 400,
+			want:   []int64{300, 100},
+		},
+		{
+			name:   "除不尽的零头逐分补给前面的商品",
+			lines:  []*PriceLine{{Price: 100, Nums: 1}, {Price: 100, Nums: 1}, {Price: 100, Nums: 1}},
+			amount: 100,
+			want:   []int64{34, 33, 33},
+		},
+		{
+			name:   "零头不补给已经优惠到 0 的商品",
+			lines:  []*PriceLine{{Price: 100, Nums: 1, Discount: 100}, {Price: 100, Nums: 1}, {Price: 100, Nums: 1}},
+			amount: 101,
+			want:   []int64{100, 51, 50},
+		},
+		{
+			name:   "没有可分摊的金额",
+			lines:  []*PriceLine{{Price: 100, Nums: 1, Discount: 100}},
+			amount: 50,
+			want:   []int64{100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocate(tt.lines, tt.amount)
+			for i, line := range tt.lines {
+				if line.Discount != tt.want[i] {
+					t.Errorf("lines[%d].Discount = %d, want %d", i, line.Discount, tt.want[i])
+				}
+				if line.Payable() < 0 {
+					t.Errorf("lines[%d] 优惠后金额为负数: %d", i, line.Payable())
+				}
+			}
+		})
+	}
+}
+
+func TestCouponProration(t *testing.T) {
+	lines := []*PriceLine{
+		{Goods: 1, Price: 999, Nums: 1, Merchant: 1},
+		{Goods: 2, Price: 1001, Nums: 2, Merchant: 2},
+	}
+	promotion := &do.PromotionDO{Code: "C1", Type: do.PromotionCouponFixed, Amount: 500}
+	result, err := Price(lines, &coupon{promotion: promotion})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var discount int64
+	for _, line := range result.Lines {
+		discount += line.Discount
+	}
+	if discount != 500 || result.DiscountAmount != 500 || result.PayAmount != 999+2002-500 {
+		t.Fatalf("分摊合计 %d，订单优惠 %d，应付 %d", discount, result.DiscountAmount, result.PayAmount)
+	}
+}
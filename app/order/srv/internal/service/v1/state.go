@@ -0,0 +1,130 @@
+package service
+
+import (
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/order/srv/internal/domain/dto"
+	code2 "Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+// StatusChange 一次订单状态变更
+type StatusChange struct {
+	Order    *dto.OrderInfoResponse // 变更前的订单
+	From     string
+	To       string
+	Operator string
+	Reason   string
+	TradeNo  string     // 支付渠道交易号，进入已支付状态时记录
+	PayTime  *time.Time // 支付完成时间，为空时取当前时间
+}
+
+// Guard 在状态更新之前执行，返回错误时拒绝这次变更，不在数据库事务里，可以调用其他服务
+type Guard func(ctx context.Context, change *StatusChange) error
+
+// Hook 在状态更新的同一个事务里执行，返回错误时整个变更回滚
+type Hook func(ctx context.Context, tx *gorm.DB, change *StatusChange) error
+
+// StateMachine 允许的流转由 do.CanTransit 决定，这里按目标状态挂载守卫和钩子
+type StateMachine struct {
+	guards map[string][]Guard
+	hooks  map[string][]Hook
+}
+
+func NewStateMachine() *StateMachine {
+	return &StateMachine{
+		guards: make(map[string][]Guard),
+		hooks:  make(map[string][]Hook),
+	}
+}
+
+// Guard 进入 to 状态之前执行的检查
+func (m *StateMachine) Guard(to string, guard Guard) {
+	m.guards[to] = append(m.guards[to], guard)
+}
+
+// OnEnter 进入 to 状态时执行的钩子
+func (m *StateMachine) OnEnter(to string, hook Hook) {
+	m.hooks[to] = append(m.hooks[to], hook)
+}
+
+// Check 检查流转是否允许并执行守卫
+func (m *StateMachine) Check(ctx context.Context, change *StatusChange) error {
+	if !do.CanTransit(change.From, change.To) {
+		return errors.WithCode(code2.ErrOrderTransition, "订单%s不能从%s变更为%s", change.Order.OrderSn, change.From, change.To)
+	}
+	for _, guard := range m.guards[change.To] {
+		if err := guard(ctx, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Enter 执行进入目标状态的钩子
+func (m *StateMachine) Enter(ctx context.Context, tx *gorm.DB, change *StatusChange) error {
+	for _, hook := range m.hooks[change.To] {
+		if err := hook(ctx, tx, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transit 按状态机变更订单状态并记录变更历史，txn 为空时自己开启事务
+// 订单已经处于目标状态时直接返回，支付回调和 DTM 重试都依赖这里的幂等
+func (os *orderService) transit(ctx context.Context, txn *gorm.DB, orderSn, to, operator, reason string) error {
+	return os.transitChange(ctx, txn, orderSn, &StatusChange{To: to, Operator: operator, Reason: reason})
+}
+
+// transitChange 与 transit 相同，change 中的 Order 和 From 由这里填充
+func (os *orderService) transitChange(ctx context.Context, txn *gorm.DB, orderSn string, change *StatusChange) error {
+	order, err := os.data.NewDB().Orders().GetWithTx(ctx, txn, orderSn)
+	if err != nil {
+		return err
+	}
+	to, operator, reason := change.To, change.Operator, change.Reason
+	if order.Status == to {
+		return nil
+	}
+
+	change.Order, change.From = order, order.Status
+	if err := os.machine.Check(ctx, change); err != nil {
+		return err
+	}
+
+	apply := func(tx *gorm.DB) error {
+		// 只在状态没被并发修改时更新，否则本次变更失败，由调用方决定是否重试
+		rows, err := os.data.NewDB().Orders().UpdateStatusFrom(ctx, tx, orderSn, []string{change.From}, to)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return errors.WithCode(code2.ErrOrderTransition, "订单%s状态已被修改，不能从%s变更为%s", orderSn, change.From, to)
+		}
+		err = os.data.NewDB().StatusHistories().Append(ctx, tx, &do.OrderStatusHistoryDO{
+			OrderSn:    orderSn,
+			FromStatus: change.From,
+			ToStatus:   to,
+			Operator:   operator,
+			Reason:     reason,
+		})
+		if err != nil {
+			return err
+		}
+		return os.machine.Enter(ctx, tx, change)
+	}
+	if txn != nil {
+		err = apply(txn)
+	} else {
+		err = os.data.NewDB().DB().Transaction(apply)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("订单%s状态%s -> %s，操作方%s", orderSn, change.From, to, operator)
+	return nil
+}
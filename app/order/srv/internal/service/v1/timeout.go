@@ -0,0 +1,126 @@
+package service
+
+import (
+	apb "Advanced_Shop/api/action/v1"
+	proto2 "Advanced_Shop/api/inventory/v1"
+	"Advanced_Shop/app/order/srv/internal/domain/do"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/dtm-labs/client/dtmgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 订单超时关闭流程：
+// 1. 下单的 CreateOrder 分支提交后向延时队列投递订单超时消息
+// 2. 消息到期后先向支付渠道查询交易，用户已经付款但通知丢失时补记支付，不再关闭
+// 3. 然后关闭支付渠道中的交易，外部调用不放在数据库事务中；用户已经付款时关单失败，等消息重新投递
+//    再在事务中把未支付的订单置为已关闭，已支付或已取消的订单直接跳过
+// 4. 事务提交后再归还：RocketMQ 延时队列通过 CrossTopic 通知库存服务归还库存；Redis 延时队列不依赖 RocketMQ，通过 DTM Saga 归还
+// 5. 归还失败时消息稍后重新投递，订单已经是 CLOSED，只重新归还；库存和优惠券都按订单号幂等归还
+
+// Timeout 处理到期的订单超时消息，签名与延时队列的处理函数一致
+func (os *orderService) Timeout(ctx context.Context, body []byte) error {
+	var orderInfo do.OrderMQMessageRequest
+	if err := json.Unmarshal(body, &orderInfo); err != nil {
+		// 消息本身有问题，重试也没用
+		log.Errorf("解析订单超时消息体失败, 跳过该消息: %v", err)
+		return nil
+	}
+
+	if order, err := os.data.NewDB().Orders().GetByOrderSn(ctx, orderInfo.OrderSns); err == nil && unpaid(order.Status) {
+		// 开启对账时支付通知可能丢失，关闭之前先向渠道确认用户没有付款，查询失败时等消息重新投递
+		if os.reconcile != nil && os.reconcile.Enable {
+			ok, err := os.syncTrade(ctx, &order.OrderInfoDO)
+			if err != nil {
+				log.Errorf("订单%s超时关闭前查询交易失败: %v", orderInfo.OrderSns, err)
+				return err
+			}
+			if ok {
+				log.Infof("订单%s已经支付，不再超时关闭", orderInfo.OrderSns)
+				return nil
+			}
+		}
+		// 关单之后用户无法再付款，事务失败时消息重新投递，重复关单视为成功
+		if err := os.closeTrade(ctx, &order.OrderInfoDO); err != nil {
+			return err
+		}
+	}
+
+	txn := os.data.NewDB().Begin()
+	number := os.data.NewDB().Orders().TimeoutHandler(ctx, txn, orderInfo.OrderSns)
+	// 0 代表没找到或者不需要关闭 说明没有 这样就不需要管了
+	// 返回1是有错需要重试保存状态失败
+	//返回2 是继续向下走 说明要归还库存
+	if number == do.DirectPass {
+		txn.Rollback()
+		// 上次关闭后归还失败，消息重新投递
+		if order, err := os.data.NewDB().Orders().GetByOrderSn(ctx, orderInfo.OrderSns); err == nil && order.Status == do.OrderStatusClosed {
+			return os.release(ctx, order.OrderSn, order.User, order.CouponCode, body)
+		}
+		log.Warnf("订单%s不需要超时关闭，直接跳过", orderInfo.OrderSns)
+		return nil
+	} else if number == do.OptionFail {
+		txn.Rollback()
+		return fmt.Errorf("订单%s超时关闭失败", orderInfo.OrderSns)
+	}
+
+	order, err := os.data.NewDB().Orders().GetWithTx(ctx, txn, orderInfo.OrderSns)
+	if err != nil {
+		txn.Rollback()
+		return err
+	}
+	if err := os.writeEvent(ctx, txn, do.OrderEventClosed, &order.OrderInfoDO, do.OrderStatusClosed, do.OperatorTimeout); err != nil {
+		txn.Rollback()
+		return err
+	}
+	if err := txn.Commit().Error; err != nil {
+		log.Errorf("提交订单%s超时事务失败: %v", order.OrderSn, err)
+		return err
+	}
+	log.Infof("订单%s超时关闭成功", order.OrderSn)
+	return os.release(ctx, order.OrderSn, order.User, order.CouponCode, body)
+}
+
+// release 归还超时订单占用的库存和优惠券，订单关闭的事务提交之后调用
+func (os *orderService) release(ctx context.Context, orderSn string, userID int32, couponCode string, body []byte) error {
+	saga := dtmgrpc.NewSagaGrpc(os.dtmOpts.GrpcServer, "timeout_"+orderSn)
+	if os.delayOpts.Type == options.DelayQueueRocketMQ {
+		// 库存由库存服务消费 CrossTopic 归还
+		if _, err := os.data.NewMQ().Send(ctx, primitive.NewMessage(os.MqOpts.CrossTopic, body)); err != nil {
+			return err
+		}
+	} else {
+		rebackBranch := "discovery:///xshop-inventory-srv/Inventory/Reback"
+		if os.dtmOpts.Mode == options.DtmModeTcc {
+			// TCC 模式下未支付订单的库存还处于冻结状态
+			rebackBranch = "discovery:///xshop-inventory-srv/Inventory/CancelSell"
+		}
+		saga.Add(rebackBranch, "", &proto2.SellInfo{OrderSn: orderSn})
+	}
+	if couponCode != "" {
+		// 不是券包中的优惠券时营销服务直接跳过
+		saga.Add(actionBusi+"/Coupon/ReleaseCoupon", "", &apb.UserCouponRequest{
+			UserId:  userID,
+			Code:    couponCode,
+			OrderSn: orderSn,
+		})
+	}
+	if len(saga.Steps) == 0 {
+		return nil
+	}
+	if err := saga.Submit(); err != nil {
+		// 同一个 gid 的 Saga 已经结束时 DTM 返回 Aborted，重试也不会变
+		if status.Code(err) == codes.Aborted {
+			log.Warnf("订单%s归还事务已结束，不再提交: %v", orderSn, err)
+			return nil
+		}
+		log.Errorf("订单%s超时归还失败，稍后重试: %v", orderSn, err)
+		return err
+	}
+	return nil
+}
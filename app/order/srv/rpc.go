@@ -6,6 +6,7 @@ import (
 	"Advanced_Shop/app/order/srv/internal/controller/order/v1"
 	db2 "Advanced_Shop/app/order/srv/internal/data/v1/realize"
 	v13 "Advanced_Shop/app/order/srv/internal/service/v1"
+	"Advanced_Shop/app/pkg/outbox"
 	"Advanced_Shop/gnova/core/trace"
 	"Advanced_Shop/gnova/server/rpcserver"
 	"context"
@@ -21,10 +22,25 @@ func NewOrderRPCServer(cfg *config.Config, ctx context.Context) (*rpcserver.Serv
 		cfg.Telemetry.Batcher,
 	})
 
-	dataFactory := db2.NewDataFactory(cfg.MySQLOptions, cfg.Registry, cfg.MQOptions)
-	// 监听延时消息
-	dataFactory.Listen(ctx)
-	orderSrvFactory := v13.NewService(dataFactory, cfg.Dtm, cfg.MQOptions)
+	dataFactory, err := db2.NewDataFactory(cfg.MySQLOptions, cfg.Registry, cfg.MQOptions, cfg.Aliyun, cfg.Payment, cfg.Delay, cfg.Shipping, cfg.Cart)
+	if err != nil {
+		return nil, err
+	}
+	orderSrvFactory := v13.NewService(dataFactory, cfg.Dtm, cfg.MQOptions, cfg.Pricing, cfg.Delay, cfg.Outbox, cfg.Reconcile,
+		cfg.Shipping, cfg.Cart)
+	// 监听订单超时的延时消息
+	dataFactory.DelayQueue().Consume(ctx, orderSrvFactory.Orders().Timeout)
+	// 订单领域事件由发件箱转发到 RocketMQ
+	if cfg.Outbox.Enable {
+		publisher, err := outbox.NewRocketMQPublisher(cfg.MQOptions)
+		if err != nil {
+			return nil, err
+		}
+		outbox.NewRelay(dataFactory.NewDB().DB(), publisher, cfg.Outbox).Start(ctx)
+	}
+	// 主动查询交易和导入对账单，弥补丢失的支付通知
+	v13.StartReconciler(ctx, orderSrvFactory.Payments(), cfg.Reconcile)
+	v13.StartShipmentTracker(ctx, orderSrvFactory.Shipments(), cfg.Shipping)
 	orderServer := order.NewOrderServer(orderSrvFactory)
 	rpcAddr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
 	grpcServer := rpcserver.NewServer(rpcserver.WithAddress(rpcAddr))
@@ -13,4 +13,22 @@ const (
 
 	// ErrMessageCreate - 500: Failed to create Message in Database.
 	ErrMessageCreate
+
+	// ErrCouponTemplateNotFound - 404: Coupon template not found.
+	ErrCouponTemplateNotFound
+
+	// ErrCouponNotClaimable - 400: Coupon template is not claimable now.
+	ErrCouponNotClaimable
+
+	// ErrCouponSoldOut - 400: Coupon template sold out.
+	ErrCouponSoldOut
+
+	// ErrCouponClaimLimit - 400: Coupon claim limit reached.
+	ErrCouponClaimLimit
+
+	// ErrUserCouponNotFound - 404: User coupon not found.
+	ErrUserCouponNotFound
+
+	// ErrCouponUnavailable - 400: Coupon already used or expired.
+	ErrCouponUnavailable
 )
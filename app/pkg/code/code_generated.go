@@ -9,6 +9,12 @@ func init() {
 	register(ErrRecordNotFound, 404, "Record not found")
 	register(ErrMessageQuery, 500, "Failed to query Message from Database")
 	register(ErrMessageCreate, 500, "Failed to create Message in Database")
+	register(ErrCouponTemplateNotFound, 404, "Coupon template not found")
+	register(ErrCouponNotClaimable, 400, "Coupon template is not claimable now")
+	register(ErrCouponSoldOut, 400, "Coupon template sold out")
+	register(ErrCouponClaimLimit, 400, "Coupon claim limit reached")
+	register(ErrUserCouponNotFound, 404, "User coupon not found")
+	register(ErrCouponUnavailable, 400, "Coupon already used or expired")
 	register(ErrGoodsNotFound, 404, "Goods not found")
 	register(ErrCategoryNotFound, 404, "Category not found")
 	register(ErrEsUnmarshal, 500, "Elasticsearch unmarshal error")
@@ -17,10 +23,21 @@ func init() {
 	register(ErrCategoryBrandNotFound, 404, "CategoryBrand not found")
 	register(ErrGoodsImageNotFound, 404, "GoodsImage not found")
 	register(ErrJsonUnmarshal, 500, "JSON unmarshal error")
+	register(ErrSkuNotFound, 404, "Goods SKU not found")
+	register(ErrSpecificationNotFound, 404, "Specification not found")
+	register(ErrSkuSpecInvalid, 400, "SKU specifications do not match the category")
+	register(ErrSkuRequired, 400, "Goods has SKUs, a SKU must be chosen")
+	register(ErrReindexFailed, 500, "Rebuilding the goods index failed")
 	register(ErrInventoryNotFound, 404, "Inventory not found")
 	register(ErrInvSellDetailNotFound, 404, "Inventory sell detail not found")
 	register(ErrInvNotEnough, 400, "Inventory not enough")
 	register(ErrOptimisticRetry, 500, "Optimistic lock retry limit exceeded")
+	register(ErrInvReservationReleased, 400, "Inventory reservation already released")
+	register(ErrWarehouseNotFound, 404, "Warehouse not found")
+	register(ErrFlashSaleMixed, 400, "Flash sale goods must be ordered separately")
+	register(ErrReconcileRunNotFound, 404, "Reconcile run not found")
+	register(ErrInvReturnExceeded, 400, "Returned quantity exceeds sold quantity")
+	register(ErrFlashSaleRunning, 400, "Flash sale is already running for the goods")
 	register(ErrShopCartItemNotFound, 404, "ShopCart item not found")
 	register(ErrSubmitOrder, 500, "Failed to submit order")
 	register(ErrNoGoodsSelect, 400, "No goods selected")
@@ -42,6 +59,26 @@ func init() {
 	register(ErrValidation, 400, "Request validation failed")
 	register(ErrValidationTranslate, 500, "Failed to translate validation error")
 	register(ErrAlipay, 500, "Alipay initialize failed")
+	register(ErrPayTypeUnsupported, 400, "Payment type not supported")
+	register(ErrPayment, 500, "Payment provider request failed")
+	register(ErrPayNotification, 400, "Invalid payment notification")
+	register(ErrPayNotificationNotFound, 404, "Payment notification not found")
 	register(ErrInsufficientPermissions, 403, "Insufficient permissions")
 	register(ErrRedisLock, 500, "Redis lock operation failed")
+	register(ErrOrderCannotCancel, 400, "Order can not be cancelled in current status")
+	register(ErrOrderTransition, 400, "Order status transition not allowed")
+	register(ErrRefundNotFound, 404, "Refund not found")
+	register(ErrRefundExceeded, 400, "Refund quantity exceeds refundable quantity")
+	register(ErrRefundStatus, 400, "Refund can not be processed in current status")
+	register(ErrRefundPayment, 500, "Failed to refund payment")
+	register(ErrCouponNotFound, 404, "Coupon not found or expired")
+	register(ErrCouponNotApplicable, 400, "Coupon not applicable to this order")
+	register(ErrOrderCannotShip, 400, "Order can not be shipped in current status")
+	register(ErrShipmentNotFound, 404, "Shipment not found")
+	register(ErrCarrier, 500, "Carrier tracking request failed")
+	register(ErrGuestCartDisabled, 400, "Guest cart is not enabled")
+	register(ErrCartGoodsOffSale, 400, "Goods in cart is off sale")
+	register(ErrCartStockNotEnough, 400, "Goods in cart is out of stock")
+	register(ErrCartLimitExceeded, 400, "Goods quantity in cart exceeds the purchase limit")
+	register(ErrCartPriceChanged, 400, "Goods price changed since added to cart")
 }
@@ -28,4 +28,19 @@ const (
 
 	// ErrJsonUnmarshal - 500: JSON unmarshal error.
 	ErrJsonUnmarshal
+
+	// ErrSkuNotFound - 404: Goods SKU not found.
+	ErrSkuNotFound
+
+	// ErrSpecificationNotFound - 404: Specification not found.
+	ErrSpecificationNotFound
+
+	// ErrSkuSpecInvalid - 400: SKU specifications do not match the category.
+	ErrSkuSpecInvalid
+
+	// ErrSkuRequired - 400: Goods has SKUs, a SKU must be chosen.
+	ErrSkuRequired
+
+	// ErrReindexFailed - 500: Rebuilding the goods index failed.
+	ErrReindexFailed
 )
@@ -16,4 +16,22 @@ const (
 
 	// ErrOptimisticRetry - 500: Optimistic lock retry limit exceeded.
 	ErrOptimisticRetry
+
+	// ErrInvReservationReleased - 400: Inventory reservation already released.
+	ErrInvReservationReleased
+
+	// ErrWarehouseNotFound - 404: Warehouse not found.
+	ErrWarehouseNotFound
+
+	// ErrFlashSaleMixed - 400: Flash sale goods must be ordered separately.
+	ErrFlashSaleMixed
+
+	// ErrReconcileRunNotFound - 404: Reconcile run not found.
+	ErrReconcileRunNotFound
+
+	// ErrInvReturnExceeded - 400: Returned quantity exceeds sold quantity.
+	ErrInvReturnExceeded
+
+	// ErrFlashSaleRunning - 400: Flash sale is already running for the goods.
+	ErrFlashSaleRunning
 )
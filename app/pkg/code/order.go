@@ -25,4 +25,52 @@ const (
 
 	// ErrRedisLock - 500: Redis lock operation failed.
 	ErrRedisLock
+
+	// ErrOrderCannotCancel - 400: Order can not be cancelled in current status.
+	ErrOrderCannotCancel
+
+	// ErrOrderTransition - 400: Order status transition not allowed.
+	ErrOrderTransition
+
+	// ErrRefundNotFound - 404: Refund not found.
+	ErrRefundNotFound
+
+	// ErrRefundExceeded - 400: Refund quantity exceeds refundable quantity.
+	ErrRefundExceeded
+
+	// ErrRefundStatus - 400: Refund can not be processed in current status.
+	ErrRefundStatus
+
+	// ErrRefundPayment - 500: Failed to refund payment.
+	ErrRefundPayment
+
+	// ErrCouponNotFound - 404: Coupon not found or expired.
+	ErrCouponNotFound
+
+	// ErrCouponNotApplicable - 400: Coupon not applicable to this order.
+	ErrCouponNotApplicable
+
+	// ErrOrderCannotShip - 400: Order can not be shipped in current status.
+	ErrOrderCannotShip
+
+	// ErrShipmentNotFound - 404: Shipment not found.
+	ErrShipmentNotFound
+
+	// ErrCarrier - 500: Carrier tracking request failed.
+	ErrCarrier
+
+	// ErrGuestCartDisabled - 400: Guest cart is not enabled.
+	ErrGuestCartDisabled
+
+	// ErrCartGoodsOffSale - 400: Goods in cart is off sale.
+	ErrCartGoodsOffSale
+
+	// ErrCartStockNotEnough - 400: Goods in cart is out of stock.
+	ErrCartStockNotEnough
+
+	// ErrCartLimitExceeded - 400: Goods quantity in cart exceeds the purchase limit.
+	ErrCartLimitExceeded
+
+	// ErrCartPriceChanged - 400: Goods price changed since added to cart.
+	ErrCartPriceChanged
 )
@@ -35,4 +35,16 @@ const (
 const (
 	// ErrAlipay - 500: Alipay initialize failed.
 	ErrAlipay int = iota + 106201
+
+	// ErrPayTypeUnsupported - 400: Payment type not supported.
+	ErrPayTypeUnsupported
+
+	// ErrPayment - 500: Payment provider request failed.
+	ErrPayment
+
+	// ErrPayNotification - 400: Invalid payment notification.
+	ErrPayNotification
+
+	// ErrPayNotificationNotFound - 404: Payment notification not found.
+	ErrPayNotificationNotFound
 )
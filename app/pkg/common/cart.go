@@ -0,0 +1,52 @@
+package common
+
+import (
+	"Advanced_Shop/app/pkg/options"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 游客购物车 cookie 的值为 "游客ID.签名"，签名防止篡改游客ID访问别人的购物车
+
+func signGuestCartID(secret string, id string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// GetGuestCartID 从 cookie 中取出游客ID，没有 cookie 或签名不对时返回 0
+func GetGuestCartID(c *gin.Context, opts *options.CartOptions) int32 {
+	value, err := c.Cookie(opts.CookieName)
+	if err != nil || value == "" {
+		return 0
+	}
+	id, sign, found := strings.Cut(value, ".")
+	if !found || !hmac.Equal([]byte(sign), []byte(signGuestCartID(opts.CookieSecret, id))) {
+		return 0
+	}
+	guestID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || guestID <= 0 {
+		return 0
+	}
+	return int32(guestID)
+}
+
+// SetGuestCartID 写入游客购物车 cookie，有效期和 Redis 中的购物车一致
+func SetGuestCartID(c *gin.Context, opts *options.CartOptions, guestID int32) {
+	id := strconv.Itoa(int(guestID))
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(opts.CookieName, id+"."+signGuestCartID(opts.CookieSecret, id), int(opts.GuestTTL.Seconds()),
+		"/", opts.CookieDomain, opts.CookieSecure, true)
+}
+
+// ClearGuestCartID 游客购物车合并后删除 cookie
+func ClearGuestCartID(c *gin.Context, opts *options.CartOptions) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(opts.CookieName, "", -1, "/", opts.CookieDomain, opts.CookieSecure, true)
+}
@@ -0,0 +1,30 @@
+package gorm
+
+import (
+	"database/sql"
+	"github.com/dtm-labs/client/dtmcli"
+	"gorm.io/gorm"
+)
+
+// CallWithGorm 让DTM屏障支持GORM事务
+// 原理：拿到底层sql.DB开事务，把sql.Tx转给GORM，这样既能用屏障又能用GORM
+func CallWithGorm(barrier *dtmcli.BranchBarrier, db *gorm.DB, busiCall func(tx *gorm.DB) error) error {
+	// 1. 从gorm拿到底层 *sql.DB
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	// 2. 调用DTM原生的CallWithDB，拿到 *sql.Tx
+	return barrier.CallWithDB(sqlDB, func(sqlTx *sql.Tx) error {
+		// 3. 把 *sql.Tx 包装成 *gorm.DB，这样你的repo方法全部可以继续用
+		gormTx := db.WithContext(db.Statement.Context)
+
+		// 关键：用DTM给的sqlTx替换gorm内部的连接
+		// gorm提供了 ConnPool 接口，sql.Tx 实现了这个接口
+		gormTx.Statement.ConnPool = sqlTx
+
+		// 4. 执行业务逻辑，用的是包装好的gormTx
+		return busiCall(gormTx)
+	})
+}
@@ -0,0 +1,19 @@
+package message
+
+// 库存服务和商品服务之间的 MQ 消息体，两边共用同一个定义，避免字段不一致
+
+// 库存告警事件类型，商品服务按类型处理
+const (
+	StockAlertLow     = "stock_low" // 可售库存降到阈值以下
+	StockAlertSoldOut = "sold_out"  // 可售库存耗尽，没有冻结中的库存
+	StockAlertRestock = "restock"   // 售罄后重新有了可售库存
+)
+
+// StockAlertMessage 库存告警 MQ 消息体
+type StockAlertMessage struct {
+	Event     string `json:"event"`
+	GoodsId   int32  `json:"goods_id"`
+	Available int32  `json:"available"` // 变更后的可售库存
+	LowWater  int32  `json:"low_water"` // 触发时的阈值
+	Timestamp int64  `json:"timestamp"` // 毫秒
+}
@@ -24,6 +24,8 @@ type AliyunOptions struct {
 	AlipayProductCode string `mapstructure:"alipay-product-code" json:"alipayProductCode,omitempty"`
 	// AlipayTimeoutExpress 支付链接失效时间，默认30分钟
 	AlipayTimeoutExpress string `mapstructure:"alipay-timeout-express" json:"alipayTimeoutExpress,omitempty"`
+	// AlipayFakeRefund 沙箱联调时支付宝退款不调用支付宝接口，直接视为成功；支付、验签、查询和关单仍然调用支付宝
+	AlipayFakeRefund bool `mapstructure:"alipay-fake-refund" json:"alipayFakeRefund,omitempty"`
 }
 
 // NewAliyunOptions 创建AliyunOptions实例并设置默认值
@@ -65,4 +67,5 @@ func (o *AliyunOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AlipayReturnUrl, "aliyun.alipay.return-url", o.AlipayReturnUrl, "AliPay synchronous redirect URL")
 	fs.StringVar(&o.AlipayProductCode, "aliyun.alipay.product-code", o.AlipayProductCode, "AliPay product code (default: FAST_INSTANT_TRADE_PAY)")
 	fs.StringVar(&o.AlipayTimeoutExpress, "aliyun.alipay.timeout-express", o.AlipayTimeoutExpress, "AliPay payment link timeout (default: 30m)")
+	fs.BoolVar(&o.AlipayFakeRefund, "aliyun.alipay.fake-refund", o.AlipayFakeRefund, "Treat AliPay refunds as succeeded without calling AliPay, payments still go through AliPay")
 }
@@ -0,0 +1,77 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+const (
+	CartMergeSum   = "sum"   // 数量相加
+	CartMergeMax   = "max"   // 取两边较大的数量
+	CartMergeGuest = "guest" // 以游客购物车的数量为准
+)
+
+// CartOptions 购物车配置，订单服务使用存储、合并和限购部分，网关使用 cookie 部分
+type CartOptions struct {
+	GuestEnable   bool          `mapstructure:"guest_enable" json:"guest_enable,omitempty"`
+	GuestTTL      time.Duration `mapstructure:"guest_ttl" json:"guest_ttl,omitempty"`           // 游客购物车在 Redis 中的过期时间，每次修改后续期
+	KeyPrefix     string        `mapstructure:"key_prefix" json:"key_prefix,omitempty"`         // 游客购物车的 Redis key 前缀
+	MergeStrategy string        `mapstructure:"merge_strategy" json:"merge_strategy,omitempty"` // 登录合并时同一商品的数量规则
+	MaxNums       int32         `mapstructure:"max_nums" json:"max_nums,omitempty"`             // 商品和规格都没有设置限购时的默认限购数量，0 为不限购
+
+	CookieName   string `mapstructure:"cookie_name" json:"cookie_name,omitempty"`
+	CookieSecret string `mapstructure:"cookie_secret" json:"-"` // 购物车 cookie 的签名密钥
+	CookieDomain string `mapstructure:"cookie_domain" json:"cookie_domain,omitempty"`
+	CookieSecure bool   `mapstructure:"cookie_secure" json:"cookie_secure,omitempty"`
+}
+
+func NewCartOptions() *CartOptions {
+	return &CartOptions{
+		GuestEnable:   false,
+		GuestTTL:      30 * 24 * time.Hour,
+		KeyPrefix:     "cart:guest:",
+		MergeStrategy: CartMergeSum,
+		CookieName:    "cart_id",
+	}
+}
+
+func (o *CartOptions) Validate() []error {
+	errs := []error{}
+	if o.MaxNums < 0 {
+		errs = append(errs, fmt.Errorf("cart.max_nums must not be negative, got %d", o.MaxNums))
+	}
+	if !o.GuestEnable {
+		return errs
+	}
+	if o.GuestTTL <= 0 {
+		errs = append(errs, fmt.Errorf("cart.guest_ttl must be positive, got %s", o.GuestTTL))
+	}
+	if o.KeyPrefix == "" || o.CookieName == "" {
+		errs = append(errs, fmt.Errorf("cart.key_prefix and cart.cookie_name cannot be empty"))
+	}
+	// 不提供默认密钥，否则所有部署共用同一个密钥，游客 cookie 可以被伪造
+	if len(o.CookieSecret) < 16 {
+		errs = append(errs, fmt.Errorf("cart.cookie_secret is required when cart.guest_enable is set and must be at least 16 characters"))
+	}
+	switch o.MergeStrategy {
+	case CartMergeSum, CartMergeMax, CartMergeGuest:
+	default:
+		errs = append(errs, fmt.Errorf("cart.merge_strategy must be %s, %s or %s, got %q",
+			CartMergeSum, CartMergeMax, CartMergeGuest, o.MergeStrategy))
+	}
+	return errs
+}
+
+func (o *CartOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.GuestEnable, "cart.guest_enable", o.GuestEnable, "Allow anonymous carts stored in redis.")
+	fs.DurationVar(&o.GuestTTL, "cart.guest_ttl", o.GuestTTL, "Guest carts expire after this duration without changes.")
+	fs.StringVar(&o.KeyPrefix, "cart.key_prefix", o.KeyPrefix, "Redis key prefix of guest carts.")
+	fs.StringVar(&o.MergeStrategy, "cart.merge_strategy", o.MergeStrategy,
+		"How to merge quantities of the same goods at login, sum, max or guest.")
+	fs.Int32Var(&o.MaxNums, "cart.max_nums", o.MaxNums, "Default purchase limit for goods and skus without their own limit, 0 means unlimited.")
+	fs.StringVar(&o.CookieName, "cart.cookie_name", o.CookieName, "Name of the signed guest cart cookie.")
+	fs.StringVar(&o.CookieSecret, "cart.cookie_secret", o.CookieSecret, "Secret used to sign the guest cart cookie.")
+	fs.StringVar(&o.CookieDomain, "cart.cookie_domain", o.CookieDomain, "Domain of the guest cart cookie.")
+	fs.BoolVar(&o.CookieSecure, "cart.cookie_secure", o.CookieSecure, "Only send the guest cart cookie over https.")
+}
@@ -0,0 +1,61 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+const (
+	DelayQueueRocketMQ = "rocketmq" // RocketMQ 延时消息，超时关闭后通过 CrossTopic 通知库存服务归还
+	DelayQueueRedis    = "redis"    // Redis ZSET 轮询，不依赖 RocketMQ，超时关闭后通过 DTM 归还库存
+)
+
+// DelayQueueOptions 订单超时关闭使用的延时队列配置
+type DelayQueueOptions struct {
+	Type         string        `mapstructure:"type" json:"type,omitempty"`
+	OrderTimeout time.Duration `mapstructure:"order_timeout" json:"order_timeout,omitempty"` // 订单未支付自动关闭的时间
+	Key          string        `mapstructure:"key" json:"key,omitempty"`                     // Redis 队列的 ZSET key
+	PollInterval time.Duration `mapstructure:"poll_interval" json:"poll_interval,omitempty"` // Redis 队列轮询间隔
+	BatchSize    int           `mapstructure:"batch_size" json:"batch_size,omitempty"`       // Redis 队列每次取出的消息数
+	RetryDelay   time.Duration `mapstructure:"retry_delay" json:"retry_delay,omitempty"`     // Redis 队列处理失败或消费者宕机后重新投递的间隔
+}
+
+func NewDelayQueueOptions() *DelayQueueOptions {
+	return &DelayQueueOptions{
+		Type:         DelayQueueRocketMQ,
+		OrderTimeout: 3 * time.Minute,
+		Key:          "order:delay",
+		PollInterval: time.Second,
+		BatchSize:    100,
+		RetryDelay:   30 * time.Second,
+	}
+}
+
+func (o *DelayQueueOptions) Validate() []error {
+	errs := []error{}
+	if o.Type != DelayQueueRocketMQ && o.Type != DelayQueueRedis {
+		errs = append(errs, fmt.Errorf("delay.type must be %s or %s, got %q", DelayQueueRocketMQ, DelayQueueRedis, o.Type))
+	}
+	if o.OrderTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("delay.order_timeout must be positive, got %s", o.OrderTimeout))
+	}
+	if o.Type == DelayQueueRedis {
+		if o.Key == "" {
+			errs = append(errs, fmt.Errorf("delay.key must not be empty"))
+		}
+		if o.PollInterval <= 0 || o.RetryDelay <= 0 || o.BatchSize <= 0 {
+			errs = append(errs, fmt.Errorf("delay.poll_interval, delay.retry_delay and delay.batch_size must be positive"))
+		}
+	}
+	return errs
+}
+
+func (o *DelayQueueOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Type, "delay.type", o.Type, "Delay queue used to close unpaid orders, rocketmq or redis.")
+	fs.DurationVar(&o.OrderTimeout, "delay.order_timeout", o.OrderTimeout, "Unpaid orders are closed after this duration.")
+	fs.StringVar(&o.Key, "delay.key", o.Key, "Redis sorted set key of the redis delay queue.")
+	fs.DurationVar(&o.PollInterval, "delay.poll_interval", o.PollInterval, "Polling interval of the redis delay queue.")
+	fs.IntVar(&o.BatchSize, "delay.batch_size", o.BatchSize, "Max messages taken from the redis delay queue per poll.")
+	fs.DurationVar(&o.RetryDelay, "delay.retry_delay", o.RetryDelay, "Redelivery delay of the redis delay queue when handling fails.")
+}
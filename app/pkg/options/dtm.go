@@ -1,25 +1,39 @@
 package options
 
-import "github.com/spf13/pflag"
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+)
+
+const (
+	DtmModeSaga = "saga" // 下单直接扣减库存，失败时归还
+	DtmModeTcc  = "tcc"  // 下单冻结库存，支付成功后再转为已售
+)
 
 type DtmOptions struct {
 	GrpcServer string `mapstructure:"grpc" json:"grpc,omitempty"`
 	HttpServer string `mapstructure:"http" json:"http,omitempty"`
+	Mode       string `mapstructure:"mode" json:"mode,omitempty"`
 }
 
 func NewDtmOptions() *DtmOptions {
 	return &DtmOptions{
 		HttpServer: "http://127.0.0.1:36789/api/dtmsvr",
 		GrpcServer: "127.0.0.1:36790",
+		Mode:       DtmModeSaga,
 	}
 }
 
 func (o *DtmOptions) Validate() []error {
 	errs := []error{}
+	if o.Mode != DtmModeSaga && o.Mode != DtmModeTcc {
+		errs = append(errs, fmt.Errorf("dtm.mode must be %s or %s, got %q", DtmModeSaga, DtmModeTcc, o.Mode))
+	}
 	return errs
 }
 
 func (o *DtmOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.GrpcServer, "dtm.grpc", o.GrpcServer, "")
 	fs.StringVar(&o.HttpServer, "dtm.http", o.HttpServer, "")
+	fs.StringVar(&o.Mode, "dtm.mode", o.Mode, "Distributed transaction mode for order submit, saga or tcc.")
 }
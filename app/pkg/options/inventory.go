@@ -0,0 +1,77 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+const (
+	AllocStrategyNearest = "nearest" // 优先收货省份所在仓库
+	AllocStrategyLargest = "largest" // 优先库存最多的仓库
+)
+
+type InventoryOptions struct {
+	AllocStrategy string `mapstructure:"alloc_strategy" json:"alloc_strategy,omitempty"`
+	LowWater      int32  `mapstructure:"low_water" json:"low_water,omitempty"` // 商品没有单独配置阈值时的默认低库存阈值
+	// FlashSale 开启后，预热过的商品在 Redis 里原子扣减，再经 MQ 异步写回 MySQL
+	FlashSale bool `mapstructure:"flash_sale" json:"flash_sale,omitempty"`
+
+	// 对账：定期扫描长时间未归还也未确认的扣减记录，与订单状态比对
+	ReconcileInterval   time.Duration `mapstructure:"reconcile_interval" json:"reconcile_interval,omitempty"` // 为 0 时不启动定时对账
+	ReconcileStaleAfter time.Duration `mapstructure:"reconcile_stale_after" json:"reconcile_stale_after,omitempty"`
+	ReconcileBatchSize  int           `mapstructure:"reconcile_batch_size" json:"reconcile_batch_size,omitempty"`
+	// 默认只记录对账结果，不归还库存也不改扣减记录，确认结果无误后显式设为 false 才自动纠正
+	ReconcileDryRun bool `mapstructure:"reconcile_dry_run" json:"reconcile_dry_run"`
+}
+
+func NewInventoryOptions() *InventoryOptions {
+	return &InventoryOptions{
+		AllocStrategy: AllocStrategyNearest,
+		LowWater:      10,
+
+		ReconcileInterval:   10 * time.Minute,
+		ReconcileStaleAfter: time.Hour,
+		ReconcileBatchSize:  200,
+		ReconcileDryRun:     true,
+	}
+}
+
+func (o *InventoryOptions) Validate() []error {
+	errs := []error{}
+	if o.AllocStrategy != AllocStrategyNearest && o.AllocStrategy != AllocStrategyLargest {
+		errs = append(errs, fmt.Errorf("inventory.alloc_strategy must be %s or %s, got %q",
+			AllocStrategyNearest, AllocStrategyLargest, o.AllocStrategy))
+	}
+	if o.LowWater < 0 {
+		errs = append(errs, fmt.Errorf("inventory.low_water must not be negative, got %d", o.LowWater))
+	}
+	if o.ReconcileInterval < 0 {
+		errs = append(errs, fmt.Errorf("inventory.reconcile_interval must not be negative, got %s", o.ReconcileInterval))
+	}
+	if o.ReconcileStaleAfter <= 0 {
+		errs = append(errs, fmt.Errorf("inventory.reconcile_stale_after must be positive, got %s", o.ReconcileStaleAfter))
+	}
+	if o.ReconcileBatchSize <= 0 {
+		errs = append(errs, fmt.Errorf("inventory.reconcile_batch_size must be positive, got %d", o.ReconcileBatchSize))
+	}
+	return errs
+}
+
+func (o *InventoryOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.AllocStrategy, "inventory.alloc_strategy", o.AllocStrategy,
+		"Warehouse allocation strategy when selling, nearest or largest.")
+	fs.Int32Var(&o.LowWater, "inventory.low_water", o.LowWater,
+		"Default low-stock threshold for goods without their own threshold.")
+	fs.BoolVar(&o.FlashSale, "inventory.flash_sale", o.FlashSale,
+		"Deduct preloaded flash-sale goods in Redis and persist to MySQL asynchronously via MQ.")
+	fs.DurationVar(&o.ReconcileInterval, "inventory.reconcile_interval", o.ReconcileInterval,
+		"Interval of the sell detail and order reconciliation job, 0 disables it.")
+	fs.DurationVar(&o.ReconcileStaleAfter, "inventory.reconcile_stale_after", o.ReconcileStaleAfter,
+		"Only sell details older than this are reconciled.")
+	fs.IntVar(&o.ReconcileBatchSize, "inventory.reconcile_batch_size", o.ReconcileBatchSize,
+		"Number of sell details scanned per batch during reconciliation.")
+	fs.BoolVar(&o.ReconcileDryRun, "inventory.reconcile_dry_run", o.ReconcileDryRun,
+		"Report reconciliation results without returning stock or updating sell details. "+
+			"Set to false to let the scheduled job correct stock.")
+}
@@ -17,6 +17,9 @@ type RocketMQOptions struct {
 	ConsumerTopic     string `mapstructure:"consumer_topic" yaml:"consumer_topic"`
 	MaxRetryTimes     int    `mapstructure:"max_retry_times" yaml:"max_retry_times"`
 	BaseRetryDelay    int    `mapstructure:"base_retry_delay" yaml:"base_retry_delay"`
+	StockAlertTopic   string `mapstructure:"stock_alert_topic" yaml:"stock_alert_topic"`
+	FlashSaleTopic    string `mapstructure:"flash_sale_topic" yaml:"flash_sale_topic"`
+	OrderEventTopic   string `mapstructure:"order_event_topic" yaml:"order_event_topic"`
 }
 
 func NewRocketMQOptions() *RocketMQOptions {
@@ -30,6 +33,9 @@ func NewRocketMQOptions() *RocketMQOptions {
 		ConsumerTopic:     "goods_topic",
 		MaxRetryTimes:     3,
 		BaseRetryDelay:    1000,
+		StockAlertTopic:   "stock_alert_topic",
+		FlashSaleTopic:    "flash_sale_topic",
+		OrderEventTopic:   "order_event_topic",
 	}
 }
 
@@ -53,4 +59,7 @@ func (o *RocketMQOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ConsumerTopic, "rocketmq.consumer_topic", o.ConsumerTopic, "RocketMQ consumer topic")
 	fs.IntVar(&o.MaxRetryTimes, "rocketmq.max_retry_times", o.MaxRetryTimes, "RocketMQ max retry times")
 	fs.IntVar(&o.BaseRetryDelay, "rocketmq.base_retry_delay", o.BaseRetryDelay, "RocketMQ base retry delay (ms)")
+	fs.StringVar(&o.StockAlertTopic, "rocketmq.stock_alert_topic", o.StockAlertTopic, "RocketMQ topic for low-stock and sold-out events")
+	fs.StringVar(&o.FlashSaleTopic, "rocketmq.flash_sale_topic", o.FlashSaleTopic, "RocketMQ topic for flash-sale write-behind to MySQL")
+	fs.StringVar(&o.OrderEventTopic, "rocketmq.order_event_topic", o.OrderEventTopic, "RocketMQ topic for order created, paid and closed events")
 }
@@ -0,0 +1,51 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+// OutboxOptions 事务发件箱配置，开启后领域事件在业务事务中写入 outbox_events 表，由后台转发到 RocketMQ
+type OutboxOptions struct {
+	Enable       bool          `mapstructure:"enable" json:"enable,omitempty"`
+	PollInterval time.Duration `mapstructure:"poll_interval" json:"poll_interval,omitempty"` // 轮询间隔
+	BatchSize    int           `mapstructure:"batch_size" json:"batch_size,omitempty"`       // 每次转发的事件数
+	MaxAttempts  int           `mapstructure:"max_attempts" json:"max_attempts,omitempty"`   // 最大发送次数，超过后置为 FAILED
+	RetryDelay   time.Duration `mapstructure:"retry_delay" json:"retry_delay,omitempty"`     // 第一次重试的等待时间，之后指数增长
+	Retention    time.Duration `mapstructure:"retention" json:"retention,omitempty"`         // 已发送事件的保留时间，0 表示不清理
+}
+
+func NewOutboxOptions() *OutboxOptions {
+	return &OutboxOptions{
+		Enable:       false,
+		PollInterval: time.Second,
+		BatchSize:    100,
+		MaxAttempts:  10,
+		RetryDelay:   5 * time.Second,
+		Retention:    7 * 24 * time.Hour,
+	}
+}
+
+func (o *OutboxOptions) Validate() []error {
+	errs := []error{}
+	if !o.Enable {
+		return errs
+	}
+	if o.PollInterval <= 0 || o.RetryDelay <= 0 {
+		errs = append(errs, fmt.Errorf("outbox.poll_interval and outbox.retry_delay must be positive"))
+	}
+	if o.BatchSize <= 0 || o.MaxAttempts <= 0 {
+		errs = append(errs, fmt.Errorf("outbox.batch_size and outbox.max_attempts must be positive"))
+	}
+	return errs
+}
+
+func (o *OutboxOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enable, "outbox.enable", o.Enable, "Write domain events to the outbox table in the business transaction and relay them to RocketMQ.")
+	fs.DurationVar(&o.PollInterval, "outbox.poll_interval", o.PollInterval, "Polling interval of the outbox relay.")
+	fs.IntVar(&o.BatchSize, "outbox.batch_size", o.BatchSize, "Max events relayed per poll.")
+	fs.IntVar(&o.MaxAttempts, "outbox.max_attempts", o.MaxAttempts, "Events are marked FAILED after this many failed attempts.")
+	fs.DurationVar(&o.RetryDelay, "outbox.retry_delay", o.RetryDelay, "Delay before the first retry, doubled on each further failure.")
+	fs.DurationVar(&o.Retention, "outbox.retention", o.Retention, "How long sent events are kept, 0 keeps them forever.")
+}
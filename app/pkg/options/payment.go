@@ -0,0 +1,74 @@
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// PaymentOptions 支付渠道配置，支付宝的配置沿用 AliyunOptions
+type PaymentOptions struct {
+	// Mock 开启模拟支付，回调不验签，只能用于本地联调
+	Mock bool `mapstructure:"mock" json:"mock,omitempty"`
+
+	// WechatEnable 开启微信支付（Native 扫码支付，API v3）
+	WechatEnable bool `mapstructure:"wechat-enable" json:"wechatEnable,omitempty"`
+	// WechatAppId 公众号或小程序的 AppID
+	WechatAppId string `mapstructure:"wechat-app-id" json:"wechatAppId,omitempty"`
+	// WechatMchId 商户号
+	WechatMchId string `mapstructure:"wechat-mch-id" json:"wechatMchId,omitempty"`
+	// WechatSerialNo 商户 API 证书序列号
+	WechatSerialNo string `mapstructure:"wechat-serial-no" json:"wechatSerialNo,omitempty"`
+	// WechatPrivateKey 商户 API 私钥（PEM）
+	WechatPrivateKey string `mapstructure:"wechat-private-key" json:"wechatPrivateKey,omitempty"`
+	// WechatAPIv3Key APIv3 密钥，用于解密回调通知
+	WechatAPIv3Key string `mapstructure:"wechat-apiv3-key" json:"wechatAPIv3Key,omitempty"`
+	// WechatPlatformPublicKey 微信支付公钥或平台证书公钥（PEM），用于验证回调和应答签名
+	WechatPlatformPublicKey string `mapstructure:"wechat-platform-public-key" json:"wechatPlatformPublicKey,omitempty"`
+	// WechatNotifyUrl 支付结果回调地址
+	WechatNotifyUrl string `mapstructure:"wechat-notify-url" json:"wechatNotifyUrl,omitempty"`
+	// WechatDescription 商品描述前缀，后面拼接订单号
+	WechatDescription string `mapstructure:"wechat-description" json:"wechatDescription,omitempty"`
+}
+
+func NewPaymentOptions() *PaymentOptions {
+	return &PaymentOptions{
+		Mock:              false,
+		WechatEnable:      false,
+		WechatNotifyUrl:   "http://tvxw11009075.vicp.fun/o/v1/pay/notify/wechat",
+		WechatDescription: "xshop订单",
+	}
+}
+
+func (o *PaymentOptions) Validate() []error {
+	var errs []error
+	if !o.WechatEnable {
+		return errs
+	}
+	if o.WechatAppId == "" || o.WechatMchId == "" {
+		errs = append(errs, fmt.Errorf("payment wechat app id and mch id cannot be empty"))
+	}
+	if o.WechatSerialNo == "" || o.WechatPrivateKey == "" {
+		errs = append(errs, fmt.Errorf("payment wechat serial no and private key cannot be empty"))
+	}
+	if len(o.WechatAPIv3Key) != 32 {
+		errs = append(errs, fmt.Errorf("payment wechat apiv3 key must be 32 bytes"))
+	}
+	if o.WechatPlatformPublicKey == "" {
+		errs = append(errs, fmt.Errorf("payment wechat platform public key cannot be empty"))
+	}
+	return errs
+}
+
+func (o *PaymentOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Mock, "payment.mock", o.Mock, "Enable the mock payment provider, notifications are not signed, for local testing only")
+	fs.BoolVar(&o.WechatEnable, "payment.wechat.enable", o.WechatEnable, "Enable WeChat Pay (Native, API v3)")
+	fs.StringVar(&o.WechatAppId, "payment.wechat.app-id", o.WechatAppId, "WeChat Pay AppID")
+	fs.StringVar(&o.WechatMchId, "payment.wechat.mch-id", o.WechatMchId, "WeChat Pay merchant ID")
+	fs.StringVar(&o.WechatSerialNo, "payment.wechat.serial-no", o.WechatSerialNo, "WeChat Pay merchant certificate serial number")
+	fs.StringVar(&o.WechatPrivateKey, "payment.wechat.private-key", o.WechatPrivateKey, "WeChat Pay merchant private key (PEM)")
+	fs.StringVar(&o.WechatAPIv3Key, "payment.wechat.apiv3-key", o.WechatAPIv3Key, "WeChat Pay APIv3 key for decrypting notifications")
+	fs.StringVar(&o.WechatPlatformPublicKey, "payment.wechat.platform-public-key", o.WechatPlatformPublicKey, "WeChat Pay platform public key (PEM) for verifying signatures")
+	fs.StringVar(&o.WechatNotifyUrl, "payment.wechat.notify-url", o.WechatNotifyUrl, "WeChat Pay notify callback URL")
+	fs.StringVar(&o.WechatDescription, "payment.wechat.description", o.WechatDescription, "WeChat Pay order description prefix")
+}
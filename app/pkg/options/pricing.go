@@ -0,0 +1,30 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+)
+
+// PricingOptions 订单计价配置，金额单位为分
+type PricingOptions struct {
+	ShippingFee int64 `mapstructure:"shipping_fee" json:"shipping_fee,omitempty"` // 订单中有不包邮商品时收取的运费
+}
+
+func NewPricingOptions() *PricingOptions {
+	return &PricingOptions{
+		ShippingFee: 1000,
+	}
+}
+
+func (o *PricingOptions) Validate() []error {
+	errs := []error{}
+	if o.ShippingFee < 0 {
+		errs = append(errs, fmt.Errorf("pricing.shipping_fee must not be negative, got %d", o.ShippingFee))
+	}
+	return errs
+}
+
+func (o *PricingOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.Int64Var(&o.ShippingFee, "pricing.shipping_fee", o.ShippingFee,
+		"Shipping fee in cents charged when the order contains goods without free shipping.")
+}
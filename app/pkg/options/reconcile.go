@@ -0,0 +1,52 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+// ReconcileOptions 支付对账配置
+// 主动查询：订单距离超时关闭不到 Ahead 时向渠道查询交易，补记丢失的支付通知
+// 对账单：每天 StatementHour 点导入前一天的对账单，记录渠道与订单的差异
+type ReconcileOptions struct {
+	Enable          bool          `mapstructure:"enable" json:"enable,omitempty"`
+	Interval        time.Duration `mapstructure:"interval" json:"interval,omitempty"`     // 主动查询的间隔
+	Ahead           time.Duration `mapstructure:"ahead" json:"ahead,omitempty"`           // 距离超时关闭多久开始主动查询
+	BatchSize       int           `mapstructure:"batch_size" json:"batch_size,omitempty"` // 每次主动查询的订单数
+	StatementEnable bool          `mapstructure:"statement_enable" json:"statement_enable,omitempty"`
+	StatementHour   int           `mapstructure:"statement_hour" json:"statement_hour,omitempty"` // 导入前一天对账单的时间（点）
+}
+
+func NewReconcileOptions() *ReconcileOptions {
+	return &ReconcileOptions{
+		Enable:          false,
+		Interval:        time.Minute,
+		Ahead:           5 * time.Minute,
+		BatchSize:       100,
+		StatementEnable: false,
+		StatementHour:   10, // 支付宝和微信的日账单在次日上午生成
+	}
+}
+
+func (o *ReconcileOptions) Validate() []error {
+	errs := []error{}
+	if o.Enable {
+		if o.Interval <= 0 || o.Ahead <= 0 || o.BatchSize <= 0 {
+			errs = append(errs, fmt.Errorf("reconcile.interval, reconcile.ahead and reconcile.batch_size must be positive"))
+		}
+	}
+	if o.StatementEnable && (o.StatementHour < 0 || o.StatementHour > 23) {
+		errs = append(errs, fmt.Errorf("reconcile.statement_hour must be between 0 and 23, got %d", o.StatementHour))
+	}
+	return errs
+}
+
+func (o *ReconcileOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enable, "reconcile.enable", o.Enable, "Query the payment provider for unpaid orders that are about to time out.")
+	fs.DurationVar(&o.Interval, "reconcile.interval", o.Interval, "Interval of the active trade query.")
+	fs.DurationVar(&o.Ahead, "reconcile.ahead", o.Ahead, "Start querying an unpaid order this long before it times out.")
+	fs.IntVar(&o.BatchSize, "reconcile.batch_size", o.BatchSize, "Max orders queried per interval.")
+	fs.BoolVar(&o.StatementEnable, "reconcile.statement_enable", o.StatementEnable, "Import the previous day's payment statements daily and record mismatches.")
+	fs.IntVar(&o.StatementHour, "reconcile.statement_hour", o.StatementHour, "Hour of the day to import the previous day's statements.")
+}
@@ -0,0 +1,122 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+const (
+	SearchAnalyzerAuto     = "auto"     // 检测 ES 是否安装了 ik 和 pinyin 插件，没有时使用内置分词器
+	SearchAnalyzerIK       = "ik"       // 必须安装 analysis-ik 和 analysis-pinyin 插件
+	SearchAnalyzerStandard = "standard" // 只使用 ES 内置的 cjk 分词器，不支持拼音
+
+	SearchBackendES     = "es"     // Elasticsearch，支持中文分词、拼音和搜索建议
+	SearchBackendMySQL  = "mysql"  // 直接查询 MySQL，用 LIKE 或全文索引匹配关键词
+	SearchBackendMemory = "memory" // 进程内索引，只用于测试和本地开发，重启后数据丢失
+)
+
+// SearchOptions 商品搜索配置：搜索后端、索引模板使用的分词器、搜索建议和热搜词
+type SearchOptions struct {
+	Backend       string `mapstructure:"backend" json:"backend,omitempty"`
+	MysqlFulltext bool   `mapstructure:"mysql_fulltext" json:"mysql_fulltext,omitempty"` // MySQL 后端用全文索引匹配关键词，需要在 name、goods_brief 上建 ngram 全文索引
+
+	// ES 后端出错或熔断时搜索降级到 MySQL 后端，熔断规则按统计周期内的错误比例
+	Fallback            bool          `mapstructure:"fallback" json:"fallback,omitempty"`
+	BreakerErrorRatio   float64       `mapstructure:"breaker_error_ratio" json:"breaker_error_ratio,omitempty"`
+	BreakerMinRequests  uint64        `mapstructure:"breaker_min_requests" json:"breaker_min_requests,omitempty"`   // 统计周期内请求数少于这个值时不熔断
+	BreakerStatInterval time.Duration `mapstructure:"breaker_stat_interval" json:"breaker_stat_interval,omitempty"` // 错误比例的统计周期
+	BreakerRetryTimeout time.Duration `mapstructure:"breaker_retry_timeout" json:"breaker_retry_timeout,omitempty"` // 熔断多久后放行一个请求探测 ES 是否恢复
+
+	Analyzer    string `mapstructure:"analyzer" json:"analyzer,omitempty"`
+	SuggestSize int    `mapstructure:"suggest_size" json:"suggest_size,omitempty"` // 搜索建议的最大条数
+
+	HotEnable    bool          `mapstructure:"hot_enable" json:"hot_enable,omitempty"` // 在 Redis 中统计热搜词
+	HotKeyPrefix string        `mapstructure:"hot_key_prefix" json:"hot_key_prefix,omitempty"`
+	HotWindow    int           `mapstructure:"hot_window" json:"hot_window,omitempty"`       // 按天计数，热搜词统计最近几天
+	HotCacheTTL  time.Duration `mapstructure:"hot_cache_ttl" json:"hot_cache_ttl,omitempty"` // 热搜榜的缓存时间，过期后重新合并每天的计数
+	HotSize      int           `mapstructure:"hot_size" json:"hot_size,omitempty"`           // 热搜榜的条数
+
+	ReindexBatch int `mapstructure:"reindex_batch" json:"reindex_batch,omitempty"` // 重建索引时每批读取和写入的商品数
+	CheckSample  int `mapstructure:"check_sample" json:"check_sample,omitempty"`   // 一致性检查抽样的商品数
+}
+
+func NewSearchOptions() *SearchOptions {
+	return &SearchOptions{
+		Backend:             SearchBackendES,
+		Fallback:            true,
+		BreakerErrorRatio:   0.5,
+		BreakerMinRequests:  10,
+		BreakerStatInterval: 10 * time.Second,
+		BreakerRetryTimeout: 10 * time.Second,
+		Analyzer:            SearchAnalyzerAuto,
+		SuggestSize:         10,
+		HotEnable:           false,
+		HotKeyPrefix:        "{goods:hot_search}:", // hash tag 保证集群模式下所有 key 在同一个槽
+		HotWindow:           7,
+		HotCacheTTL:         time.Minute,
+		HotSize:             10,
+		ReindexBatch:        500,
+		CheckSample:         200,
+	}
+}
+
+func (o *SearchOptions) Validate() []error {
+	errs := []error{}
+	switch o.Backend {
+	case SearchBackendES, SearchBackendMySQL, SearchBackendMemory:
+	default:
+		errs = append(errs, fmt.Errorf("search.backend must be one of es, mysql, memory, got %q", o.Backend))
+	}
+	if o.Backend == SearchBackendES && o.Fallback {
+		if o.BreakerErrorRatio <= 0 || o.BreakerErrorRatio > 1 {
+			errs = append(errs, fmt.Errorf("search.breaker_error_ratio must be in (0, 1], got %v", o.BreakerErrorRatio))
+		}
+		if o.BreakerStatInterval < time.Millisecond || o.BreakerRetryTimeout < time.Millisecond {
+			errs = append(errs, fmt.Errorf("search.breaker_stat_interval and search.breaker_retry_timeout must be at least 1ms"))
+		}
+	}
+	switch o.Analyzer {
+	case SearchAnalyzerAuto, SearchAnalyzerIK, SearchAnalyzerStandard:
+	default:
+		errs = append(errs, fmt.Errorf("search.analyzer must be one of auto, ik, standard, got %q", o.Analyzer))
+	}
+	if o.SuggestSize <= 0 {
+		errs = append(errs, fmt.Errorf("search.suggest_size must be positive, got %d", o.SuggestSize))
+	}
+	if o.ReindexBatch <= 0 || o.CheckSample <= 0 {
+		errs = append(errs, fmt.Errorf("search.reindex_batch and search.check_sample must be positive"))
+	}
+	if !o.HotEnable {
+		return errs
+	}
+	if o.HotKeyPrefix == "" {
+		errs = append(errs, fmt.Errorf("search.hot_key_prefix cannot be empty"))
+	}
+	if o.HotWindow <= 0 || o.HotSize <= 0 {
+		errs = append(errs, fmt.Errorf("search.hot_window and search.hot_size must be positive"))
+	}
+	if o.HotCacheTTL <= 0 {
+		errs = append(errs, fmt.Errorf("search.hot_cache_ttl must be positive, got %s", o.HotCacheTTL))
+	}
+	return errs
+}
+
+func (o *SearchOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Backend, "search.backend", o.Backend, "Goods search backend: es, mysql or memory (tests and local development only).")
+	fs.BoolVar(&o.MysqlFulltext, "search.mysql_fulltext", o.MysqlFulltext, "Match keywords with a FULLTEXT index in the mysql backend instead of LIKE.")
+	fs.BoolVar(&o.Fallback, "search.fallback", o.Fallback, "Degrade goods search to mysql when elasticsearch fails or the circuit breaker is open.")
+	fs.Float64Var(&o.BreakerErrorRatio, "search.breaker_error_ratio", o.BreakerErrorRatio, "Error ratio of elasticsearch requests that opens the circuit breaker.")
+	fs.Uint64Var(&o.BreakerMinRequests, "search.breaker_min_requests", o.BreakerMinRequests, "Minimum requests in a stat interval before the circuit breaker can open.")
+	fs.DurationVar(&o.BreakerStatInterval, "search.breaker_stat_interval", o.BreakerStatInterval, "Stat interval of the circuit breaker error ratio.")
+	fs.DurationVar(&o.BreakerRetryTimeout, "search.breaker_retry_timeout", o.BreakerRetryTimeout, "How long the circuit breaker stays open before probing elasticsearch again.")
+	fs.StringVar(&o.Analyzer, "search.analyzer", o.Analyzer, "Analyzer of the goods index template: auto, ik (requires ik and pinyin plugins) or standard.")
+	fs.IntVar(&o.SuggestSize, "search.suggest_size", o.SuggestSize, "Max number of search suggestions.")
+	fs.BoolVar(&o.HotEnable, "search.hot_enable", o.HotEnable, "Count search keywords in Redis to build the hot search list.")
+	fs.StringVar(&o.HotKeyPrefix, "search.hot_key_prefix", o.HotKeyPrefix, "Redis key prefix of the daily hot search counters.")
+	fs.IntVar(&o.HotWindow, "search.hot_window", o.HotWindow, "Number of days counted in the hot search list.")
+	fs.DurationVar(&o.HotCacheTTL, "search.hot_cache_ttl", o.HotCacheTTL, "How long the merged hot search list is cached.")
+	fs.IntVar(&o.HotSize, "search.hot_size", o.HotSize, "Number of terms in the hot search list.")
+	fs.IntVar(&o.ReindexBatch, "search.reindex_batch", o.ReindexBatch, "Number of goods read and bulk indexed at a time when rebuilding the index.")
+	fs.IntVar(&o.CheckSample, "search.check_sample", o.CheckSample, "Number of goods sampled by the index consistency check.")
+}
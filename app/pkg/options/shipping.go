@@ -0,0 +1,73 @@
+package options
+
+import (
+	"fmt"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+// CarrierKuaidi100 快递100 实时查询接口，不配置物流渠道时不查询轨迹
+// 模拟物流只在测试中直接构造，不能通过配置启用，避免运单被模拟签收后自动确认收货
+const CarrierKuaidi100 = "kuaidi100"
+
+// ShippingOptions 发货和物流跟踪配置
+type ShippingOptions struct {
+	Carrier           string `mapstructure:"carrier" json:"carrier,omitempty"`
+	Kuaidi100Customer string `mapstructure:"kuaidi100_customer" json:"kuaidi100_customer,omitempty"`
+	Kuaidi100Key      string `mapstructure:"kuaidi100_key" json:"kuaidi100_key,omitempty"`
+
+	TrackEnable           bool          `mapstructure:"track_enable" json:"track_enable,omitempty"`
+	PollInterval          time.Duration `mapstructure:"poll_interval" json:"poll_interval,omitempty"`                     // 后台任务的轮询间隔
+	SyncInterval          time.Duration `mapstructure:"sync_interval" json:"sync_interval,omitempty"`                     // 同一个运单两次查询轨迹的最小间隔
+	BatchSize             int           `mapstructure:"batch_size" json:"batch_size,omitempty"`                           // 每次轮询处理的运单数
+	AutoReceiveAfter      time.Duration `mapstructure:"auto_receive_after" json:"auto_receive_after,omitempty"`           // 发货后超过这个时间自动确认收货
+	DeliveredReceiveAfter time.Duration `mapstructure:"delivered_receive_after" json:"delivered_receive_after,omitempty"` // 物流签收后超过这个时间自动确认收货
+}
+
+func NewShippingOptions() *ShippingOptions {
+	return &ShippingOptions{
+		TrackEnable:           false,
+		PollInterval:          time.Minute,
+		SyncInterval:          2 * time.Hour,
+		BatchSize:             100,
+		AutoReceiveAfter:      10 * 24 * time.Hour,
+		DeliveredReceiveAfter: 3 * 24 * time.Hour,
+	}
+}
+
+func (o *ShippingOptions) Validate() []error {
+	errs := []error{}
+	switch o.Carrier {
+	case "":
+	case CarrierKuaidi100:
+		if o.Kuaidi100Customer == "" || o.Kuaidi100Key == "" {
+			errs = append(errs, fmt.Errorf("shipping.kuaidi100_customer and shipping.kuaidi100_key cannot be empty"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("shipping.carrier must be empty or %s, got %q", CarrierKuaidi100, o.Carrier))
+	}
+	if o.TrackEnable {
+		if o.Carrier == "" {
+			errs = append(errs, fmt.Errorf("shipping.track_enable requires shipping.carrier"))
+		}
+		if o.PollInterval <= 0 || o.SyncInterval <= 0 || o.BatchSize <= 0 {
+			errs = append(errs, fmt.Errorf("shipping.poll_interval, shipping.sync_interval and shipping.batch_size must be positive"))
+		}
+		if o.AutoReceiveAfter <= 0 || o.DeliveredReceiveAfter <= 0 {
+			errs = append(errs, fmt.Errorf("shipping.auto_receive_after and shipping.delivered_receive_after must be positive"))
+		}
+	}
+	return errs
+}
+
+func (o *ShippingOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Carrier, "shipping.carrier", o.Carrier, "Carrier tracking adapter, empty or kuaidi100. Tracking is disabled without a carrier.")
+	fs.StringVar(&o.Kuaidi100Customer, "shipping.kuaidi100_customer", o.Kuaidi100Customer, "Kuaidi100 customer id.")
+	fs.StringVar(&o.Kuaidi100Key, "shipping.kuaidi100_key", o.Kuaidi100Key, "Kuaidi100 api key.")
+	fs.BoolVar(&o.TrackEnable, "shipping.track_enable", o.TrackEnable, "Sync tracking events periodically and confirm receipt automatically.")
+	fs.DurationVar(&o.PollInterval, "shipping.poll_interval", o.PollInterval, "Polling interval of the shipment tracker.")
+	fs.DurationVar(&o.SyncInterval, "shipping.sync_interval", o.SyncInterval, "Minimum interval between two tracking queries of the same shipment.")
+	fs.IntVar(&o.BatchSize, "shipping.batch_size", o.BatchSize, "Max shipments handled per poll.")
+	fs.DurationVar(&o.AutoReceiveAfter, "shipping.auto_receive_after", o.AutoReceiveAfter, "Orders are marked received this long after shipping.")
+	fs.DurationVar(&o.DeliveredReceiveAfter, "shipping.delivered_receive_after", o.DeliveredReceiveAfter, "Orders are marked received this long after the carrier reports delivery.")
+}
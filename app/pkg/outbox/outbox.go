@@ -0,0 +1,121 @@
+package outbox
+
+import (
+	gorm2 "Advanced_Shop/app/pkg/gorm"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/common/util/idutil"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"encoding/json"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 发件箱模式：
+// 1. 业务在自己的 GORM 事务里调用 Writer.Write 写入事件，事务回滚时事件一起回滚，不会发出不存在的事件
+// 2. Relay 在后台轮询待发送的事件发布到 RocketMQ，失败时按退避时间重试，超过最大次数后置为 FAILED 等待人工处理
+// 3. 发布是至少一次的，转发实例宕机或发送超时后会重复发送，消费方需要幂等处理：
+//    商品变更按 ES 外部版本号写入，重复的消息不会覆盖更新的数据；订单事件带有订单号和状态，按状态处理
+//    事件ID作为消息 Key，用于按 Key 查询和排查
+
+// 事件状态
+const (
+	StatusPending = "PENDING" // 等待发送或等待重试
+	StatusSent    = "SENT"    // 已发送
+	StatusFailed  = "FAILED"  // 超过最大重试次数
+)
+
+// EventDO 发件箱中的一条事件，每个服务在自己的库里建一张 outbox_events 表
+type EventDO struct {
+	gorm2.Model
+	EventID       string     `gorm:"type:varchar(64);uniqueIndex;comment:事件ID，同时作为消息Key"`
+	Topic         string     `gorm:"type:varchar(100);comment:RocketMQ Topic"`
+	Type          string     `gorm:"type:varchar(50);comment:事件类型，同时作为消息Tag"`
+	Key           string     `gorm:"type:varchar(100);index;comment:业务主键，如订单号、商品ID"`
+	Payload       string     `gorm:"type:text;comment:消息体"`
+	Status        string     `gorm:"type:varchar(20);index:idx_outbox_relay,priority:1;comment:状态（PENDING/SENT/FAILED）"`
+	NextAttemptAt time.Time  `gorm:"index:idx_outbox_relay,priority:2;comment:下次发送时间"`
+	Attempts      int32      `gorm:"type:int;default:0;comment:已尝试发送的次数"`
+	LastError     string     `gorm:"type:varchar(200);comment:最近一次发送失败的原因"`
+	SentAt        *time.Time `gorm:"comment:发送成功时间"`
+}
+
+func (EventDO) TableName() string {
+	return "outbox_events"
+}
+
+// Event 业务写入的事件
+type Event struct {
+	Topic   string
+	Type    string
+	Key     string
+	Payload interface{} // []byte 和 string 原样写入，其他类型序列化为 JSON
+}
+
+// Writer 在业务事务中写入事件
+type Writer interface {
+	Write(ctx context.Context, tx *gorm.DB, events ...Event) error
+	// Enabled 构建事件需要额外查询时，可以先判断是否开启
+	Enabled() bool
+}
+
+// NewWriter 未开启发件箱时返回的 Writer 什么都不做，业务代码不需要判断开关
+func NewWriter(opts *options.OutboxOptions) Writer {
+	if opts == nil || !opts.Enable {
+		return noopWriter{}
+	}
+	return gormWriter{}
+}
+
+type gormWriter struct{}
+
+func (gormWriter) Enabled() bool {
+	return true
+}
+
+func (gormWriter) Write(ctx context.Context, tx *gorm.DB, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	rows := make([]*EventDO, 0, len(events))
+	for _, event := range events {
+		var payload string
+		switch p := event.Payload.(type) {
+		case []byte:
+			payload = string(p)
+		case string:
+			payload = p
+		default:
+			data, err := json.Marshal(p)
+			if err != nil {
+				return errors.WithCode(code.ErrEncodingJSON, "序列化%s事件失败: %v", event.Type, err)
+			}
+			payload = string(data)
+		}
+		rows = append(rows, &EventDO{
+			EventID:       idutil.GetUUID36(""),
+			Topic:         event.Topic,
+			Type:          event.Type,
+			Key:           event.Key,
+			Payload:       payload,
+			Status:        StatusPending,
+			NextAttemptAt: now,
+		})
+	}
+	if err := tx.WithContext(ctx).Create(&rows).Error; err != nil {
+		return errors.WithCode(code.ErrDatabase, "写入发件箱失败: %v", err)
+	}
+	return nil
+}
+
+type noopWriter struct{}
+
+func (noopWriter) Enabled() bool {
+	return false
+}
+
+func (noopWriter) Write(ctx context.Context, tx *gorm.DB, events ...Event) error {
+	return nil
+}
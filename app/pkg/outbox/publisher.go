@@ -0,0 +1,51 @@
+package outbox
+
+import (
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/gnova/code"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+)
+
+// Publisher 把事件发布到消息队列，返回错误时 Relay 稍后重试
+type Publisher interface {
+	Publish(ctx context.Context, event *EventDO) error
+}
+
+type rocketMQPublisher struct {
+	producer rocketmq.Producer
+}
+
+// NewRocketMQPublisher 使用独立的生产者组，不影响服务原有的生产者
+func NewRocketMQPublisher(mqOpts *options.RocketMQOptions) (Publisher, error) {
+	p, err := rocketmq.NewProducer(
+		producer.WithNameServer([]string{mqOpts.Addr()}),
+		producer.WithGroupName(mqOpts.GroupName+"_outbox"),
+		// 重试由 Relay 负责
+		producer.WithRetry(0),
+	)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrConnectMQ, "发件箱生产者创建失败: %v", err)
+	}
+	if err := p.Start(); err != nil {
+		return nil, errors.WithCode(code.ErrConnectMQ, "发件箱生产者启动失败: %v", err)
+	}
+	return &rocketMQPublisher{producer: p}, nil
+}
+
+func (p *rocketMQPublisher) Publish(ctx context.Context, event *EventDO) error {
+	msg := primitive.NewMessage(event.Topic, []byte(event.Payload))
+	msg.WithKeys([]string{event.EventID})
+	msg.WithTag(event.Type)
+	result, err := p.producer.SendSync(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if result.Status != primitive.SendOK {
+		return errors.WithCode(code.ErrConnectMQ, "事件%s发送状态异常: %d", event.EventID, result.Status)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package outbox
+
+import (
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+const (
+	// 退避时间的上限
+	maxRetryDelay = 10 * time.Minute
+	// 认领一批事件的租约，租约内其他实例不会取到这批事件；转发实例宕机时租约到期后重新发送
+	claimLease = 5 * time.Minute
+)
+
+// Relay 后台把发件箱中的事件发布出去
+// 认领事件时在一个短事务里使用 FOR UPDATE SKIP LOCKED，并把下次发送时间推迟一个租约，
+// 发送时不持有行锁，多个实例同时运行时不会重复发送同一批事件
+type Relay struct {
+	db        *gorm.DB
+	publisher Publisher
+	opts      *options.OutboxOptions
+}
+
+func NewRelay(db *gorm.DB, publisher Publisher, opts *options.OutboxOptions) *Relay {
+	return &Relay{db: db, publisher: publisher, opts: opts}
+}
+
+// Start 在后台开始轮询，ctx 结束时停止
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.opts.PollInterval)
+		defer ticker.Stop()
+		lastPurge := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("发件箱转发已停止")
+				return
+			case <-ticker.C:
+				// 取满一批时继续取，积压时不用等下一个间隔
+				for ctx.Err() == nil {
+					n, err := r.relay(ctx)
+					if err != nil {
+						log.Errorf("发件箱转发失败: %v", err)
+						break
+					}
+					if n < r.opts.BatchSize {
+						break
+					}
+				}
+				if r.opts.Retention > 0 && time.Since(lastPurge) > time.Hour {
+					r.purge(ctx)
+					lastPurge = time.Now()
+				}
+			}
+		}
+	}()
+	log.Info("发件箱转发启动成功")
+}
+
+// relay 认领并发送一批到期的事件，返回认领到的事件数
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	events, err := r.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, event := range events {
+		if err := r.publish(ctx, event); err != nil {
+			return len(events), err
+		}
+	}
+	return len(events), nil
+}
+
+// claim 认领一批到期的事件，事务提交后行锁就释放了
+func (r *Relay) claim(ctx context.Context) ([]*EventDO, error) {
+	var events []*EventDO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", StatusPending, now).
+			Order("id").Limit(r.opts.BatchSize).
+			Find(&events).Error
+		if err != nil || len(events) == 0 {
+			return err
+		}
+		ids := make([]int32, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.ID)
+		}
+		return tx.Model(&EventDO{}).Where("id IN ?", ids).Update("next_attempt_at", now.Add(claimLease)).Error
+	})
+	return events, err
+}
+
+// publish 发送一条事件并记录结果，只有记录结果失败时才返回错误
+func (r *Relay) publish(ctx context.Context, event *EventDO) error {
+	now := time.Now()
+	updates := map[string]interface{}{"attempts": event.Attempts + 1}
+	if err := r.publisher.Publish(ctx, event); err != nil {
+		reason := err.Error()
+		if len(reason) > 200 {
+			reason = reason[:200]
+		}
+		updates["last_error"] = reason
+		if int(event.Attempts+1) >= r.opts.MaxAttempts {
+			updates["status"] = StatusFailed
+			log.Errorf("事件%s（%s %s）发送%d次均失败，需要人工处理: %v", event.EventID, event.Type, event.Key, event.Attempts+1, err)
+		} else {
+			updates["next_attempt_at"] = now.Add(r.backoff(event.Attempts + 1))
+			log.Warnf("事件%s发送失败，稍后重试: %v", event.EventID, err)
+		}
+	} else {
+		updates["status"] = StatusSent
+		updates["sent_at"] = now
+	}
+	return r.db.WithContext(ctx).Model(&EventDO{}).Where("id = ? AND status = ?", event.ID, StatusPending).Updates(updates).Error
+}
+
+// backoff 第 n 次失败后的等待时间，按 RetryDelay 指数增长
+func (r *Relay) backoff(n int32) time.Duration {
+	delay := r.opts.RetryDelay
+	for i := int32(1); i < n && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// purge 删除超过保留时间的已发送事件
+func (r *Relay) purge(ctx context.Context) {
+	result := r.db.WithContext(ctx).Unscoped().
+		Where("status = ? AND sent_at < ?", StatusSent, time.Now().Add(-r.opts.Retention)).
+		Delete(&EventDO{})
+	if result.Error != nil {
+		log.Errorf("清理已发送的事件失败: %v", result.Error)
+		return
+	}
+	if result.RowsAffected > 0 {
+		log.Infof("清理已发送的事件%d条", result.RowsAffected)
+	}
+}
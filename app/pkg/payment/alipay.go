@@ -0,0 +1,146 @@
+package payment
+
+import (
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"github.com/smartwalle/alipay/v3"
+	"net/http"
+	"time"
+)
+
+// 支付宝返回的交易不存在错误码
+const alipayTradeNotExist = "ACQ.TRADE_NOT_EXIST"
+
+type alipayProvider struct {
+	client *alipay.Client
+	opts   *options.AliyunOptions
+}
+
+// NewAlipayProvider 电脑网站支付，异步通知使用支付宝公钥验签
+func NewAlipayProvider(opts *options.AliyunOptions) (PaymentProvider, error) {
+	client, err := alipay.New(opts.AlipayAppId, opts.AlipayPrivateKey, false)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrAlipay, err.Error())
+	}
+	if err := client.LoadAliPayPublicKey(opts.AlipayPublicKey); err != nil {
+		return nil, errors.WithCode(code.ErrAlipay, err.Error())
+	}
+	return &alipayProvider{client: client, opts: opts}, nil
+}
+
+func (a *alipayProvider) Pay(ctx context.Context, req *PayRequest) (*PayResult, error) {
+	var p = alipay.TradePagePay{}
+	p.NotifyURL = a.opts.AlipayNotifyUrl
+	p.ReturnURL = a.opts.AlipayReturnUrl
+	p.Subject = a.opts.AlipaySubject + req.OrderSn
+	p.OutTradeNo = req.OrderSn
+	p.TotalAmount = formatYuan(req.Amount)
+	p.ProductCode = a.opts.AlipayProductCode
+	p.TimeoutExpress = a.opts.AlipayTimeoutExpress // 默认30分钟 链接失效
+
+	result, err := a.client.TradePagePay(p)
+	if err != nil {
+		log.Errorf("订单%s生成支付宝url失败: %v", req.OrderSn, err)
+		return nil, errors.WithCode(code.ErrAlipay, "生成支付宝url失败")
+	}
+	return &PayResult{PayURL: result.String()}, nil
+}
+
+func (a *alipayProvider) VerifyNotification(req *http.Request) (*Trade, error) {
+	notification, err := a.client.GetTradeNotification(req)
+	if err != nil || notification == nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "支付宝通知验签失败: %v", err)
+	}
+	return &Trade{
+		OrderSn: notification.OutTradeNo,
+		TradeNo: notification.TradeNo,
+		Status:  string(notification.TradeStatus),
+		Amount:  parseYuan(notification.TotalAmount),
+		PayTime: parseLocalTime(notification.GmtPayment),
+		Raw:     req.PostForm.Encode(),
+	}, nil
+}
+
+func (a *alipayProvider) AckNotification(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusOK)
+	if err != nil {
+		_, _ = w.Write([]byte("fail"))
+		return
+	}
+	_, _ = w.Write([]byte("success"))
+}
+
+func (a *alipayProvider) QueryTrade(ctx context.Context, orderSn string) (*Trade, error) {
+	rsp, err := a.client.TradeQuery(ctx, alipay.TradeQuery{OutTradeNo: orderSn})
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "查询支付宝交易%s失败: %v", orderSn, err)
+	}
+	if rsp.IsFailure() {
+		if rsp.SubCode == alipayTradeNotExist {
+			return &Trade{OrderSn: orderSn, Status: TradeNotExist}, nil
+		}
+		return nil, errors.WithCode(code.ErrPayment, "查询支付宝交易%s失败: %s %s", orderSn, rsp.SubCode, rsp.SubMsg)
+	}
+	return &Trade{
+		OrderSn: rsp.OutTradeNo,
+		TradeNo: rsp.TradeNo,
+		Status:  string(rsp.TradeStatus),
+		Amount:  parseYuan(rsp.TotalAmount),
+		PayTime: parseLocalTime(rsp.SendPayDate),
+	}, nil
+}
+
+// Refund 使用 alipay.trade.refund 退款，RefundSn 作为 out_request_no 支持部分退款和重试
+func (a *alipayProvider) Refund(ctx context.Context, req *RefundRequest) error {
+	rsp, err := a.client.TradeRefund(ctx, alipay.TradeRefund{
+		OutTradeNo:   req.OrderSn,
+		RefundAmount: formatYuan(req.Amount),
+		RefundReason: req.Reason,
+		OutRequestNo: req.RefundSn,
+	})
+	if err != nil {
+		return errors.WithCode(code.ErrRefundPayment, "订单%s退款%s失败: %v", req.OrderSn, req.RefundSn, err)
+	}
+	if rsp.IsFailure() {
+		return errors.WithCode(code.ErrRefundPayment, "订单%s退款%s失败: %s %s", req.OrderSn, req.RefundSn, rsp.SubCode, rsp.SubMsg)
+	}
+	log.Infof("订单%s支付宝退款%s成功，累计退款%s", req.OrderSn, req.RefundSn, rsp.RefundFee)
+	return nil
+}
+
+func (a *alipayProvider) CloseTrade(ctx context.Context, orderSn string) error {
+	rsp, err := a.client.TradeClose(ctx, alipay.TradeClose{OutTradeNo: orderSn})
+	if err != nil {
+		return errors.WithCode(code.ErrPayment, "关闭支付宝交易%s失败: %v", orderSn, err)
+	}
+	if rsp.IsFailure() && rsp.SubCode != alipayTradeNotExist {
+		return errors.WithCode(code.ErrPayment, "关闭支付宝交易%s失败: %s %s", orderSn, rsp.SubCode, rsp.SubMsg)
+	}
+	return nil
+}
+
+// Statement 下载 trade 类型的日账单，账单是压缩的 CSV 文件
+func (a *alipayProvider) Statement(ctx context.Context, date time.Time) ([]*Trade, error) {
+	billDate := date.Format("2006-01-02")
+	rsp, err := a.client.BillDownloadURLQuery(ctx, alipay.BillDownloadURLQuery{BillType: "trade", BillDate: billDate})
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "查询支付宝对账单%s下载地址失败: %v", billDate, err)
+	}
+	if rsp.IsFailure() {
+		return nil, errors.WithCode(code.ErrPayment, "查询支付宝对账单%s下载地址失败: %s %s", billDate, rsp.SubCode, rsp.SubMsg)
+	}
+	data, err := download(ctx, http.DefaultClient, rsp.BillDownloadURL, "")
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "下载支付宝对账单%s失败: %v", billDate, err)
+	}
+	trades, err := parseAlipayBill(data)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "解析支付宝对账单%s失败: %v", billDate, err)
+	}
+	return trades, nil
+}
+
+var _ PaymentProvider = &alipayProvider{}
@@ -0,0 +1,151 @@
+package payment
+
+import (
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MockProvider 本地联调用的模拟支付，交易保存在内存中，网关和订单服务各自一份
+// 回调不验签，向 /pay/notify/mock 提交 out_trade_no、trade_status、total_amount（分，需与订单应付金额一致）即可模拟支付结果
+// 订单服务只接受支付方式为 mock 的订单的模拟通知，不能用来支付其他渠道的订单
+type MockProvider struct {
+	mu      sync.Mutex
+	trades  map[string]*Trade
+	refunds map[string]int64
+}
+
+func NewMockProvider() *MockProvider {
+	return &MockProvider{
+		trades:  make(map[string]*Trade),
+		refunds: make(map[string]int64),
+	}
+}
+
+func (m *MockProvider) Pay(ctx context.Context, req *PayRequest) (*PayResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.trades[req.OrderSn]; !ok {
+		m.trades[req.OrderSn] = &Trade{OrderSn: req.OrderSn, Status: TradeWaitBuyerPay, Amount: req.Amount}
+	}
+	url := "mock://pay/" + req.OrderSn + "?amount=" + strconv.FormatInt(req.Amount, 10)
+	return &PayResult{PayURL: url, QRCode: url}, nil
+}
+
+func (m *MockProvider) VerifyNotification(req *http.Request) (*Trade, error) {
+	if err := req.ParseForm(); err != nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "解析模拟支付通知失败: %v", err)
+	}
+	trade := &Trade{
+		OrderSn: req.Form.Get("out_trade_no"),
+		TradeNo: req.Form.Get("trade_no"),
+		Status:  req.Form.Get("trade_status"),
+		Raw:     req.Form.Encode(),
+	}
+	if trade.OrderSn == "" {
+		return nil, errors.WithCode(code.ErrPayNotification, "模拟支付通知缺少out_trade_no")
+	}
+	if trade.TradeNo == "" {
+		trade.TradeNo = "MOCK" + trade.OrderSn
+	}
+	if trade.Status == "" {
+		trade.Status = TradeSuccess
+	}
+	trade.Amount, _ = strconv.ParseInt(req.Form.Get("total_amount"), 10, 64)
+	if trade.Status == TradeSuccess || trade.Status == TradeFinished {
+		now := time.Now()
+		trade.PayTime = &now
+	}
+
+	m.mu.Lock()
+	m.trades[trade.OrderSn] = trade
+	m.mu.Unlock()
+	return trade, nil
+}
+
+func (m *MockProvider) AckNotification(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusOK)
+	if err != nil {
+		_, _ = w.Write([]byte("fail"))
+		return
+	}
+	_, _ = w.Write([]byte("success"))
+}
+
+func (m *MockProvider) QueryTrade(ctx context.Context, orderSn string) (*Trade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	trade, ok := m.trades[orderSn]
+	if !ok {
+		return &Trade{OrderSn: orderSn, Status: TradeNotExist}, nil
+	}
+	ret := *trade
+	return &ret, nil
+}
+
+func (m *MockProvider) Refund(ctx context.Context, req *RefundRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.refunds[req.RefundSn]; ok {
+		return nil
+	}
+	m.refunds[req.RefundSn] = req.Amount
+	log.Infof("订单%s模拟退款%s，金额%d分", req.OrderSn, req.RefundSn, req.Amount)
+	return nil
+}
+
+func (m *MockProvider) CloseTrade(ctx context.Context, orderSn string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	trade, ok := m.trades[orderSn]
+	if !ok {
+		return nil
+	}
+	if trade.Status == TradeSuccess || trade.Status == TradeFinished {
+		return errors.WithCode(code.ErrPayment, "模拟交易%s已支付，不能关闭", orderSn)
+	}
+	trade.Status = TradeClosed
+	return nil
+}
+
+// Statement 返回内存中当天支付成功的交易
+func (m *MockProvider) Statement(ctx context.Context, date time.Time) ([]*Trade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 0, 1)
+	var ret []*Trade
+	for _, trade := range m.trades {
+		if trade.PayTime == nil || trade.PayTime.Before(start) || !trade.PayTime.Before(end) {
+			continue
+		}
+		t := *trade
+		ret = append(ret, &t)
+	}
+	return ret, nil
+}
+
+// FakeRefundProvider 只模拟退款，支付、验签、查询和关单仍然调用真实渠道
+// 沙箱环境联调退款时使用，退款直接视为成功，同一个 RefundSn 只记录一次
+type FakeRefundProvider struct {
+	PaymentProvider
+	refunds *MockProvider
+}
+
+func NewFakeRefundProvider(provider PaymentProvider) *FakeRefundProvider {
+	return &FakeRefundProvider{PaymentProvider: provider, refunds: NewMockProvider()}
+}
+
+func (f *FakeRefundProvider) Refund(ctx context.Context, req *RefundRequest) error {
+	return f.refunds.Refund(ctx, req)
+}
+
+var (
+	_ PaymentProvider = &MockProvider{}
+	_ PaymentProvider = &FakeRefundProvider{}
+)
@@ -0,0 +1,157 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMockProviderRefund(t *testing.T) {
+	m := NewMockProvider()
+	requests := []*RefundRequest{
+		{OrderSn: "o1", RefundSn: "r1", Amount: 100, Total: 300},
+		{OrderSn: "o1", RefundSn: "r1", Amount: 100, Total: 300}, // 重试不重复退款
+		{OrderSn: "o1", RefundSn: "r2", Amount: 200, Total: 300}, // 同一交易的第二次部分退款
+	}
+	for _, req := range requests {
+		if err := m.Refund(context.Background(), req); err != nil {
+			t.Fatalf("Refund(%s) err: %v", req.RefundSn, err)
+		}
+	}
+	if len(m.refunds) != 2 || m.refunds["r1"] != 100 || m.refunds["r2"] != 200 {
+		t.Fatalf("refunds = %v, want map[r1:100 r2:200]", m.refunds)
+	}
+}
+
+func notifyRequest(form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/pay/notify/mock", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestMockProviderTrade(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		form       url.Values // 为空时不发通知
+		wantStatus string
+		wantAmount int64
+		closeErr   bool
+	}{
+		{
+			name:       "创建支付后等待付款，可以关单",
+			wantStatus: TradeWaitBuyerPay,
+			wantAmount: 1000,
+		},
+		{
+			name:       "通知支付成功后不能关单",
+			form:       url.Values{"out_trade_no": {"o1"}, "total_amount": {"1000"}},
+			wantStatus: TradeSuccess,
+			wantAmount: 1000,
+			closeErr:   true,
+		},
+		{
+			name:       "通知交易关闭",
+			form:       url.Values{"out_trade_no": {"o1"}, "trade_status": {TradeClosed}, "total_amount": {"1000"}},
+			wantStatus: TradeClosed,
+			wantAmount: 1000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockProvider()
+			if _, err := m.Pay(ctx, &PayRequest{OrderSn: "o1", Amount: 1000}); err != nil {
+				t.Fatalf("Pay err: %v", err)
+			}
+			if tt.form != nil {
+				if _, err := m.VerifyNotification(notifyRequest(tt.form)); err != nil {
+					t.Fatalf("VerifyNotification err: %v", err)
+				}
+			}
+			trade, err := m.QueryTrade(ctx, "o1")
+			if err != nil {
+				t.Fatalf("QueryTrade err: %v", err)
+			}
+			if trade.Status != tt.wantStatus || trade.Amount != tt.wantAmount {
+				t.Fatalf("trade = %s %d, want %s %d", trade.Status, trade.Amount, tt.wantStatus, tt.wantAmount)
+			}
+			if err := m.CloseTrade(ctx, "o1"); (err != nil) != tt.closeErr {
+				t.Fatalf("CloseTrade err = %v, want err %v", err, tt.closeErr)
+			}
+		})
+	}
+}
+
+func TestMockProviderNotificationWithoutOrderSn(t *testing.T) {
+	if _, err := NewMockProvider().VerifyNotification(notifyRequest(url.Values{"total_amount": {"1"}})); err == nil {
+		t.Fatal("缺少 out_trade_no 的通知应该被拒绝")
+	}
+}
+
+func TestMockProviderStatement(t *testing.T) {
+	m := NewMockProvider()
+	for _, sn := range []string{"o1", "o2"} {
+		if _, err := m.Pay(context.Background(), &PayRequest{OrderSn: sn, Amount: 100}); err != nil {
+			t.Fatalf("Pay err: %v", err)
+		}
+	}
+	if _, err := m.VerifyNotification(notifyRequest(url.Values{"out_trade_no": {"o1"}, "total_amount": {"100"}})); err != nil {
+		t.Fatalf("VerifyNotification err: %v", err)
+	}
+	trades, err := m.Statement(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("Statement err: %v", err)
+	}
+	if len(trades) != 1 || trades[0].OrderSn != "o1" {
+		t.Fatalf("对账单只应包含已支付的 o1, got %d", len(trades))
+	}
+	trades, _ = m.Statement(context.Background(), time.Now().AddDate(0, 0, -1))
+	if len(trades) != 0 {
+		t.Fatalf("前一天的对账单应为空, got %d", len(trades))
+	}
+}
+
+// stubProvider 记录被调用的方法，验签失败
+type stubProvider struct {
+	PaymentProvider
+	calls []string
+}
+
+func (s *stubProvider) VerifyNotification(req *http.Request) (*Trade, error) {
+	s.calls = append(s.calls, "VerifyNotification")
+	return nil, errors.New("签名错误")
+}
+
+func (s *stubProvider) Refund(ctx context.Context, req *RefundRequest) error {
+	s.calls = append(s.calls, "Refund")
+	return errors.New("不应调用真实渠道退款")
+}
+
+func TestFakeRefundProvider(t *testing.T) {
+	stub := &stubProvider{}
+	p := NewFakeRefundProvider(stub)
+
+	// 退款走模拟，不调用真实渠道
+	req := &RefundRequest{OrderSn: "o1", RefundSn: "r1", Amount: 100, Total: 100}
+	for i := 0; i < 2; i++ {
+		if err := p.Refund(context.Background(), req); err != nil {
+			t.Fatalf("Refund err: %v", err)
+		}
+	}
+	if len(p.refunds.refunds) != 1 {
+		t.Fatalf("同一个 RefundSn 应只记录一次, got %v", p.refunds.refunds)
+	}
+
+	// 支付通知仍然由真实渠道验签
+	if _, err := p.VerifyNotification(notifyRequest(url.Values{"out_trade_no": {"o1"}})); err == nil {
+		t.Fatal("伪造的通知应该验签失败")
+	}
+	if len(stub.calls) != 1 || stub.calls[0] != "VerifyNotification" {
+		t.Fatalf("真实渠道的调用 = %v, want [VerifyNotification]", stub.calls)
+	}
+}
@@ -0,0 +1,168 @@
+package payment
+
+import (
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 支付方式，对应订单的 PayType
+const (
+	PayTypeAlipay = "alipay" // 支付宝支付
+	PayTypeWechat = "wechat" // 微信支付
+	PayTypeMock   = "mock"   // 模拟支付，本地联调用
+)
+
+// 交易状态，各渠道的状态统一转换为支付宝的状态，订单服务的状态机直接使用
+const (
+	TradeWaitBuyerPay = "WAIT_BUYER_PAY"  // 交易创建，等待买家付款
+	TradeSuccess      = "TRADE_SUCCESS"   // 支付成功
+	TradeFinished     = "TRADE_FINISHED"  // 交易结束，不可退款
+	TradeClosed       = "TRADE_CLOSED"    // 未付款关闭，或支付后全额退款
+	TradeNotExist     = "TRADE_NOT_EXIST" // 渠道中没有这笔交易，用户还没有打开过支付页面
+)
+
+// PayRequest 创建支付的参数，金额单位为分
+type PayRequest struct {
+	OrderSn string
+	Amount  int64
+}
+
+// PayResult 支付宝返回收银台链接，微信 Native 支付返回二维码内容
+type PayResult struct {
+	PayURL string
+	QRCode string
+}
+
+// Trade 渠道中的一笔交易，异步通知和主动查询都转换为 Trade，金额单位为分
+type Trade struct {
+	OrderSn string
+	TradeNo string // 渠道交易号
+	Status  string
+	Amount  int64
+	PayTime *time.Time // 支付完成时间，未支付时为空
+	Raw     string     // 验签（解密）后的通知原文，只有异步通知才有
+}
+
+// RefundRequest 退款参数，RefundSn 用于同一笔交易的多次部分退款和重试幂等
+type RefundRequest struct {
+	OrderSn  string
+	RefundSn string
+	Amount   int64 // 本次退款金额（分）
+	Total    int64 // 原交易金额（分），微信退款需要
+	Reason   string
+}
+
+// PaymentProvider 支付渠道
+type PaymentProvider interface {
+	// Pay 创建支付，返回支付链接或二维码
+	Pay(ctx context.Context, req *PayRequest) (*PayResult, error)
+
+	// VerifyNotification 验证异步通知的签名并解析出交易
+	VerifyNotification(req *http.Request) (*Trade, error)
+
+	// AckNotification 按渠道要求的格式应答异步通知，err 不为空时渠道会稍后重发
+	AckNotification(w http.ResponseWriter, err error)
+
+	// QueryTrade 主动查询交易，渠道中没有这笔交易时状态为 TradeNotExist
+	QueryTrade(ctx context.Context, orderSn string) (*Trade, error)
+
+	// Refund 退款，同一个 RefundSn 重复调用不会重复退款
+	Refund(ctx context.Context, req *RefundRequest) error
+
+	// CloseTrade 关闭未支付的交易，交易不存在时视为成功
+	CloseTrade(ctx context.Context, orderSn string) error
+
+	// Statement 下载 date 当天的对账单，只返回支付成功的交易
+	Statement(ctx context.Context, date time.Time) ([]*Trade, error)
+}
+
+// Providers 按支付方式选择支付渠道
+type Providers struct {
+	providers map[string]PaymentProvider
+}
+
+// NewProviders 支付宝始终可用，微信支付和模拟支付按配置开启
+// 配置了 AlipayFakeRefund 时只有支付宝退款走模拟，支付通知仍然验签；模拟支付只注册在 mock 下
+func NewProviders(aliyunOpts *options.AliyunOptions, opts *options.PaymentOptions) (*Providers, error) {
+	p := &Providers{providers: make(map[string]PaymentProvider)}
+	alipay, err := NewAlipayProvider(aliyunOpts)
+	if err != nil {
+		return nil, err
+	}
+	if aliyunOpts.AlipayFakeRefund {
+		p.Register(PayTypeAlipay, NewFakeRefundProvider(alipay))
+	} else {
+		p.Register(PayTypeAlipay, alipay)
+	}
+	if opts.WechatEnable {
+		wechat, err := NewWechatProvider(opts)
+		if err != nil {
+			return nil, err
+		}
+		p.Register(PayTypeWechat, wechat)
+	}
+	if opts.Mock {
+		p.Register(PayTypeMock, NewMockProvider())
+	}
+	return p, nil
+}
+
+// PayTypes 已开启的支付方式
+func (p *Providers) PayTypes() []string {
+	ret := make([]string, 0, len(p.providers))
+	for payType := range p.providers {
+		ret = append(ret, payType)
+	}
+	return ret
+}
+
+// Register 注册或替换支付渠道
+func (p *Providers) Register(payType string, provider PaymentProvider) {
+	p.providers[payType] = provider
+}
+
+// Get 支付方式为空的是引入多渠道之前的订单，按支付宝处理
+func (p *Providers) Get(payType string) (PaymentProvider, error) {
+	if payType == "" {
+		payType = PayTypeAlipay
+	}
+	provider, ok := p.providers[payType]
+	if !ok {
+		return nil, errors.WithCode(code.ErrPayTypeUnsupported, "不支持的支付方式%s", payType)
+	}
+	return provider, nil
+}
+
+// Cents 元转换为分
+func Cents(yuan float64) int64 {
+	return int64(math.Round(yuan * 100))
+}
+
+// formatYuan 分转换为两位小数的元，支付宝金额使用这个格式
+func formatYuan(cents int64) string {
+	return strconv.FormatFloat(float64(cents)/100, 'f', 2, 64)
+}
+
+// parseYuan 解析支付宝的元金额，解析失败时返回 0
+func parseYuan(s string) int64 {
+	yuan, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return Cents(yuan)
+}
+
+// parseLocalTime 解析渠道返回的北京时间，解析失败时返回空
+func parseLocalTime(s string) *time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
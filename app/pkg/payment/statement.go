@@ -0,0 +1,148 @@
+package payment
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// 对账单只保留支付成功的交易，退款记录不参与对账
+
+// download 下载对账单文件，authorization 不为空时放在请求头中
+func download(ctx context.Context, client *http.Client, url, authorization string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rsp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http status %d", rsp.StatusCode)
+	}
+	return io.ReadAll(rsp.Body)
+}
+
+// readBill 读取 CSV 对账单，各行的列数可以不同
+func readBill(data []byte) ([][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.Comment = '#'
+	return reader.ReadAll()
+}
+
+// parseAlipayBill 支付宝账单是 GBK 编码的 zip 包，包含业务明细和汇总两个文件
+// 只按列的位置读取交易号、订单号、时间和金额这些 ASCII 字段，不需要转码：
+// 0 支付宝交易号 1 商户订单号 5 完成时间 11 订单金额（元） 21 退款批次号
+func parseAlipayBill(data []byte) ([]*Trade, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var trades []*Trade
+	for _, file := range archive.File {
+		f, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := readBill(content)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			// 汇总文件和表头的第一列不是交易号
+			if len(row) < 12 || !isDigits(strings.TrimSpace(row[0])) {
+				continue
+			}
+			if len(row) > 21 && strings.TrimSpace(row[21]) != "" {
+				continue
+			}
+			amount := parseYuan(strings.TrimSpace(row[11]))
+			if amount <= 0 {
+				continue
+			}
+			trades = append(trades, &Trade{
+				OrderSn: strings.TrimSpace(row[1]),
+				TradeNo: strings.TrimSpace(row[0]),
+				Status:  TradeSuccess,
+				Amount:  amount,
+				PayTime: parseLocalTime(strings.TrimSpace(row[5])),
+			})
+		}
+	}
+	return trades, nil
+}
+
+// parseWechatBill 微信账单是 UTF-8 的 CSV，第一行是表头，每个字段以 ` 开头，最后两行是汇总
+func parseWechatBill(data []byte) ([]*Trade, error) {
+	rows, err := readBill(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	amountColumn := "订单金额"
+	if _, ok := columns[amountColumn]; !ok {
+		amountColumn = "应结订单金额"
+	}
+	for _, name := range []string{"交易时间", "微信订单号", "商户订单号", "交易状态", amountColumn} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("对账单缺少%s列", name)
+		}
+	}
+
+	var trades []*Trade
+	for _, row := range rows[1:] {
+		if len(row) < len(rows[0]) || !strings.HasPrefix(row[0], "`") {
+			// 汇总部分
+			break
+		}
+		field := func(name string) string {
+			return strings.TrimSpace(strings.TrimPrefix(row[columns[name]], "`"))
+		}
+		if field("交易状态") != "SUCCESS" {
+			continue
+		}
+		trades = append(trades, &Trade{
+			OrderSn: field("商户订单号"),
+			TradeNo: field("微信订单号"),
+			Status:  TradeSuccess,
+			Amount:  parseYuan(field(amountColumn)),
+			PayTime: parseLocalTime(field("交易时间")),
+		})
+	}
+	return trades, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package payment
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// alipayRow 按支付宝业务明细的列位置构造一行，只填解析用到的列
+func alipayRow(tradeNo, orderSn, finish, amount, refundBatch string) string {
+	cols := make([]string, 25)
+	cols[0], cols[1], cols[5], cols[11], cols[21] = tradeNo, orderSn, finish, amount, refundBatch
+	return strings.Join(cols, ",")
+}
+
+func alipayZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseAlipayBill(t *testing.T) {
+	detail := strings.Join([]string{
+		"#支付宝业务明细查询",
+		"#账号:[20880000000000000156]",
+		alipayRow("支付宝交易号", "商户订单号", "完成时间", "订单金额（元）", "退款批次号/请求号"),
+		alipayRow("2026101822001", "o1", "2026-10-18 10:00:00", "12.34", ""),
+		alipayRow("2026101822002", "o2", "2026-10-18 11:00:00", "5.00", "r1"), // 退款
+		alipayRow("2026101822003", "o3", "2026-10-18 12:00:00", "0.00", ""),   // 金额为 0
+		"#-----------------------------------------业务明细列表结束------------------------------------",
+	}, "\n")
+	summary := "#支付宝业务汇总查询\n支付宝,1,12.34\n"
+	data := alipayZip(t, map[string]string{"detail.csv": detail, "summary.csv": summary})
+
+	trades, err := parseAlipayBill(data)
+	if err != nil {
+		t.Fatalf("parseAlipayBill err: %v", err)
+	}
+	if len(trades) != 1 {
+		t.Fatalf("trades = %d, want 1", len(trades))
+	}
+	got := trades[0]
+	if got.OrderSn != "o1" || got.TradeNo != "2026101822001" || got.Amount != 1234 || got.Status != TradeSuccess {
+		t.Fatalf("trade = %+v", got)
+	}
+	if got.PayTime == nil || got.PayTime.Format("2006-01-02 15:04:05") != "2026-10-18 10:00:00" {
+		t.Fatalf("PayTime = %v", got.PayTime)
+	}
+
+	if _, err := parseAlipayBill([]byte("not a zip")); err == nil {
+		t.Fatal("非 zip 内容应当返回错误")
+	}
+}
+
+func TestParseWechatBill(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []Trade
+		wantErr bool
+	}{
+		{
+			name: "只保留成功的交易并在汇总处停止",
+			data: "\xef\xbb\xbf交易时间,微信订单号,商户订单号,交易状态,订单金额\n" +
+				"`2026-10-18 10:00:00,`4200001,`o1,`SUCCESS,`12.34\n" +
+				"`2026-10-18 11:00:00,`4200002,`o2,`REFUND,`5.00\n" +
+				"总交易单数,总交易额\n" +
+				"`2,`17.34\n",
+			want: []Trade{{OrderSn: "o1", TradeNo: "4200001", Amount: 1234}},
+		},
+		{
+			name: "没有订单金额时使用应结订单金额",
+			data: "交易时间,微信订单号,商户订单号,交易状态,应结订单金额\n" +
+				"`2026-10-18 10:00:00,`4200003,`o3,`SUCCESS,`1.00\n",
+			want: []Trade{{OrderSn: "o3", TradeNo: "4200003", Amount: 100}},
+		},
+		{
+			name:    "缺少列",
+			data:    "交易时间,微信订单号,交易状态,订单金额\n",
+			wantErr: true,
+		},
+		{
+			name: "空账单",
+			data: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trades, err := parseWechatBill([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(trades) != len(tt.want) {
+				t.Fatalf("trades = %d, want %d", len(trades), len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := trades[i]
+				if got.OrderSn != want.OrderSn || got.TradeNo != want.TradeNo || got.Amount != want.Amount ||
+					got.Status != TradeSuccess || got.PayTime == nil {
+					t.Fatalf("trades[%d] = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsDigits(t *testing.T) {
+	tests := map[string]bool{
+		"":              false,
+		"2026101822001": true,
+		"20261018a":     false,
+		"支付宝交易号":        false,
+		" 123":          false,
+	}
+	for s, want := range tests {
+		if got := isDigits(s); got != want {
+			t.Errorf("isDigits(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,412 @@
+package payment
+
+import (
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 微信支付 API v3：
+// 1. 请求使用商户私钥 SHA256-RSA 签名，应答和回调使用微信支付公钥验签
+// 2. 回调的交易数据使用 APIv3 密钥 AES-256-GCM 加密
+// 3. 金额单位为分
+
+const (
+	wechatHost = "https://api.mch.weixin.qq.com"
+	// 回调和应答的时间戳与本地时间相差超过这个值时拒绝，防止重放
+	wechatMaxClockSkew = 5 * time.Minute
+	// 微信支付返回的订单不存在错误码
+	wechatOrderNotExist = "ORDER_NOT_EXIST"
+)
+
+// 微信交易状态和统一交易状态的对应关系
+var wechatTradeStates = map[string]string{
+	"SUCCESS":    TradeSuccess,
+	"REFUND":     TradeSuccess, // 支付后发生了退款，交易本身是支付成功的
+	"NOTPAY":     TradeWaitBuyerPay,
+	"USERPAYING": TradeWaitBuyerPay,
+	"CLOSED":     TradeClosed,
+	"REVOKED":    TradeClosed,
+	"PAYERROR":   TradeClosed,
+}
+
+type wechatProvider struct {
+	opts        *options.PaymentOptions
+	client      *http.Client
+	privateKey  *rsa.PrivateKey
+	platformKey *rsa.PublicKey
+}
+
+// NewWechatProvider Native 扫码支付
+func NewWechatProvider(opts *options.PaymentOptions) (PaymentProvider, error) {
+	privateKey, err := parsePrivateKey(opts.WechatPrivateKey)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "解析微信支付商户私钥失败: %v", err)
+	}
+	platformKey, err := parsePublicKey(opts.WechatPlatformPublicKey)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "解析微信支付公钥失败: %v", err)
+	}
+	return &wechatProvider{
+		opts:        opts,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		privateKey:  privateKey,
+		platformKey: platformKey,
+	}, nil
+}
+
+type wechatAmount struct {
+	Total    int64  `json:"total,omitempty"`
+	Refund   int64  `json:"refund,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+type wechatTransaction struct {
+	OutTradeNo    string       `json:"out_trade_no"`
+	TransactionId string       `json:"transaction_id"`
+	TradeState    string       `json:"trade_state"`
+	SuccessTime   string       `json:"success_time"`
+	Amount        wechatAmount `json:"amount"`
+}
+
+func (t *wechatTransaction) trade() *Trade {
+	status, ok := wechatTradeStates[t.TradeState]
+	if !ok {
+		status = t.TradeState
+	}
+	trade := &Trade{OrderSn: t.OutTradeNo, TradeNo: t.TransactionId, Status: status, Amount: t.Amount.Total}
+	if payTime, err := time.Parse(time.RFC3339, t.SuccessTime); err == nil {
+		trade.PayTime = &payTime
+	}
+	return trade
+}
+
+// wechatError 微信支付的错误应答
+type wechatError struct {
+	Status  int
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *wechatError) Error() string {
+	return fmt.Sprintf("%d %s %s", e.Status, e.Code, e.Message)
+}
+
+func (w *wechatProvider) Pay(ctx context.Context, req *PayRequest) (*PayResult, error) {
+	var rsp struct {
+		CodeURL string `json:"code_url"`
+	}
+	err := w.do(ctx, http.MethodPost, "/v3/pay/transactions/native", map[string]interface{}{
+		"appid":        w.opts.WechatAppId,
+		"mchid":        w.opts.WechatMchId,
+		"description":  w.opts.WechatDescription + req.OrderSn,
+		"out_trade_no": req.OrderSn,
+		"notify_url":   w.opts.WechatNotifyUrl,
+		"amount":       wechatAmount{Total: req.Amount, Currency: "CNY"},
+	}, &rsp)
+	if err != nil {
+		log.Errorf("订单%s创建微信支付失败: %v", req.OrderSn, err)
+		return nil, errors.WithCode(code.ErrPayment, "创建微信支付失败: %v", err)
+	}
+	return &PayResult{QRCode: rsp.CodeURL}, nil
+}
+
+func (w *wechatProvider) VerifyNotification(req *http.Request) (*Trade, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "读取微信支付通知失败: %v", err)
+	}
+	if err := w.verify(req.Header, body); err != nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "微信支付通知验签失败: %v", err)
+	}
+
+	var notification struct {
+		EventType string `json:"event_type"`
+		Resource  struct {
+			Algorithm      string `json:"algorithm"`
+			Ciphertext     string `json:"ciphertext"`
+			AssociatedData string `json:"associated_data"`
+			Nonce          string `json:"nonce"`
+		} `json:"resource"`
+	}
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "解析微信支付通知失败: %v", err)
+	}
+	plaintext, err := w.decrypt(notification.Resource.Ciphertext, notification.Resource.Nonce, notification.Resource.AssociatedData)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "解密微信支付通知失败: %v", err)
+	}
+	var transaction wechatTransaction
+	if err := json.Unmarshal(plaintext, &transaction); err != nil {
+		return nil, errors.WithCode(code.ErrPayNotification, "解析微信支付交易失败: %v", err)
+	}
+	trade := transaction.trade()
+	trade.Raw = string(plaintext)
+	return trade, nil
+}
+
+// AckNotification 成功时返回 204，失败时返回 500，微信会按策略重发
+func (w *wechatProvider) AckNotification(rw http.ResponseWriter, err error) {
+	if err == nil {
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusInternalServerError)
+	_ = json.NewEncoder(rw).Encode(map[string]string{"code": "FAIL", "message": err.Error()})
+}
+
+func (w *wechatProvider) QueryTrade(ctx context.Context, orderSn string) (*Trade, error) {
+	var transaction wechatTransaction
+	path := "/v3/pay/transactions/out-trade-no/" + url.PathEscape(orderSn) + "?mchid=" + url.QueryEscape(w.opts.WechatMchId)
+	err := w.do(ctx, http.MethodGet, path, nil, &transaction)
+	if err != nil {
+		if werr, ok := err.(*wechatError); ok && werr.Code == wechatOrderNotExist {
+			return &Trade{OrderSn: orderSn, Status: TradeNotExist}, nil
+		}
+		return nil, errors.WithCode(code.ErrPayment, "查询微信支付交易%s失败: %v", orderSn, err)
+	}
+	return transaction.trade(), nil
+}
+
+// Refund RefundSn 作为 out_refund_no，重复提交同一个退款单号不会重复退款
+func (w *wechatProvider) Refund(ctx context.Context, req *RefundRequest) error {
+	var rsp struct {
+		RefundId string `json:"refund_id"`
+		Status   string `json:"status"`
+	}
+	err := w.do(ctx, http.MethodPost, "/v3/refund/domestic/refunds", map[string]interface{}{
+		"out_trade_no":  req.OrderSn,
+		"out_refund_no": req.RefundSn,
+		"reason":        req.Reason,
+		"amount":        wechatAmount{Refund: req.Amount, Total: req.Total, Currency: "CNY"},
+	}, &rsp)
+	if err != nil {
+		return errors.WithCode(code.ErrRefundPayment, "订单%s退款%s失败: %v", req.OrderSn, req.RefundSn, err)
+	}
+	// PROCESSING 表示退款已受理，微信会在到账后完成，ABNORMAL 和 CLOSED 需要人工处理
+	if rsp.Status == "ABNORMAL" || rsp.Status == "CLOSED" {
+		return errors.WithCode(code.ErrRefundPayment, "订单%s退款%s状态异常: %s", req.OrderSn, req.RefundSn, rsp.Status)
+	}
+	log.Infof("订单%s微信支付退款%s已受理，状态%s", req.OrderSn, req.RefundSn, rsp.Status)
+	return nil
+}
+
+func (w *wechatProvider) CloseTrade(ctx context.Context, orderSn string) error {
+	path := "/v3/pay/transactions/out-trade-no/" + url.PathEscape(orderSn) + "/close"
+	err := w.do(ctx, http.MethodPost, path, map[string]string{"mchid": w.opts.WechatMchId}, nil)
+	if err != nil {
+		if werr, ok := err.(*wechatError); ok && werr.Code == wechatOrderNotExist {
+			return nil
+		}
+		return errors.WithCode(code.ErrPayment, "关闭微信支付交易%s失败: %v", orderSn, err)
+	}
+	return nil
+}
+
+// Statement 下载支付成功的交易账单，下载地址 5 分钟内有效，文件用 SHA1 校验
+func (w *wechatProvider) Statement(ctx context.Context, date time.Time) ([]*Trade, error) {
+	billDate := date.Format("2006-01-02")
+	var rsp struct {
+		HashType    string `json:"hash_type"`
+		HashValue   string `json:"hash_value"`
+		DownloadUrl string `json:"download_url"`
+	}
+	err := w.do(ctx, http.MethodGet, "/v3/bill/tradebill?bill_type=SUCCESS&bill_date="+billDate, nil, &rsp)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "申请微信支付对账单%s失败: %v", billDate, err)
+	}
+	u, err := url.Parse(rsp.DownloadUrl)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "微信支付对账单%s下载地址不合法: %v", billDate, err)
+	}
+	authorization, err := w.authorization(http.MethodGet, u.RequestURI(), nil)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "微信支付请求签名失败: %v", err)
+	}
+	data, err := download(ctx, w.client, rsp.DownloadUrl, authorization)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "下载微信支付对账单%s失败: %v", billDate, err)
+	}
+	if rsp.HashType == "SHA1" {
+		sum := sha1.Sum(data)
+		if !strings.EqualFold(hex.EncodeToString(sum[:]), rsp.HashValue) {
+			return nil, errors.WithCode(code.ErrPayment, "微信支付对账单%s校验失败", billDate)
+		}
+	}
+	trades, err := parseWechatBill(data)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrPayment, "解析微信支付对账单%s失败: %v", billDate, err)
+	}
+	return trades, nil
+}
+
+// do 发送签名请求并验证应答签名，out 为空时忽略应答内容
+func (w *wechatProvider) do(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var payload []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = data
+	}
+	req, err := http.NewRequestWithContext(ctx, method, wechatHost+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	authorization, err := w.authorization(method, path, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+	if rsp.StatusCode >= http.StatusMultipleChoices {
+		werr := &wechatError{Status: rsp.StatusCode}
+		_ = json.Unmarshal(data, werr)
+		return werr
+	}
+	if err := w.verify(rsp.Header, data); err != nil {
+		return fmt.Errorf("应答验签失败: %v", err)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// authorization 请求签名串为 方法\nURL\n时间戳\n随机串\n请求体\n
+func (w *wechatProvider) authorization(method, path string, body []byte) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	nonceStr := hex.EncodeToString(nonce)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	message := method + "\n" + path + "\n" + timestamp + "\n" + nonceStr + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, w.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`WECHATPAY2-SHA256-RSA2048 mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		w.opts.WechatMchId, nonceStr, base64.StdEncoding.EncodeToString(signature), timestamp, w.opts.WechatSerialNo), nil
+}
+
+// verify 应答和回调的验签串为 时间戳\n随机串\n报文\n
+func (w *wechatProvider) verify(header http.Header, body []byte) error {
+	timestamp := header.Get("Wechatpay-Timestamp")
+	nonce := header.Get("Wechatpay-Nonce")
+	signature, err := base64.StdEncoding.DecodeString(header.Get("Wechatpay-Signature"))
+	if err != nil || timestamp == "" || nonce == "" {
+		return fmt.Errorf("缺少签名信息")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("时间戳不合法")
+	}
+	if skew := time.Since(time.Unix(ts, 0)); skew > wechatMaxClockSkew || skew < -wechatMaxClockSkew {
+		return fmt.Errorf("时间戳已过期")
+	}
+	message := timestamp + "\n" + nonce + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	return rsa.VerifyPKCS1v15(w.platformKey, crypto.SHA256, hashed[:], signature)
+}
+
+// decrypt AEAD_AES_256_GCM 解密回调中的资源数据
+func (w *wechatProvider) decrypt(ciphertext, nonce, associatedData string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher([]byte(w.opts.WechatAPIv3Key))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(nonce))
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, []byte(nonce), data, []byte(associatedData))
+}
+
+func parsePrivateKey(key string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, fmt.Errorf("不是PEM格式")
+	}
+	if pk, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return pk, nil
+	}
+	pk, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := pk.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("不是RSA私钥")
+	}
+	return rsaKey, nil
+}
+
+// parsePublicKey 支持微信支付公钥和平台证书两种格式
+func parsePublicKey(key string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, fmt.Errorf("不是PEM格式")
+	}
+	var pub interface{}
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		pub = cert.PublicKey
+	} else {
+		var err error
+		pub, err = x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rsaKey, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("不是RSA公钥")
+	}
+	return rsaKey, nil
+}
+
+var _ PaymentProvider = &wechatProvider{}
@@ -44,3 +44,17 @@ func claimHandlerFunc(c *gin.Context) interface{} {
 	c.Set(middlewares.KeyRole, claims[middlewares.KeyRole])
 	return claims[middlewares.KeyUserID]
 }
+
+// optionalAuth 带了 token 时按 jwt 校验，没带时直接放行，由接口自己决定未登录时的处理
+func optionalAuth(strategy middlewares.AuthStrategy) gin.HandlerFunc {
+	authFunc := strategy.AuthFunc()
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" && c.Query("token") == "" {
+			if _, err := c.Cookie("jwt"); err != nil {
+				c.Next()
+				return
+			}
+		}
+		authFunc(c)
+	}
+}
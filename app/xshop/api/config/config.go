@@ -15,6 +15,8 @@ type Config struct {
 	Sms       *options.SmsOptions       `json:"sms" mapstructure:"sms"`
 	Redis     *options.RedisOptions     `json:"redis" mapstructure:"redis"`
 	Aliyun    *options.AliyunOptions    `json:"aliyun" mapstructure:"aliyun"`
+	Payment   *options.PaymentOptions   `json:"payment" mapstructure:"payment"`
+	Cart      *options.CartOptions      `json:"cart" mapstructure:"cart"`
 }
 
 func (c *Config) Validate() []error {
@@ -26,6 +28,8 @@ func (c *Config) Validate() []error {
 	errors = append(errors, c.Sms.Validate()...)
 	errors = append(errors, c.Redis.Validate()...)
 	errors = append(errors, c.Aliyun.Validate()...)
+	errors = append(errors, c.Payment.Validate()...)
+	errors = append(errors, c.Cart.Validate()...)
 	return errors
 }
 
@@ -37,6 +41,8 @@ func (c *Config) Flags() (fss cliflag.NamedFlagSets) {
 	c.Sms.AddFlags(fss.FlagSet("sms"))
 	c.Redis.AddFlags(fss.FlagSet("redis"))
 	c.Aliyun.AddFlags(fss.FlagSet("aliyun"))
+	c.Payment.AddFlags(fss.FlagSet("payment"))
+	c.Cart.AddFlags(fss.FlagSet("cart"))
 	return fss
 }
 
@@ -50,5 +56,7 @@ func New() *Config {
 		Sms:      options.NewSmsOptions(),
 		Redis:    options.NewRedisOptions(),
 		Aliyun:   options.NewAliyunOptions(),
+		Payment:  options.NewPaymentOptions(),
+		Cart:     options.NewCartOptions(),
 	}
 }
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	proto "Advanced_Shop/api/action/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/common"
+	gin2 "Advanced_Shop/app/pkg/translator/gin"
+	"Advanced_Shop/app/xshop/api/internal/domain/request/action"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// CouponTemplateListView 当前可以领取的优惠券
+func (ac *actionController) CouponTemplateListView(c *gin.Context) error {
+	log.Info("coupon template list function called ...")
+	return ac.couponTemplateList(c, true)
+}
+
+// AdminCouponTemplateListView 管理员查看全部优惠券模板
+func (ac *actionController) AdminCouponTemplateListView(c *gin.Context) error {
+	log.Info("admin coupon template list function called ...")
+	_, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	return ac.couponTemplateList(c, false)
+}
+
+func (ac *actionController) couponTemplateList(c *gin.Context, available bool) error {
+	var page common.PageInfo
+	if err := c.ShouldBindQuery(&page); err != nil {
+		return gin2.HandleValidatorError(c, err, ac.trans)
+	}
+
+	list, err := ac.srv.Coupon().CouponTemplateList(c.Request.Context(), &proto.CouponTemplateFilter{
+		Available:   available,
+		Pages:       page.Page,
+		PagePerNums: page.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := make([]*action.CouponTemplateResponse, 0, len(list.Data))
+	for _, template := range list.Data {
+		response = append(response, couponTemplateResponse(template))
+	}
+	common.OkWithList(c, response, list.Total)
+	return nil
+}
+
+func (ac *actionController) CreateCouponTemplateView(c *gin.Context) error {
+	log.Info("coupon template create function called ...")
+	_, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var cr action.CouponTemplateRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, ac.trans)
+	}
+
+	template, err := ac.srv.Coupon().CreateCouponTemplate(c.Request.Context(), couponTemplateRequest(0, &cr))
+	if err != nil {
+		return err
+	}
+	common.OkWithData(c, couponTemplateResponse(template))
+	return nil
+}
+
+func (ac *actionController) UpdateCouponTemplateView(c *gin.Context) error {
+	log.Info("coupon template update function called ...")
+	_, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var uri action.CouponTemplateIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, ac.trans)
+	}
+	var cr action.CouponTemplateRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, ac.trans)
+	}
+
+	if _, err := ac.srv.Coupon().UpdateCouponTemplate(c.Request.Context(), couponTemplateRequest(uri.Id, &cr)); err != nil {
+		return err
+	}
+	common.OkWithMessage(c, "修改成功")
+	return nil
+}
+
+// ClaimCouponView 领取优惠券到券包，名额和每人限领由营销服务在 Redis 中原子扣减
+func (ac *actionController) ClaimCouponView(c *gin.Context) error {
+	log.Info("coupon claim function called ...")
+	userID, _, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	var uri action.CouponTemplateIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, ac.trans)
+	}
+
+	coupon, err := ac.srv.Coupon().ClaimCoupon(c.Request.Context(), &proto.ClaimCouponRequest{
+		UserId:     userID,
+		TemplateId: uri.Id,
+	})
+	if err != nil {
+		return err
+	}
+	common.OkWithData(c, userCouponResponse(coupon))
+	return nil
+}
+
+// UserCouponListView 我的券包
+func (ac *actionController) UserCouponListView(c *gin.Context) error {
+	log.Info("user coupon list function called ...")
+	userID, _, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	var cr action.UserCouponListRequest
+	if err := c.ShouldBindQuery(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, ac.trans)
+	}
+
+	list, err := ac.srv.Coupon().UserCouponList(c.Request.Context(), &proto.UserCouponFilter{
+		UserId:      userID,
+		Status:      cr.Status,
+		Pages:       cr.Page,
+		PagePerNums: cr.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := make([]action.UserCouponResponse, 0, len(list.Data))
+	for _, coupon := range list.Data {
+		response = append(response, userCouponResponse(coupon))
+	}
+	common.OkWithList(c, response, list.Total)
+	return nil
+}
+
+func couponTemplateRequest(id int32, cr *action.CouponTemplateRequest) *proto.CouponTemplateRequest {
+	return &proto.CouponTemplateRequest{
+		Id:        id,
+		Name:      cr.Name,
+		Type:      cr.Type,
+		Category:  cr.Category,
+		Threshold: cr.Threshold,
+		Amount:    cr.Amount,
+		Percent:   cr.Percent,
+		Total:     cr.Total,
+		PerUser:   cr.PerUser,
+		ValidDays: cr.ValidDays,
+		StartAt:   cr.StartAt,
+		EndAt:     cr.EndAt,
+		Enabled:   cr.Enabled,
+	}
+}
+
+func couponTemplateResponse(template *proto.CouponTemplateResponse) *action.CouponTemplateResponse {
+	if template == nil {
+		return nil
+	}
+	return &action.CouponTemplateResponse{
+		Id:        template.Id,
+		Name:      template.Name,
+		Type:      template.Type,
+		Category:  template.Category,
+		Threshold: template.Threshold,
+		Amount:    template.Amount,
+		Percent:   template.Percent,
+		Total:     template.Total,
+		PerUser:   template.PerUser,
+		Issued:    template.Issued,
+		ValidDays: template.ValidDays,
+		StartAt:   template.StartAt,
+		EndAt:     template.EndAt,
+		Enabled:   template.Enabled,
+	}
+}
+
+func userCouponResponse(coupon *proto.UserCouponResponse) action.UserCouponResponse {
+	return action.UserCouponResponse{
+		Id:       coupon.Id,
+		Code:     coupon.Code,
+		Status:   coupon.Status,
+		OrderSn:  coupon.OrderSn,
+		ExpireAt: coupon.ExpireAt,
+		UsedAt:   coupon.UsedAt,
+		Template: couponTemplateResponse(coupon.Template),
+	}
+}
@@ -35,6 +35,8 @@ func (gc *goodsController) GetGoodListView(c *gin.Context) error {
 		return gin2.HandleValidatorError(c, err, gc.trans)
 	}
 	ctx := c.Request.Context()
+	// 商城只展示在售商品
+	onSale := true
 	list, err := gc.srv.Goods().List(ctx, &proto.GoodsFilterRequest{
 		PriceMin:      cr.PriceMin,
 		PriceMax:      cr.PriceMax,
@@ -45,6 +47,9 @@ func (gc *goodsController) GetGoodListView(c *gin.Context) error {
 		PagePerNums:   cr.Limit,
 		KeyWords:      cr.Key,
 		BrandID:       cr.BrandID,
+		Sort:          cr.Sort,
+		ShipFree:      cr.ShipFree,
+		OnSale:        &onSale,
 	})
 	if err != nil {
 		log.Errorf("get goods list error %v", err)
@@ -63,7 +68,11 @@ func (gc *goodsController) GetGoodListView(c *gin.Context) error {
 			Stocks:          model.Stocks,
 			MarketPrice:     model.MarketPrice,
 			ShopPrice:       model.ShopPrice,
+			MaxPrice:        model.MaxPrice,
+			HasSku:          model.HasSku,
 			GoodsBrief:      model.GoodsBrief,
+			NameHighlight:   model.NameHighlight,
+			BriefHighlight:  model.BriefHighlight,
 			GoodsDesc:       model.GoodsDesc,
 			ShipFree:        model.ShipFree,
 			Images:          model.Images,
@@ -73,6 +82,8 @@ func (gc *goodsController) GetGoodListView(c *gin.Context) error {
 			IsHot:           model.IsHot,
 			OnSale:          model.OnSale,
 			AddTime:         model.AddTime,
+			MerchantID:      model.MerchantId,
+			PurchaseLimit:   model.PurchaseLimit,
 			Category: good.CategoryBriefInfoResponse{
 				ID:   model.Category.Id,
 				Name: model.Category.Name,
@@ -85,10 +96,70 @@ func (gc *goodsController) GetGoodListView(c *gin.Context) error {
 		}
 		response = append(response, info)
 	}
-	common.OkWithList(c, response, list.Total)
+	common.OkWithData(c, good.GoodListResponse{
+		List:   response,
+		Count:  list.Total,
+		Facets: searchFacets(list.Facets),
+	})
+	return nil
+}
+
+// GoodsSuggestView 搜索建议，没有输入时返回热搜词
+func (gc *goodsController) GoodsSuggestView(c *gin.Context) error {
+	var cr good.GoodsSuggestRequest
+	if err := c.ShouldBindQuery(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+	suggest, err := gc.srv.Goods().SuggestGoods(c.Request.Context(), &proto.SuggestRequest{
+		KeyWords: cr.Key,
+		Size:     cr.Size,
+	})
+	if err != nil {
+		log.Errorf("suggest goods error %v", err)
+		return err
+	}
+	response := good.GoodsSuggestResponse{
+		Suggestions: suggest.Suggestions,
+		HotTerms:    make([]good.HotTermResponse, 0, len(suggest.HotTerms)),
+	}
+	if response.Suggestions == nil {
+		response.Suggestions = []string{}
+	}
+	for _, term := range suggest.HotTerms {
+		response.HotTerms = append(response.HotTerms, good.HotTermResponse{
+			Term:  term.Term,
+			Count: term.Count,
+		})
+	}
+	common.OkWithData(c, response)
 	return nil
 }
 
+func searchFacets(facets *proto.SearchFacets) *good.SearchFacets {
+	if facets == nil {
+		return nil
+	}
+	return &good.SearchFacets{
+		Brands:        facetBuckets(facets.Brands),
+		Categories:    facetBuckets(facets.Categories),
+		Prices:        facetBuckets(facets.Prices),
+		PriceInterval: facets.PriceInterval,
+		ShipFree:      facetBuckets(facets.ShipFree),
+	}
+}
+
+func facetBuckets(buckets []*proto.FacetBucket) []good.FacetBucket {
+	ret := make([]good.FacetBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		ret = append(ret, good.FacetBucket{
+			Key:   bucket.Key,
+			Name:  bucket.Name,
+			Count: bucket.Count,
+		})
+	}
+	return ret
+}
+
 func (gc *goodsController) CreateGoodView(c *gin.Context) error {
 
 	var cr good.GoodCreateRequest
@@ -110,6 +181,9 @@ func (gc *goodsController) CreateGoodView(c *gin.Context) error {
 		GoodsFrontImage: cr.FrontImage,
 		CategoryId:      cr.CategoryId,
 		BrandId:         cr.Brand,
+		MerchantId:      cr.Merchant,
+
+		PurchaseLimit: cr.PurchaseLimit,
 	})
 	if err != nil {
 		return err
@@ -151,6 +225,8 @@ func (gc *goodsController) GoodDetailView(c *gin.Context) error {
 		Stocks:          detail.Num,
 		MarketPrice:     goodInfo.MarketPrice,
 		ShopPrice:       goodInfo.ShopPrice,
+		MaxPrice:        goodInfo.MaxPrice,
+		HasSku:          goodInfo.HasSku,
 		GoodsBrief:      goodInfo.GoodsBrief,
 		GoodsDesc:       goodInfo.GoodsDesc,
 		ShipFree:        goodInfo.ShipFree,
@@ -161,6 +237,8 @@ func (gc *goodsController) GoodDetailView(c *gin.Context) error {
 		IsHot:           goodInfo.IsHot,
 		OnSale:          goodInfo.OnSale,
 		AddTime:         goodInfo.AddTime,
+		MerchantID:      goodInfo.MerchantId,
+		PurchaseLimit:   goodInfo.PurchaseLimit,
 		Category: good.CategoryBriefInfoResponse{
 			ID:   goodInfo.Category.Id,
 			Name: goodInfo.Category.Name,
@@ -171,6 +249,10 @@ func (gc *goodsController) GoodDetailView(c *gin.Context) error {
 			Logo: goodInfo.Brand.Logo,
 		},
 	}
+	response.Skus, err = gc.skuResponses(ctx, goodInfo.Id, goodInfo.Skus)
+	if err != nil {
+		return err
+	}
 
 	common.OkWithData(c, response)
 	return nil
@@ -204,6 +286,9 @@ func (gc *goodsController) GoodUpdateView(c *gin.Context) error {
 		GoodsFrontImage: cr.FrontImage,
 		CategoryId:      cr.CategoryId,
 		BrandId:         cr.Brand,
+		MerchantId:      cr.Merchant,
+
+		PurchaseLimit: cr.PurchaseLimit,
 	})
 
 	if err != nil {
@@ -0,0 +1,196 @@
+package goods
+
+import (
+	proto "Advanced_Shop/api/goods/v1"
+	ipb "Advanced_Shop/api/inventory/v1"
+	"Advanced_Shop/app/pkg/common"
+	gin2 "Advanced_Shop/app/pkg/translator/gin"
+	"Advanced_Shop/app/xshop/api/internal/domain/request/good"
+	"context"
+	"github.com/gin-gonic/gin"
+	"strconv"
+)
+
+func skuSpecs(specs []good.SkuSpec) []*proto.SkuSpec {
+	ret := make([]*proto.SkuSpec, 0, len(specs))
+	for _, spec := range specs {
+		ret = append(ret, &proto.SkuSpec{Name: spec.Name, Value: spec.Value})
+	}
+	return ret
+}
+
+// skuResponses 查询每个SKU的可售库存
+func (gc *goodsController) skuResponses(ctx context.Context, goodsID int32, skus []*proto.SkuInfoResponse) ([]good.SkuInfoResponse, error) {
+	if len(skus) == 0 {
+		return nil, nil
+	}
+	inv, err := gc.srv.Inventory().BatchInvDetail(ctx, &ipb.BatchInvRequest{GoodsIds: []int32{goodsID}, BySku: true})
+	if err != nil {
+		return nil, err
+	}
+	stocks := make(map[int32]int32, len(inv.Data))
+	for _, info := range inv.Data {
+		stocks[info.SkuId] = info.Num
+	}
+	ret := make([]good.SkuInfoResponse, 0, len(skus))
+	for _, sku := range skus {
+		info := good.SkuInfoResponse{
+			ID:          sku.Id,
+			GoodsID:     sku.GoodsId,
+			SkuSn:       sku.SkuSn,
+			Barcode:     sku.Barcode,
+			SpecText:    sku.SpecText,
+			Price:       sku.Price,
+			MarketPrice: sku.MarketPrice,
+			OnSale:      sku.OnSale,
+			Image:       sku.Image,
+			Stocks:      stocks[sku.Id],
+
+			PurchaseLimit: sku.PurchaseLimit,
+		}
+		for _, spec := range sku.Specs {
+			info.Specs = append(info.Specs, good.SkuSpec{Name: spec.Name, Value: spec.Value})
+		}
+		ret = append(ret, info)
+	}
+	return ret, nil
+}
+
+func (gc *goodsController) SpecificationListView(c *gin.Context) error {
+	var cr good.SpecificationListRequest
+	if err := c.ShouldBindQuery(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+
+	list, err := gc.srv.Goods().SpecificationList(c.Request.Context(), &proto.SpecificationFilterRequest{
+		CategoryId: cr.CategoryID,
+	})
+	if err != nil {
+		return err
+	}
+	common.OkWithList(c, list.Data, list.Total)
+	return nil
+}
+
+func (gc *goodsController) CreateSpecificationView(c *gin.Context) error {
+	var cr good.SpecificationCreateRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+
+	spec, err := gc.srv.Goods().CreateSpecification(c.Request.Context(), &proto.SpecificationRequest{
+		CategoryId: cr.CategoryID,
+		Name:       cr.Name,
+		Values:     cr.Values,
+		Sort:       cr.Sort,
+	})
+	if err != nil {
+		return err
+	}
+	common.OkWithData(c, map[string]interface{}{
+		"id": spec.Id,
+	})
+	return nil
+}
+
+func (gc *goodsController) UpdateSpecificationView(c *gin.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+	var cr good.SpecificationUpdateRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+
+	_, err = gc.srv.Goods().UpdateSpecification(c.Request.Context(), &proto.SpecificationRequest{
+		Id:     int32(id),
+		Name:   cr.Name,
+		Values: cr.Values,
+		Sort:   cr.Sort,
+	})
+	if err != nil {
+		return err
+	}
+	common.OkWithMessage(c, "修改成功")
+	return nil
+}
+
+func (gc *goodsController) GoodsSkuListView(c *gin.Context) error {
+	var cr good.GoodDetailRequest
+	if err := c.ShouldBindUri(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+
+	ctx := c.Request.Context()
+	list, err := gc.srv.Goods().GoodsSkuList(ctx, &proto.GoodInfoRequest{Id: cr.Id})
+	if err != nil {
+		return err
+	}
+	response, err := gc.skuResponses(ctx, cr.Id, list.Data)
+	if err != nil {
+		return err
+	}
+	common.OkWithList(c, response, list.Total)
+	return nil
+}
+
+func (gc *goodsController) CreateSkuView(c *gin.Context) error {
+	var uri good.GoodDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+	var cr good.SkuCreateRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+
+	sku, err := gc.srv.Goods().CreateSku(c.Request.Context(), &proto.SkuInfoRequest{
+		GoodsId:     uri.Id,
+		SkuSn:       cr.SkuSn,
+		Barcode:     cr.Barcode,
+		Specs:       skuSpecs(cr.Specs),
+		Price:       cr.Price,
+		MarketPrice: cr.MarketPrice,
+		OnSale:      cr.OnSale,
+		Image:       cr.Image,
+
+		PurchaseLimit: cr.PurchaseLimit,
+	})
+	if err != nil {
+		return err
+	}
+	common.OkWithData(c, map[string]interface{}{
+		"id": sku.Id,
+	})
+	return nil
+}
+
+func (gc *goodsController) UpdateSkuView(c *gin.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+	var cr good.SkuUpdateRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, gc.trans)
+	}
+
+	_, err = gc.srv.Goods().UpdateSku(c.Request.Context(), &proto.SkuInfoRequest{
+		Id:          int32(id),
+		SkuSn:       cr.SkuSn,
+		Barcode:     cr.Barcode,
+		Specs:       skuSpecs(cr.Specs),
+		Price:       cr.Price,
+		MarketPrice: cr.MarketPrice,
+		OnSale:      cr.OnSale,
+		Image:       cr.Image,
+
+		PurchaseLimit: cr.PurchaseLimit,
+	})
+	if err != nil {
+		return err
+	}
+	common.OkWithMessage(c, "修改成功")
+	return nil
+}
@@ -5,19 +5,35 @@ import (
 	"Advanced_Shop/app/pkg/common"
 	gin2 "Advanced_Shop/app/pkg/translator/gin"
 	"Advanced_Shop/app/xshop/api/internal/domain/request/order"
+	"Advanced_Shop/gnova/server/restserver/middlewares"
 	"Advanced_Shop/pkg/log"
 	"github.com/gin-gonic/gin"
+	"net/http"
 )
 
+// cartOwner 登录用户返回用户ID，未登录时返回 cookie 中的游客ID（还没有游客购物车时为 0）
+func (oc orderController) cartOwner(c *gin.Context) (int32, bool, error) {
+	if _, logged := c.Get(middlewares.KeyUserID); logged || !oc.cart.GuestEnable {
+		userID, _, err := common.GetAuthUser(c)
+		return userID, false, err
+	}
+	return common.GetGuestCartID(c, oc.cart), true, nil
+}
+
 func (oc orderController) CartListView(c *gin.Context) error {
 	log.Info("Cart list function called ...")
-	userID, _, err := common.GetAuthUser(c)
+	userID, guest, err := oc.cartOwner(c)
 	if err != nil {
 		return err
 	}
+	if guest && userID == 0 {
+		common.OkWithList(c, []order.CartListResponse{}, 0)
+		return nil
+	}
 	ctx := c.Request.Context()
 	response, total, err := oc.srv.Order().CartItemList(ctx, &proto.UserInfo{
-		Id: userID,
+		Id:    userID,
+		Guest: guest,
 	})
 
 	if err != nil {
@@ -31,7 +47,7 @@ func (oc orderController) CartListView(c *gin.Context) error {
 
 func (oc orderController) DeleteCartItemView(c *gin.Context) error {
 	log.Info("DeleteCartItemView function called ...")
-	userID, _, err := common.GetAuthUser(c)
+	userID, guest, err := oc.cartOwner(c)
 	if err != nil {
 		return err
 	}
@@ -42,10 +58,17 @@ func (oc orderController) DeleteCartItemView(c *gin.Context) error {
 		return gin2.HandleValidatorError(c, err, nil)
 
 	}
+	var sku order.CartSkuRequest
+	err = c.ShouldBindQuery(&sku)
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, nil)
+	}
 	ctx := c.Request.Context()
 	_, err = oc.srv.Order().DeleteCartItem(ctx, &proto.CartItemRequest{
 		UserId:  userID,
 		GoodsId: cr.Id,
+		SkuId:   sku.SkuID,
+		Guest:   guest,
 	})
 	if err != nil {
 		return err
@@ -56,7 +79,7 @@ func (oc orderController) DeleteCartItemView(c *gin.Context) error {
 
 func (oc orderController) AddItemView(c *gin.Context) error {
 	log.Info("AddItemView function called ...")
-	userID, _, err := common.GetAuthUser(c)
+	userID, guest, err := oc.cartOwner(c)
 	if err != nil {
 		return err
 	}
@@ -71,8 +94,17 @@ func (oc orderController) AddItemView(c *gin.Context) error {
 	res, err := oc.srv.Order().CreateCartItem(ctx, &proto.CartItemRequest{
 		UserId:  userID,
 		GoodsId: cr.GoodID,
+		SkuId:   cr.SkuID,
 		Nums:    cr.Num,
+		Guest:   guest,
 	})
+	if err != nil {
+		return err
+	}
+	// 游客第一次加购时服务端分配游客ID，之后每次加购都续期 cookie
+	if guest {
+		common.SetGuestCartID(c, oc.cart, res.UserId)
+	}
 	response := order.CartAddResponse{
 		Id: res.Id,
 	}
@@ -82,7 +114,7 @@ func (oc orderController) AddItemView(c *gin.Context) error {
 
 func (oc orderController) UpdatePatchView(c *gin.Context) error {
 	log.Info("UpdatePatchView function called ...")
-	userID, _, err := common.GetAuthUser(c)
+	userID, guest, err := oc.cartOwner(c)
 	if err != nil {
 		return err
 	}
@@ -93,6 +125,11 @@ func (oc orderController) UpdatePatchView(c *gin.Context) error {
 		return gin2.HandleValidatorError(c, err, nil)
 
 	}
+	var sku order.CartSkuRequest
+	err = c.ShouldBindQuery(&sku)
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, nil)
+	}
 	var update order.CartUpdateRequest
 	err = c.ShouldBindJSON(&update)
 	if err != nil {
@@ -103,8 +140,10 @@ func (oc orderController) UpdatePatchView(c *gin.Context) error {
 	_, err = oc.srv.Order().UpdateCartItem(ctx, &proto.CartItemRequest{
 		UserId:  userID,
 		GoodsId: cr.Id,
+		SkuId:   sku.SkuID,
 		Nums:    update.Num,
 		Checked: update.Checked,
+		Guest:   guest,
 	})
 	if err != nil {
 		return err
@@ -113,3 +152,59 @@ func (oc orderController) UpdatePatchView(c *gin.Context) error {
 	return nil
 
 }
+
+// CartValidateView GET 只校验，POST 时可以带 accept_price 确认新价格
+func (oc orderController) CartValidateView(c *gin.Context) error {
+	log.Info("CartValidateView function called ...")
+	userID, guest, err := oc.cartOwner(c)
+	if err != nil {
+		return err
+	}
+
+	var cr order.CartValidateRequest
+	if c.Request.Method == http.MethodPost {
+		err = c.ShouldBindJSON(&cr)
+	} else {
+		err = c.ShouldBindQuery(&cr)
+		cr.AcceptPrice = false
+	}
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, nil)
+	}
+	response := order.CartValidateResponse{Valid: true, Lines: []order.CartLineCheckResponse{}}
+	if guest && userID == 0 {
+		common.OkWithData(c, response)
+		return nil
+	}
+	ctx := c.Request.Context()
+	result, err := oc.srv.Order().ValidateCart(ctx, &proto.ValidateCartRequest{
+		UserId:      userID,
+		Guest:       guest,
+		CheckedOnly: cr.CheckedOnly,
+		AcceptPrice: cr.AcceptPrice,
+	})
+	if err != nil {
+		return err
+	}
+	response.Valid = result.Valid
+	for _, line := range result.Lines {
+		response.Lines = append(response.Lines, order.CartLineCheckResponse{
+			GoodID:            line.GoodsId,
+			SkuID:             line.SkuId,
+			SkuSpecs:          line.SkuSpecs,
+			Name:              line.GoodsName,
+			Num:               line.Nums,
+			Checked:           line.Checked,
+			AddedPrice:        line.AddedPrice,
+			Price:             line.Price,
+			Stock:             line.Stock,
+			Limit:             line.Limit,
+			PriceChanged:      line.PriceChanged,
+			OffSale:           line.OffSale,
+			InsufficientStock: line.InsufficientStock,
+			LimitExceeded:     line.LimitExceeded,
+		})
+	}
+	common.OkWithData(c, response)
+	return nil
+}
@@ -2,38 +2,37 @@ package v1
 
 import (
 	proto "Advanced_Shop/api/order/v1"
-	"Advanced_Shop/app/pkg/code"
 	"Advanced_Shop/app/pkg/common"
 	"Advanced_Shop/app/pkg/options"
+	"Advanced_Shop/app/pkg/payment"
 	gin2 "Advanced_Shop/app/pkg/translator/gin"
 	"Advanced_Shop/app/xshop/api/internal/domain/request/order"
 	"Advanced_Shop/app/xshop/api/internal/service"
-	"Advanced_Shop/pkg/errors"
 	"Advanced_Shop/pkg/log"
 	"fmt"
-	"github.com/smartwalle/alipay/v3"
 
 	"github.com/gin-gonic/gin"
 	ut "github.com/go-playground/universal-translator"
 
-	"go.uber.org/zap"
 	"math/rand"
 	"net/http"
-	"strconv"
 	"time"
 )
 
 type orderController struct {
-	trans   ut.Translator
-	srv     service.ServiceFactory
-	options *options.AliyunOptions
+	trans    ut.Translator
+	srv      service.ServiceFactory
+	payments *payment.Providers
+	cart     *options.CartOptions
 }
 
-func NewOrderController(srv service.ServiceFactory, trans ut.Translator, options *options.AliyunOptions) *orderController {
+func NewOrderController(srv service.ServiceFactory, trans ut.Translator, payments *payment.Providers,
+	cart *options.CartOptions) *orderController {
 	return &orderController{
-		srv:     srv,
-		trans:   trans,
-		options: options,
+		srv:      srv,
+		trans:    trans,
+		payments: payments,
+		cart:     cart,
 	}
 }
 
@@ -43,7 +42,7 @@ func (oc orderController) OrderListView(c *gin.Context) error {
 	if err != nil {
 		return err
 	}
-	var cr common.PageInfo
+	var cr order.OrderListRequest
 	err = c.ShouldBindQuery(&cr)
 	if err != nil {
 		return gin2.HandleValidatorError(c, err, oc.trans)
@@ -55,6 +54,7 @@ func (oc orderController) OrderListView(c *gin.Context) error {
 		UserId:      userID,
 		Pages:       cr.Page,
 		PagePerNums: cr.Limit,
+		View:        cr.View,
 	})
 
 	if err != nil {
@@ -62,20 +62,7 @@ func (oc orderController) OrderListView(c *gin.Context) error {
 	}
 	var response []order.OrderListResponse
 	for _, model := range list.Data {
-		result := order.OrderListResponse{
-			Id:      model.Id,
-			UserId:  model.UserId,
-			OrderSn: model.OrderSn,
-			PayType: model.PayType,
-			Status:  model.Status,
-			Post:    model.Post,
-			Total:   model.Total,
-			Address: model.Address,
-			Name:    model.Name,
-			Mobile:  model.Mobile,
-		}
-		response = append(response, result)
-
+		response = append(response, orderListResponse(model))
 	}
 
 	common.OkWithList(c, response, list.Total)
@@ -103,49 +90,181 @@ func (oc orderController) OrderCreateView(c *gin.Context) error {
 		return gin2.HandleValidatorError(c, err, oc.trans)
 
 	}
+	// 先确认支付方式可用，避免下单后无法支付
+	provider, err := oc.payments.Get(cr.PayType)
+	if err != nil {
+		return err
+	}
+	if cr.PayType == "" {
+		cr.PayType = payment.PayTypeAlipay
+	}
+
 	ctx := c.Request.Context()
 	orderSn := RandomSns(userID)
 	total, err := oc.srv.Order().SubmitOrder(ctx, &proto.OrderRequest{
-		UserId:  userID,
+		UserId:     userID,
+		OrderSn:    orderSn,
+		Address:    cr.Address,
+		Name:       cr.Name,
+		Mobile:     cr.Mobile,
+		Post:       cr.Post,
+		Province:   cr.Province,
+		CouponCode: cr.CouponCode,
+		PayType:    cr.PayType,
+	})
+	if err != nil {
+		return err
+	}
+
+	amount := total.PayAmount
+	if amount == 0 {
+		amount = payment.Cents(float64(total.PriceSum))
+	}
+	result, err := provider.Pay(ctx, &payment.PayRequest{OrderSn: orderSn, Amount: amount})
+	if err != nil {
+		return err
+	}
+	response := order.OrderCreateResponse{
 		OrderSn: orderSn,
-		Address: cr.Address,
-		Name:    cr.Name,
-		Mobile:  cr.Mobile,
-		Post:    cr.Post,
+		PayType: cr.PayType,
+		PayUrl:  result.PayURL,
+		QrCode:  result.QRCode,
+	}
+	if cr.PayType == payment.PayTypeAlipay {
+		response.AlipayUrl = result.PayURL
+	}
+
+	common.OkWithData(c, response)
+	return nil
+}
+
+// OrderPreviewView 按购物车选中的商品和优惠券试算最终价格，不创建订单
+func (oc orderController) OrderPreviewView(c *gin.Context) error {
+	log.Info("order preview function called ...")
+	userID, _, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	var cr order.OrderPreviewRequest
+	err = c.ShouldBindJSON(&cr)
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	ctx := c.Request.Context()
+	result, err := oc.srv.Order().PreviewOrder(ctx, &proto.OrderRequest{
+		UserId:     userID,
+		CouponCode: cr.CouponCode,
 	})
 	if err != nil {
 		return err
 	}
 
-	client, err := alipay.New(oc.options.AlipayAppId, oc.options.AlipayPrivateKey, false)
+	response := order.OrderPreviewResponse{Price: priceResponse(result.Price)}
+	for _, item := range result.Items {
+		response.Goods = append(response.Goods, order.PreviewGoodsResponse{
+			GoodsId:   item.GoodsId,
+			SkuID:     item.SkuId,
+			SkuSpecs:  item.SkuSpecs,
+			Name:      item.GoodsName,
+			Image:     item.GoodsImage,
+			Price:     item.Price,
+			Nums:      item.Nums,
+			PayAmount: item.PayAmount,
+		})
+	}
+	common.OkWithData(c, response)
+	return nil
+}
+
+// priceResponse 订单价格明细，金额单位为分
+func priceResponse(price *proto.PriceBreakdown) order.PriceBreakdownResponse {
+	ret := order.PriceBreakdownResponse{
+		GoodsAmount:    price.GetGoodsAmount(),
+		DiscountAmount: price.GetDiscountAmount(),
+		ShippingFee:    price.GetShippingFee(),
+		PayAmount:      price.GetPayAmount(),
+		CouponCode:     price.GetCouponCode(),
+		Adjustments:    []order.PriceAdjustmentResponse{},
+	}
+	for _, adj := range price.GetAdjustments() {
+		ret.Adjustments = append(ret.Adjustments, order.PriceAdjustmentResponse{Type: adj.Type, Name: adj.Name, Amount: adj.Amount})
+	}
+	return ret
+}
+
+// OrderCancelView 取消待支付的订单，库存由订单服务通过 DTM 归还
+func (oc orderController) OrderCancelView(c *gin.Context) error {
+	log.Info("order cancel function called ...")
+	userID, _, err := common.GetAuthUser(c)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	err = client.LoadAliPayPublicKey(oc.options.AlipayPublicKey)
+	var cr order.OrderIdRequest
+	err = c.ShouldBindUri(&cr)
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	ctx := c.Request.Context()
+	_, err = oc.srv.Order().CancelOrder(ctx, &proto.OrderRequest{
+		Id:     cr.Id,
+		UserId: userID,
+	})
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	var p = alipay.TradePagePay{}
-	p.NotifyURL = oc.options.AlipayNotifyUrl
-	p.ReturnURL = oc.options.AlipayReturnUrl
-	p.Subject = oc.options.AlipaySubject + orderSn
-	p.OutTradeNo = orderSn
-	p.TotalAmount = strconv.FormatFloat(float64(total.PriceSum), 'f', 2, 64)
-	p.ProductCode = oc.options.AlipayProductCode
-	p.TimeoutExpress = oc.options.AlipayTimeoutExpress // 默认3分钟 链接失效
+	common.OkWithMessage(c, "取消成功")
+	return nil
+}
 
-	result, err := client.TradePagePay(p)
+// OrderHistoryView 订单状态变更记录，管理员可以查看所有用户的订单
+func (oc orderController) OrderHistoryView(c *gin.Context) error {
+	log.Info("order history function called ...")
+	userID, role, err := common.GetAuthUser(c)
 	if err != nil {
-		log.Errorf("生成支付宝url失败")
-		return errors.WithCode(code.ErrAlipay, "生成支付宝url失败")
+		return err
 	}
-	response := order.OrderCreateResponse{
-		OrderSn:   orderSn,
-		AlipayUrl: result.String(),
+	var cr order.OrderIdRequest
+	err = c.ShouldBindUri(&cr)
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+	var page common.PageInfo
+	err = c.ShouldBindQuery(&page)
+	if err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
 	}
 
-	common.OkWithData(c, response)
+	if role == 1 {
+		userID = 0
+	}
+	ctx := c.Request.Context()
+	list, err := oc.srv.Order().OrderStatusHistory(ctx, &proto.OrderStatusHistoryRequest{
+		Id:          cr.Id,
+		UserId:      userID,
+		Pages:       page.Page,
+		PagePerNums: page.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response []order.OrderStatusHistoryResponse
+	for _, item := range list.Data {
+		response = append(response, order.OrderStatusHistoryResponse{
+			Id:         item.Id,
+			OrderSn:    item.OrderSn,
+			FromStatus: item.FromStatus,
+			ToStatus:   item.ToStatus,
+			Operator:   item.Operator,
+			Reason:     item.Reason,
+			CreatedAt:  item.CreatedAt,
+		})
+	}
+
+	common.OkWithList(c, response, list.Total)
 	return nil
 }
 
@@ -156,7 +275,7 @@ func (oc orderController) OrderDetailView(c *gin.Context) error {
 		return err
 	}
 	var cr order.OrderIdRequest
-	err = c.ShouldBindQuery(&cr)
+	err = c.ShouldBindUri(&cr)
 	if err != nil {
 		return gin2.HandleValidatorError(c, err, oc.trans)
 
@@ -167,6 +286,7 @@ func (oc orderController) OrderDetailView(c *gin.Context) error {
 	}
 	ctx := c.Request.Context()
 	result, err := oc.srv.Order().OrderDetail(ctx, &proto.OrderRequest{
+		Id:     cr.Id,
 		UserId: userID,
 	})
 	if err != nil {
@@ -184,86 +304,123 @@ func (oc orderController) OrderDetailView(c *gin.Context) error {
 		Address: result.OrderInfo.Address,
 		Name:    result.OrderInfo.Name,
 		Mobile:  result.OrderInfo.Mobile,
+		Price:   priceResponse(result.OrderInfo.Price),
+
+		ParentId:   result.OrderInfo.ParentId,
+		ParentSn:   result.OrderInfo.ParentSn,
+		MerchantId: result.OrderInfo.MerchantId,
+		Split:      result.OrderInfo.Split,
+		SubOrders:  []order.OrderListResponse{},
+	}
+	for _, sub := range result.SubOrders {
+		response.SubOrders = append(response.SubOrders, orderListResponse(sub))
 	}
 	var goodsInfo []order.GoodInfo
 	for _, good := range result.Goods {
 		info := order.GoodInfo{
-			Id:    good.Id,
-			Name:  good.GoodsName,
-			Image: good.GoodsImage,
-			Price: good.GoodsPrice,
-			Nums:  good.Nums,
+			Id:       good.Id,
+			Name:     good.GoodsName,
+			SkuID:    good.SkuId,
+			SkuSpecs: good.SkuSpecs,
+			Image:    good.GoodsImage,
+			Price:    good.GoodsPrice,
+			Nums:     good.Nums,
 		}
 		goodsInfo = append(goodsInfo, info)
 	}
 	response.GoodInfo = goodsInfo
-	client, err := alipay.New(oc.options.AlipayAppId, oc.options.AlipayPrivateKey, false)
-	if err != nil {
-		panic(err)
-	}
-	err = client.LoadAliPayPublicKey(oc.options.AlipayPublicKey)
-	if err != nil {
-		panic(err)
-	}
-
-	var p = alipay.TradePagePay{}
-	p.NotifyURL = oc.options.AlipayNotifyUrl
-	p.ReturnURL = oc.options.AlipayReturnUrl
-	p.Subject = oc.options.AlipaySubject + result.OrderInfo.OrderSn
-	p.OutTradeNo = result.OrderInfo.OrderSn
-	p.TotalAmount = strconv.FormatFloat(float64(result.OrderInfo.Total), 'f', 2, 64)
-	p.ProductCode = oc.options.AlipayProductCode
-	p.TimeoutExpress = oc.options.AlipayTimeoutExpress // 默认3分钟 链接失效
 
-	alipayRes, err := client.TradePagePay(p)
-
-	if err != nil {
-		log.Errorf("生成支付宝url失败")
-		return errors.WithCode(code.ErrAlipay, "生成支付宝url失败")
+	// 只有待支付的订单需要重新生成支付链接，子订单按父订单付款
+	status := result.OrderInfo.Status
+	if (status == "PAYING" || status == "WAIT_BUYER_PAY") && result.OrderInfo.ParentSn == "" {
+		provider, err := oc.payments.Get(result.OrderInfo.PayType)
+		if err != nil {
+			return err
+		}
+		amount := result.OrderInfo.GetPrice().GetPayAmount()
+		if amount == 0 {
+			amount = payment.Cents(float64(result.OrderInfo.Total))
+		}
+		payRes, err := provider.Pay(ctx, &payment.PayRequest{OrderSn: result.OrderInfo.OrderSn, Amount: amount})
+		if err != nil {
+			return err
+		}
+		response.PayUrl = payRes.PayURL
+		response.QrCode = payRes.QRCode
+		if result.OrderInfo.PayType == "" || result.OrderInfo.PayType == payment.PayTypeAlipay {
+			response.AlipayUrl = payRes.PayURL
+		}
 	}
-	response.AlipayUrl = alipayRes.String()
 
 	common.OkWithData(c, response)
-	return err
+	return nil
 }
 
+// AlipayCallBackView 支付宝异步通知，保留原有的回调地址
 func (oc orderController) AlipayCallBackView(c *gin.Context) {
+	oc.handleNotification(c, payment.PayTypeAlipay)
+}
 
-	client, err := alipay.New(oc.options.AlipayAppId, oc.options.AlipayPrivateKey, false)
+// PayNotifyView 各支付渠道的异步通知，支付方式取自路径 /pay/notify/:pay_type
+func (oc orderController) PayNotifyView(c *gin.Context) {
+	oc.handleNotification(c, c.Param("pay_type"))
+}
+
+// handleNotification 验签后把交易状态交给订单服务的状态机，按渠道要求的格式应答
+func (oc orderController) handleNotification(c *gin.Context, payType string) {
+	provider, err := oc.payments.Get(payType)
 	if err != nil {
-		panic(err)
+		log.Errorf("支付通知失败: %v", err)
+		c.Status(http.StatusNotFound)
+		return
 	}
-	err = client.LoadAliPayPublicKey(oc.options.AlipayPublicKey)
+	err = oc.applyNotification(c, provider, payType)
 	if err != nil {
-		panic(err)
+		log.Errorf("%s 支付通知处理失败: %v", payType, err)
 	}
+	provider.AckNotification(c.Writer, err)
+}
 
-	notification, err := client.GetTradeNotification(c.Request)
-	if err != nil || notification == nil {
-		log.Errorf("支付宝 CallBack 失败")
-		c.String(200, "fail")
-		return
-	}
-	// 查看订单是否存在 是否超时
-	ctx := c.Request.Context()
-	info, err := oc.srv.Order().OrderDetailByOrderSn(ctx, &proto.AlipayOrderSnRequest{
-		OrderSn: notification.OutTradeNo,
-	})
+// applyNotification 验签后交给订单服务处理，去重、乱序和金额校验都在订单服务中完成
+func (oc orderController) applyNotification(c *gin.Context, provider payment.PaymentProvider, payType string) error {
+	trade, err := provider.VerifyNotification(c.Request)
 	if err != nil {
-		zap.S().Error(err)
-		c.String(200, "fail")
-		return
+		return err
 	}
-	if info.OrderInfo.Status != "" {
-		c.String(200, "fail")
+	request := &proto.PayNotification{
+		PayType:     payType,
+		OrderSn:     trade.OrderSn,
+		TradeNo:     trade.TradeNo,
+		TradeStatus: trade.Status,
+		Amount:      trade.Amount,
+		Payload:     trade.Raw,
 	}
-	_, err = oc.srv.Order().UpdateOrderStatus(ctx, &proto.OrderStatus{
-		OrderSn: notification.OutTradeNo,
-		Status:  string(notification.TradeStatus),
-	})
+	if trade.PayTime != nil {
+		request.PayTime = trade.PayTime.Unix()
+	}
+	notification, err := oc.srv.Order().PayNotify(c.Request.Context(), request)
 	if err != nil {
-		c.String(200, "fail")
-		return
+		return err
+	}
+	log.Infof("订单%s的%s通知%s处理结果%s", trade.OrderSn, payType, trade.Status, notification.Status)
+	return nil
+}
+
+func orderListResponse(model *proto.OrderInfoResponse) order.OrderListResponse {
+	return order.OrderListResponse{
+		Id:         model.Id,
+		UserId:     model.UserId,
+		OrderSn:    model.OrderSn,
+		PayType:    model.PayType,
+		Status:     model.Status,
+		Post:       model.Post,
+		Total:      model.Total,
+		Address:    model.Address,
+		Name:       model.Name,
+		Mobile:     model.Mobile,
+		ParentId:   model.ParentId,
+		ParentSn:   model.ParentSn,
+		MerchantId: model.MerchantId,
+		Split:      model.Split,
 	}
-	c.String(http.StatusOK, "success")
 }
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	proto "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/common"
+	gin2 "Advanced_Shop/app/pkg/translator/gin"
+	"Advanced_Shop/app/xshop/api/internal/domain/request/order"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// PayNotificationListView 订单收到的支付通知，只允许管理员查看
+func (oc orderController) PayNotificationListView(c *gin.Context) error {
+	log.Info("pay notification list function called ...")
+	_, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var uri order.OrderIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+	var page common.PageInfo
+	if err := c.ShouldBindQuery(&page); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	list, err := oc.srv.Order().PayNotificationList(c.Request.Context(), &proto.PayNotificationFilterRequest{
+		OrderId:     uri.Id,
+		Pages:       page.Page,
+		PagePerNums: page.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response []order.PayNotificationResponse
+	for _, notification := range list.Data {
+		response = append(response, payNotificationResponse(notification))
+	}
+	common.OkWithList(c, response, list.Total)
+	return nil
+}
+
+// PayNotificationReplayView 重放保存的支付通知，用于排查问题，只允许管理员操作
+func (oc orderController) PayNotificationReplayView(c *gin.Context) error {
+	log.Info("pay notification replay function called ...")
+	userID, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var uri order.PayNotificationIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	log.Infof("管理员%d重放支付通知%d", userID, uri.Id)
+	notification, err := oc.srv.Order().ReplayPayNotification(c.Request.Context(), &proto.PayNotificationReplayRequest{Id: uri.Id})
+	if err != nil {
+		return err
+	}
+
+	common.OkWithData(c, payNotificationResponse(notification))
+	return nil
+}
+
+func payNotificationResponse(notification *proto.PayNotificationInfo) order.PayNotificationResponse {
+	return order.PayNotificationResponse{
+		Id:          notification.Id,
+		PayType:     notification.PayType,
+		OrderSn:     notification.OrderSn,
+		TradeNo:     notification.TradeNo,
+		TradeStatus: notification.TradeStatus,
+		Amount:      notification.Amount,
+		PayTime:     notification.PayTime,
+		Status:      notification.Status,
+		Remark:      notification.Remark,
+		NotifyCount: notification.NotifyCount,
+		Payload:     notification.Payload,
+		CreatedAt:   notification.CreatedAt,
+		UpdatedAt:   notification.UpdatedAt,
+	}
+}
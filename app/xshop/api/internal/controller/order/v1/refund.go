@@ -0,0 +1,165 @@
+package v1
+
+import (
+	proto "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/common"
+	gin2 "Advanced_Shop/app/pkg/translator/gin"
+	"Advanced_Shop/app/xshop/api/internal/domain/request/order"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+func (oc orderController) RefundApplyView(c *gin.Context) error {
+	log.Info("refund apply function called ...")
+	userID, _, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	var uri order.OrderIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+	var cr order.RefundApplyRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	request := &proto.RefundApplyRequest{
+		OrderId: uri.Id,
+		UserId:  userID,
+		Reason:  cr.Reason,
+	}
+	for _, item := range cr.Items {
+		request.Items = append(request.Items, &proto.RefundItem{OrderGoodsId: item.OrderGoodsId, Nums: item.Nums})
+	}
+	refund, err := oc.srv.Order().ApplyRefund(c.Request.Context(), request)
+	if err != nil {
+		return err
+	}
+
+	common.OkWithData(c, refundResponse(refund))
+	return nil
+}
+
+func (oc orderController) RefundListView(c *gin.Context) error {
+	log.Info("refund list function called ...")
+	userID, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	var uri order.OrderIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+	var page common.PageInfo
+	if err := c.ShouldBindQuery(&page); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	if role == 1 {
+		userID = 0
+	}
+	list, err := oc.srv.Order().RefundList(c.Request.Context(), &proto.RefundFilterRequest{
+		OrderId:     uri.Id,
+		UserId:      userID,
+		Pages:       page.Page,
+		PagePerNums: page.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response []order.RefundResponse
+	for _, refund := range list.Data {
+		response = append(response, refundResponse(refund))
+	}
+	common.OkWithList(c, response, list.Total)
+	return nil
+}
+
+func (oc orderController) RefundApproveView(c *gin.Context) error {
+	log.Info("refund approve function called ...")
+	userID, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var uri order.RefundIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	refund, err := oc.srv.Order().ApproveRefund(c.Request.Context(), &proto.RefundReviewRequest{
+		Id:         uri.RefundId,
+		OrderId:    uri.Id,
+		OperatorId: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	common.OkWithData(c, refundResponse(refund))
+	return nil
+}
+
+func (oc orderController) RefundRejectView(c *gin.Context) error {
+	log.Info("refund reject function called ...")
+	userID, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var uri order.RefundIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+	var cr order.RefundRejectRequest
+	if err := c.ShouldBindJSON(&cr); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	refund, err := oc.srv.Order().RejectRefund(c.Request.Context(), &proto.RefundReviewRequest{
+		Id:         uri.RefundId,
+		OrderId:    uri.Id,
+		OperatorId: userID,
+		Remark:     cr.Remark,
+	})
+	if err != nil {
+		return err
+	}
+
+	common.OkWithData(c, refundResponse(refund))
+	return nil
+}
+
+func refundResponse(refund *proto.RefundInfo) order.RefundResponse {
+	response := order.RefundResponse{
+		Id:        refund.Id,
+		RefundSn:  refund.RefundSn,
+		OrderSn:   refund.OrderSn,
+		Status:    refund.Status,
+		Amount:    refund.Amount,
+		Reason:    refund.Reason,
+		Remark:    refund.Remark,
+		CreatedAt: refund.CreatedAt,
+
+		RefundAmount: refund.RefundAmount,
+	}
+	for _, goods := range refund.Goods {
+		response.Goods = append(response.Goods, order.RefundGoodsResponse{
+			OrderGoodsId: goods.OrderGoodsId,
+			GoodsId:      goods.GoodsId,
+			GoodsName:    goods.GoodsName,
+			Nums:         goods.Nums,
+			Amount:       goods.Amount,
+			RefundAmount: goods.RefundAmount,
+		})
+	}
+	return response
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	proto "Advanced_Shop/api/order/v1"
+	"Advanced_Shop/app/pkg/code"
+	"Advanced_Shop/app/pkg/common"
+	gin2 "Advanced_Shop/app/pkg/translator/gin"
+	"Advanced_Shop/app/xshop/api/internal/domain/request/order"
+	"Advanced_Shop/pkg/errors"
+	"Advanced_Shop/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// OrderShipView 发货，记录快递公司和运单号，只允许管理员操作
+func (oc orderController) OrderShipView(c *gin.Context) error {
+	log.Info("order ship function called ...")
+	userID, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	if role != 1 {
+		return errors.WithCode(code.ErrInsufficientPermissions, "权限不足")
+	}
+	var uri order.OrderIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+	var req order.ShipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	shipment, err := oc.srv.Order().ShipOrder(c.Request.Context(), &proto.ShipRequest{
+		OrderId:    uri.Id,
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+		OperatorId: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	common.OkWithData(c, shipmentResponse(shipment))
+	return nil
+}
+
+// ShipmentDetailView 订单的运单和物流轨迹，用户只能查看自己的订单
+func (oc orderController) ShipmentDetailView(c *gin.Context) error {
+	log.Info("shipment detail function called ...")
+	userID, role, err := common.GetAuthUser(c)
+	if err != nil {
+		return err
+	}
+	var uri order.OrderIdRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		return gin2.HandleValidatorError(c, err, oc.trans)
+	}
+
+	if role == 1 {
+		userID = 0
+	}
+	shipment, err := oc.srv.Order().ShipmentDetail(c.Request.Context(), &proto.ShipmentRequest{
+		OrderId: uri.Id,
+		UserId:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	common.OkWithData(c, shipmentResponse(shipment))
+	return nil
+}
+
+func shipmentResponse(shipment *proto.ShipmentInfo) order.ShipmentResponse {
+	response := order.ShipmentResponse{
+		Id:          shipment.Id,
+		OrderId:     shipment.OrderId,
+		OrderSn:     shipment.OrderSn,
+		Carrier:     shipment.Carrier,
+		TrackingNo:  shipment.TrackingNo,
+		Status:      shipment.Status,
+		ShippedAt:   shipment.ShippedAt,
+		DeliveredAt: shipment.DeliveredAt,
+		Events:      []order.ShipmentEventResponse{},
+	}
+	for _, event := range shipment.Events {
+		response.Events = append(response.Events, order.ShipmentEventResponse{
+			Time:        event.Time,
+			Status:      event.Status,
+			Location:    event.Location,
+			Description: event.Description,
+		})
+	}
+	return response
+}
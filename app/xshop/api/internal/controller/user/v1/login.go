@@ -1,6 +1,7 @@
 package user
 
 import (
+	proto "Advanced_Shop/api/order/v1"
 	"Advanced_Shop/app/pkg/common"
 	gin2 "Advanced_Shop/app/pkg/translator/gin"
 	"Advanced_Shop/pkg/log"
@@ -47,6 +48,7 @@ func (us *userServer) Login(ctx *gin.Context) {
 		})
 		return
 	}
+	us.mergeGuestCart(ctx, int32(userDTO.ID))
 	common.OkWithData(ctx, UserResponse{
 		ID:        userDTO.ID,
 		NickName:  userDTO.NickName,
@@ -55,3 +57,24 @@ func (us *userServer) Login(ctx *gin.Context) {
 	})
 
 }
+
+// mergeGuestCart 登录成功后把游客购物车并入用户购物车
+// 合并失败不影响登录，保留 cookie，下次登录再合并
+func (us *userServer) mergeGuestCart(ctx *gin.Context, userID int32) {
+	if !us.cart.GuestEnable {
+		return
+	}
+	guestID := common.GetGuestCartID(ctx, us.cart)
+	if guestID == 0 {
+		return
+	}
+	_, err := us.sf.Order().MergeCart(ctx, &proto.MergeCartRequest{
+		GuestId: guestID,
+		UserId:  userID,
+	})
+	if err != nil {
+		log.Warnf("合并游客购物车失败: guestID=%d, userID=%d, err=%v", guestID, userID, err)
+		return
+	}
+	common.ClearGuestCartID(ctx, us.cart)
+}
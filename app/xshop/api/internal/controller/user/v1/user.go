@@ -1,6 +1,7 @@
 package user
 
 import (
+	"Advanced_Shop/app/pkg/options"
 	"Advanced_Shop/app/xshop/api/internal/service"
 	ut "github.com/go-playground/universal-translator"
 )
@@ -9,8 +10,10 @@ type userServer struct {
 	trans ut.Translator
 
 	sf service.ServiceFactory
+
+	cart *options.CartOptions
 }
 
-func NewUserController(trans ut.Translator, sf service.ServiceFactory) *userServer {
-	return &userServer{trans, sf}
+func NewUserController(trans ut.Translator, sf service.ServiceFactory, cart *options.CartOptions) *userServer {
+	return &userServer{trans, sf, cart}
 }
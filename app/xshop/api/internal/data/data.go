@@ -15,4 +15,5 @@ type DataFactory interface {
 	Address() apb.AddressClient
 	Collection() apb.UserFavClient
 	Message() apb.MessageClient
+	Coupon() apb.CouponClient
 }
@@ -14,9 +14,10 @@ type Action struct {
 	gc apbv1.AddressClient
 	uc apbv1.UserFavClient
 	mc apbv1.MessageClient
+	cc apbv1.CouponClient
 }
 
-func NewActionServiceClient(r registry.Discovery) (apbv1.AddressClient, apbv1.UserFavClient, apbv1.MessageClient) {
+func NewActionServiceClient(r registry.Discovery) (apbv1.AddressClient, apbv1.UserFavClient, apbv1.MessageClient, apbv1.CouponClient) {
 	conn, err := rpcserver.DialInsecure(
 		context.Background(),
 		rpcserver.WithEndpoint(optionserviceName),
@@ -29,5 +30,6 @@ func NewActionServiceClient(r registry.Discovery) (apbv1.AddressClient, apbv1.Us
 	c1 := apbv1.NewAddressClient(conn)
 	c2 := apbv1.NewUserFavClient(conn)
 	c3 := apbv1.NewMessageClient(conn)
-	return c1, c2, c3
+	c4 := apbv1.NewCouponClient(conn)
+	return c1, c2, c3, c4
 }
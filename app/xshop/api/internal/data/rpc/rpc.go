@@ -30,6 +30,7 @@ type grpcData struct {
 	ac apb.AddressClient
 	mc apb.MessageClient
 	cc apb.UserFavClient
+	cp apb.CouponClient
 	ic ipb.InventoryClient
 }
 
@@ -49,6 +50,10 @@ func (g grpcData) Message() apb.MessageClient {
 	return g.mc
 }
 
+func (g grpcData) Coupon() apb.CouponClient {
+	return g.cp
+}
+
 func (g grpcData) Goods() gpb.GoodsClient {
 	return g.gc
 }
@@ -90,7 +95,7 @@ func GetDataFactoryOr(options *options.RegistryOptions) (data.DataFactory, error
 		userClient := user.NewUserServiceClient(discovery)
 		goodsClient := good.NewGoodsServiceClient(discovery)
 		orderClient := order.NewOrderServiceClient(discovery)
-		ac, cc, mc := action.NewActionServiceClient(discovery)
+		ac, cc, mc, cp := action.NewActionServiceClient(discovery)
 		ic := inventory.NewInventoryServiceClient(discovery)
 		dbFactory = &grpcData{
 			gc: goodsClient,
@@ -99,6 +104,7 @@ func GetDataFactoryOr(options *options.RegistryOptions) (data.DataFactory, error
 			ac: ac,
 			mc: mc,
 			cc: cc,
+			cp: cp,
 			ic: ic,
 		}
 	})
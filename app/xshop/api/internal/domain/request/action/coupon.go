@@ -0,0 +1,54 @@
+package action
+
+// CouponTemplateRequest 新建或修改优惠券模板，金额单位为分，时间为 unix 秒
+type CouponTemplateRequest struct {
+	Name      string `json:"name" binding:"required"`
+	Type      string `json:"type" binding:"required,oneof=fixed percent threshold"`
+	Category  int32  `json:"category"`
+	Threshold int64  `json:"threshold" binding:"min=0"`
+	Amount    int64  `json:"amount" binding:"min=0"`
+	Percent   int32  `json:"percent" binding:"min=0,max=100"`
+	Total     int32  `json:"total" binding:"required,min=1"`
+	PerUser   int32  `json:"per_user" binding:"required,min=1"`
+	ValidDays int32  `json:"valid_days" binding:"min=0"`
+	StartAt   int64  `json:"start_at"`
+	EndAt     int64  `json:"end_at"`
+	Enabled   *bool  `json:"enabled"`
+}
+
+type CouponTemplateIdRequest struct {
+	Id int32 `uri:"id" binding:"required,min=1"`
+}
+
+type CouponTemplateResponse struct {
+	Id        int32  `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Category  int32  `json:"category"`
+	Threshold int64  `json:"threshold"`
+	Amount    int64  `json:"amount"`
+	Percent   int32  `json:"percent"`
+	Total     int32  `json:"total"`
+	PerUser   int32  `json:"per_user"`
+	Issued    int32  `json:"issued"`
+	ValidDays int32  `json:"valid_days"`
+	StartAt   int64  `json:"start_at"`
+	EndAt     int64  `json:"end_at"`
+	Enabled   bool   `json:"enabled"`
+}
+
+type UserCouponListRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=UNUSED USED EXPIRED"`
+	Page   int32  `form:"page"`
+	Limit  int32  `form:"limit"`
+}
+
+type UserCouponResponse struct {
+	Id       int32                   `json:"id"`
+	Code     string                  `json:"code"`
+	Status   string                  `json:"status"`
+	OrderSn  string                  `json:"order_sn"`
+	ExpireAt int64                   `json:"expire_at"`
+	UsedAt   int64                   `json:"used_at"`
+	Template *CouponTemplateResponse `json:"template,omitempty"`
+}
@@ -23,6 +23,47 @@ type GoodListRequest struct {
 	PriceMin      int32 `form:"price_min"`
 	BrandID       int32 `form:"brand_id"`
 	TopCategoryID int32 `form:"top_category_id"`
+	// 排序：relevance（默认）、sales、price、price_desc、newest
+	Sort     string `form:"sort" binding:"omitempty,oneof=relevance sales price price_desc newest"`
+	ShipFree *bool  `form:"ship_free"`
+}
+
+// GoodListResponse 商品搜索结果，facets 用于渲染筛选项
+type GoodListResponse struct {
+	List   []GoodsInfoResponse `json:"list"`
+	Count  int32               `json:"count"`
+	Facets *SearchFacets       `json:"facets,omitempty"`
+}
+
+// GoodsSuggestRequest 搜索框输入时的建议，q 为空时返回热搜词
+type GoodsSuggestRequest struct {
+	Key  string `form:"q" binding:"omitempty,max=50"`
+	Size int32  `form:"size" binding:"omitempty,min=1,max=20"`
+}
+
+type HotTermResponse struct {
+	Term  string `json:"term"`
+	Count int64  `json:"count"` // 最近几天的搜索次数
+}
+
+type GoodsSuggestResponse struct {
+	Suggestions []string          `json:"suggestions"`
+	HotTerms    []HotTermResponse `json:"hot_terms"`
+}
+
+// FacetBucket 筛选项：品牌、分类的 key 为ID，价格的 key 为区间下限，包邮的 key 为 1/0
+type FacetBucket struct {
+	Key   int64  `json:"key"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type SearchFacets struct {
+	Brands        []FacetBucket `json:"brands"`
+	Categories    []FacetBucket `json:"categories"`
+	Prices        []FacetBucket `json:"prices"`
+	PriceInterval int64         `json:"price_interval"` // 价格区间为 [key, key+price_interval)
+	ShipFree      []FacetBucket `json:"ship_free"`
 }
 
 type GoodCreateRequest struct {
@@ -38,31 +79,41 @@ type GoodCreateRequest struct {
 	ShipFree    *bool    `form:"ship_free" json:"ship_free" binding:"required"`
 	FrontImage  string   `form:"front_image" json:"front_image" binding:"required,url"`
 	Brand       int32    `form:"brand" json:"brand" binding:"required"`
+	Merchant    int32    `form:"merchant" json:"merchant" binding:"omitempty,min=0"` // 所属商家，不传为自营
+	// 每单限购数量，不传或 0 为不限购
+	PurchaseLimit *int32 `form:"purchase_limit" json:"purchase_limit" binding:"omitempty,min=0"`
 }
 
 type GoodsInfoResponse struct {
-	ID              int32                     `json:"id"`                  // 商品ID
-	CategoryID      int32                     `json:"category_id"`         // 分类ID
-	Name            string                    `json:"name"`                // 商品名称
-	GoodsSn         string                    `json:"goods_sn"`            // 商品编号
-	ClickNum        int32                     `json:"click_num"`           // 点击数
-	SoldNum         int32                     `json:"sold_num"`            // 销量
-	FavNum          int32                     `json:"fav_num"`             // 收藏数
-	Stocks          int32                     `json:"stocks"`              // 库存
-	MarketPrice     float32                   `json:"market_price"`        // 市场价
-	ShopPrice       float32                   `json:"shop_price"`          // 店铺价
-	GoodsBrief      string                    `json:"goods_brief"`         // 商品简介
-	GoodsDesc       string                    `json:"goods_desc"`          // 商品详情
-	ShipFree        *bool                     `json:"ship_free,omitempty"` // 是否包邮（optional，指针表示可选）
-	Images          []string                  `json:"images"`              // 商品图片（repeated）
-	DescImages      []string                  `json:"desc_images"`         // 详情图片（repeated）
-	GoodsFrontImage string                    `json:"goods_front_image"`   // 商品封面图
-	IsNew           *bool                     `json:"is_new,omitempty"`    // 是否新品（optional）
-	IsHot           *bool                     `json:"is_hot,omitempty"`    // 是否热门（optional）
-	OnSale          *bool                     `json:"on_sale,omitempty"`   // 是否上架（optional）
-	AddTime         int64                     `json:"add_time"`            // 添加时间
-	Category        CategoryBriefInfoResponse `json:"category"`            // 分类信息
-	Brand           BrandInfoResponse         `json:"brand"`               // 品牌信息
+	ID              int32                     `json:"id"`                        // 商品ID
+	CategoryID      int32                     `json:"category_id"`               // 分类ID
+	Name            string                    `json:"name"`                      // 商品名称
+	GoodsSn         string                    `json:"goods_sn"`                  // 商品编号
+	ClickNum        int32                     `json:"click_num"`                 // 点击数
+	SoldNum         int32                     `json:"sold_num"`                  // 销量
+	FavNum          int32                     `json:"fav_num"`                   // 收藏数
+	Stocks          int32                     `json:"stocks"`                    // 库存
+	MarketPrice     float32                   `json:"market_price"`              // 市场价
+	ShopPrice       float32                   `json:"shop_price"`                // 店铺价，有SKU时为最低价
+	MaxPrice        float32                   `json:"max_price"`                 // 最高价，没有SKU时等于店铺价
+	HasSku          bool                      `json:"has_sku"`                   // 有SKU时按SKU加购
+	GoodsBrief      string                    `json:"goods_brief"`               // 商品简介
+	NameHighlight   string                    `json:"name_highlight,omitempty"`  // 关键词高亮的名称（只在搜索中返回）
+	BriefHighlight  string                    `json:"brief_highlight,omitempty"` // 关键词高亮的简介
+	GoodsDesc       string                    `json:"goods_desc"`                // 商品详情
+	ShipFree        *bool                     `json:"ship_free,omitempty"`       // 是否包邮（optional，指针表示可选）
+	Images          []string                  `json:"images"`                    // 商品图片（repeated）
+	DescImages      []string                  `json:"desc_images"`               // 详情图片（repeated）
+	GoodsFrontImage string                    `json:"goods_front_image"`         // 商品封面图
+	IsNew           *bool                     `json:"is_new,omitempty"`          // 是否新品（optional）
+	IsHot           *bool                     `json:"is_hot,omitempty"`          // 是否热门（optional）
+	OnSale          *bool                     `json:"on_sale,omitempty"`         // 是否上架（optional）
+	AddTime         int64                     `json:"add_time"`                  // 添加时间
+	MerchantID      int32                     `json:"merchant_id"`               // 所属商家，0 为自营
+	PurchaseLimit   int32                     `json:"purchase_limit"`            // 每单限购数量，0 为不限购
+	Category        CategoryBriefInfoResponse `json:"category"`                  // 分类信息
+	Brand           BrandInfoResponse         `json:"brand"`                     // 品牌信息
+	Skus            []SkuInfoResponse         `json:"skus,omitempty"`            // SKU列表（只在详情中返回）
 }
 
 // CategoryBriefInfoResponse 对应 Protobuf 的 CategoryBriefInfoResponse 消息
@@ -95,6 +146,9 @@ type GoodUpdateRequest struct {
 	ShipFree    *bool    `form:"ship_free" json:"ship_free"`
 	FrontImage  string   `form:"front_image" json:"front_image"`
 	Brand       int32    `form:"brand" json:"brand"`
+	Merchant    int32    `form:"merchant" json:"merchant" binding:"omitempty,min=0"`
+	// 不传时不修改，0 为不限购
+	PurchaseLimit *int32 `form:"purchase_limit" json:"purchase_limit" binding:"omitempty,min=0"`
 }
 
 type GoodPatchUpdateRequest struct {
@@ -0,0 +1,65 @@
+package good
+
+type SpecificationListRequest struct {
+	CategoryID int32 `form:"category_id" binding:"required,min=1"`
+}
+
+type SpecificationCreateRequest struct {
+	CategoryID int32    `json:"category_id" binding:"required,min=1"`
+	Name       string   `json:"name" binding:"required,max=20"`
+	Values     []string `json:"values" binding:"required,min=1"`
+	Sort       int32    `json:"sort"`
+}
+
+// SpecificationUpdateRequest 不能修改所属分类，values 不传时保持原值
+type SpecificationUpdateRequest struct {
+	Name   string   `json:"name" binding:"omitempty,max=20"`
+	Values []string `json:"values" binding:"omitempty,min=1"`
+	Sort   int32    `json:"sort"`
+}
+
+type SkuSpec struct {
+	Name  string `json:"name" binding:"required"`
+	Value string `json:"value" binding:"required"`
+}
+
+type SkuCreateRequest struct {
+	SkuSn       string    `json:"sku_sn" binding:"required,max=50"`
+	Barcode     string    `json:"barcode" binding:"omitempty,max=50"`
+	Specs       []SkuSpec `json:"specs" binding:"dive"` // 分类下每个规格选一个值
+	Price       float32   `json:"price" binding:"required,gt=0"`
+	MarketPrice float32   `json:"market_price" binding:"omitempty,min=0"`
+	OnSale      *bool     `json:"on_sale"`
+	Image       string    `json:"image" binding:"omitempty,url"`
+	// 规格的每单限购数量，0 时按商品的限购数量
+	PurchaseLimit *int32 `json:"purchase_limit" binding:"omitempty,min=0"`
+}
+
+// SkuUpdateRequest 零值字段保持原值，specs 不传时不修改规格
+type SkuUpdateRequest struct {
+	SkuSn       string    `json:"sku_sn" binding:"omitempty,max=50"`
+	Barcode     string    `json:"barcode" binding:"omitempty,max=50"`
+	Specs       []SkuSpec `json:"specs" binding:"dive"`
+	Price       float32   `json:"price" binding:"omitempty,gt=0"`
+	MarketPrice float32   `json:"market_price" binding:"omitempty,min=0"`
+	OnSale      *bool     `json:"on_sale"`
+	Image       string    `json:"image" binding:"omitempty,url"`
+	// 规格的每单限购数量，0 时按商品的限购数量
+	PurchaseLimit *int32 `json:"purchase_limit" binding:"omitempty,min=0"`
+}
+
+type SkuInfoResponse struct {
+	ID          int32     `json:"id"`
+	GoodsID     int32     `json:"goods_id"`
+	SkuSn       string    `json:"sku_sn"`
+	Barcode     string    `json:"barcode"`
+	Specs       []SkuSpec `json:"specs"`
+	SpecText    string    `json:"spec_text"` // 规格描述，例如 "颜色:红 尺码:L"
+	Price       float32   `json:"price"`
+	MarketPrice float32   `json:"market_price"`
+	OnSale      bool      `json:"on_sale"`
+	Image       string    `json:"image"`
+	Stocks      int32     `json:"stocks"`
+
+	PurchaseLimit int32 `json:"purchase_limit"` // 规格的每单限购数量，0 时按商品的限购数量
+}
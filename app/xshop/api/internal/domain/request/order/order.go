@@ -1,16 +1,66 @@
 package order
 
+import "Advanced_Shop/app/pkg/common"
+
 type OrderCreateRequest struct {
-	Post    string `json:"post" binding:"required"`
-	Address string `json:"address" binding:"required"`
-	Name    string `json:"name" binding:"required"`
-	Mobile  string `json:"mobile" binding:"required,mobile"`
+	Post       string `json:"post" binding:"required"`
+	Address    string `json:"address" binding:"required"`
+	Name       string `json:"name" binding:"required"`
+	Mobile     string `json:"mobile" binding:"required,mobile"`
+	Province   string `json:"province"`                                              // 收货省份，可选，用于库存就近选仓
+	CouponCode string `json:"coupon_code"`                                           // 优惠券码，可选
+	PayType    string `json:"pay_type" binding:"omitempty,oneof=alipay wechat mock"` // 支付方式，默认支付宝
+}
+
+type OrderPreviewRequest struct {
+	CouponCode string `json:"coupon_code"`
+}
+
+// PriceAdjustmentResponse 一项优惠，金额单位为分
+type PriceAdjustmentResponse struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Amount int64  `json:"amount"`
+}
+
+// PriceBreakdownResponse 订单价格明细，金额单位为分
+type PriceBreakdownResponse struct {
+	GoodsAmount    int64                     `json:"goods_amount"`
+	DiscountAmount int64                     `json:"discount_amount"`
+	ShippingFee    int64                     `json:"shipping_fee"`
+	PayAmount      int64                     `json:"pay_amount"`
+	CouponCode     string                    `json:"coupon_code"`
+	Adjustments    []PriceAdjustmentResponse `json:"adjustments"`
+}
+
+type PreviewGoodsResponse struct {
+	GoodsId   int32  `json:"goods_id"`
+	SkuID     int32  `json:"sku_id,omitempty"`
+	SkuSpecs  string `json:"sku_specs,omitempty"`
+	Name      string `json:"name"`
+	Image     string `json:"image"`
+	Price     int64  `json:"price"`
+	Nums      int32  `json:"nums"`
+	PayAmount int64  `json:"pay_amount"`
+}
+
+type OrderPreviewResponse struct {
+	Price PriceBreakdownResponse `json:"price"`
+	Goods []PreviewGoodsResponse `json:"goods"`
 }
 type OrderCreateResponse struct {
 	OrderSn   string `json:"order_sn"`
+	PayType   string `json:"pay_type"`
+	PayUrl    string `json:"pay_url"` // 支付宝收银台链接
+	QrCode    string `json:"qr_code"` // 微信 Native 支付二维码内容
 	AlipayUrl string `json:"alipay_url"`
 }
 
+type OrderListRequest struct {
+	common.PageInfo
+	View string `form:"view" binding:"omitempty,oneof=parent sub"` // parent 按支付单展示（默认），sub 按子订单展示
+}
+
 type OrderIdRequest struct {
 	Id int32 `uri:"id" binding:"required,min=1"`
 }
@@ -32,6 +82,11 @@ type OrderListResponse struct {
 	Address string  `json:"address"`
 	Name    string  `json:"name"`
 	Mobile  string  `json:"mobile"`
+
+	ParentId   int32  `json:"parent_id"` // 子订单的父订单，付款按父订单进行
+	ParentSn   string `json:"parent_sn"`
+	MerchantId int32  `json:"merchant_id"`
+	Split      bool   `json:"split"` // 已按商家拆分为子订单
 }
 
 type OrderDetailResponse struct {
@@ -47,13 +102,121 @@ type OrderDetailResponse struct {
 	Mobile    string     `json:"mobile"`
 	GoodInfo  []GoodInfo `json:"goods"`
 	AlipayUrl string     `json:"alipay_url"`
+	PayUrl    string     `json:"pay_url"`
+	QrCode    string     `json:"qr_code"`
+
+	Price PriceBreakdownResponse `json:"price"`
+
+	ParentId   int32               `json:"parent_id"`
+	ParentSn   string              `json:"parent_sn"`
+	MerchantId int32               `json:"merchant_id"`
+	Split      bool                `json:"split"`
+	SubOrders  []OrderListResponse `json:"sub_orders"` // 已拆分的父订单的子订单
 }
 
 type GoodInfo struct {
-	Id   int32  `json:"id"`
-	Name string `json:"name"`
+	Id       int32  `json:"id"`
+	Name     string `json:"name"`
+	SkuID    int32  `json:"sku_id,omitempty"`
+	SkuSpecs string `json:"sku_specs,omitempty"` // 下单时的规格描述
 
 	Image string  `json:"image"`
 	Price float32 `json:"price"`
 	Nums  int32   `json:"nums"`
 }
+
+type OrderStatusHistoryResponse struct {
+	Id         int32  `json:"id"`
+	OrderSn    string `json:"order_sn"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Operator   string `json:"operator"`
+	Reason     string `json:"reason"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
+type RefundItemRequest struct {
+	OrderGoodsId int32 `json:"order_goods_id" binding:"required,min=1"`
+	Nums         int32 `json:"nums" binding:"required,min=1"`
+}
+
+type RefundApplyRequest struct {
+	Reason string              `json:"reason" binding:"required,max=200"`
+	Items  []RefundItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+type RefundIdRequest struct {
+	Id       int32 `uri:"id" binding:"required,min=1"`
+	RefundId int32 `uri:"refund_id" binding:"required,min=1"`
+}
+
+type PayNotificationIdRequest struct {
+	Id int32 `uri:"notify_id" binding:"required,min=1"`
+}
+
+type PayNotificationResponse struct {
+	Id          int32  `json:"id"`
+	PayType     string `json:"pay_type"`
+	OrderSn     string `json:"order_sn"`
+	TradeNo     string `json:"trade_no"`
+	TradeStatus string `json:"trade_status"`
+	Amount      int64  `json:"amount"` // 金额（分）
+	PayTime     int64  `json:"pay_time"`
+	Status      string `json:"status"`
+	Remark      string `json:"remark"`
+	NotifyCount int32  `json:"notify_count"`
+	Payload     string `json:"payload"`
+	CreatedAt   int64  `json:"created_at"`
+	UpdatedAt   int64  `json:"updated_at"`
+}
+
+type ShipRequest struct {
+	Carrier    string `json:"carrier" binding:"required,max=20"`
+	TrackingNo string `json:"tracking_no" binding:"required,max=50"`
+}
+
+type ShipmentEventResponse struct {
+	Time        int64  `json:"time"`
+	Status      string `json:"status"`
+	Location    string `json:"location"`
+	Description string `json:"description"`
+}
+
+type ShipmentResponse struct {
+	Id          int32                   `json:"id"`
+	OrderId     int32                   `json:"order_id"`
+	OrderSn     string                  `json:"order_sn"`
+	Carrier     string                  `json:"carrier"`
+	TrackingNo  string                  `json:"tracking_no"`
+	Status      string                  `json:"status"`
+	ShippedAt   int64                   `json:"shipped_at"`
+	DeliveredAt int64                   `json:"delivered_at"`
+	Events      []ShipmentEventResponse `json:"events"`
+}
+
+type RefundRejectRequest struct {
+	Remark string `json:"remark" binding:"required,max=200"`
+}
+
+type RefundGoodsResponse struct {
+	OrderGoodsId int32   `json:"order_goods_id"`
+	GoodsId      int32   `json:"goods_id"`
+	GoodsName    string  `json:"goods_name"`
+	Nums         int32   `json:"nums"`
+	Amount       float32 `json:"amount"`
+	RefundAmount int64   `json:"refund_amount"` // 分
+}
+
+type RefundResponse struct {
+	Id        int32                 `json:"id"`
+	RefundSn  string                `json:"refund_sn"`
+	OrderSn   string                `json:"order_sn"`
+	Status    string                `json:"status"`
+	Amount    float32               `json:"amount"`
+	Reason    string                `json:"reason"`
+	Remark    string                `json:"remark"`
+	CreatedAt int64                 `json:"created_at"`
+	Goods     []RefundGoodsResponse `json:"goods"`
+	// RefundAmount 退款金额（分），Amount 为元
+	RefundAmount int64 `json:"refund_amount"`
+}
@@ -3,6 +3,8 @@ package order
 type CartListResponse struct {
 	Id          int32    `json:"id"`
 	GoodID      int32    `json:"good_id"`
+	SkuID       int32    `json:"sku_id,omitempty"`
+	SkuSpecs    string   `json:"sku_specs,omitempty"`
 	Name        string   `form:"name" json:"name"`
 	GoodsSn     string   `form:"goods_sn" json:"goods_sn"`
 	Stocks      int32    `form:"stocks" json:"stocks"` // 有值时校验 ≥1
@@ -20,6 +22,7 @@ type CartListResponse struct {
 
 type CartAddRequest struct {
 	GoodID int32 `json:"good_id" binding:"required"`
+	SkuID  int32 `json:"sku_id"` // 有规格的商品必须指定
 	Num    int32 `json:"num" binding:"required" min:"1"`
 }
 
@@ -31,7 +34,39 @@ type CartIdRequest struct {
 	Id int32 `uri:"id" binding:"required,min=1"`
 }
 
+// CartSkuRequest 删除和修改有规格的商品时用 sku_id 指定规格
+type CartSkuRequest struct {
+	SkuID int32 `form:"sku_id" binding:"omitempty,min=0"`
+}
+
 type CartUpdateRequest struct {
 	Num     int32 `json:"num" binding:"required" min:"1"`
 	Checked *bool `json:"checked"`
 }
+
+type CartValidateRequest struct {
+	CheckedOnly bool `form:"checked_only" json:"checked_only"` // 只校验选中的商品
+	AcceptPrice bool `form:"accept_price" json:"accept_price"` // 确认新价格，把记录的单价更新为当前价格
+}
+
+type CartLineCheckResponse struct {
+	GoodID            int32   `json:"good_id"`
+	SkuID             int32   `json:"sku_id,omitempty"`
+	SkuSpecs          string  `json:"sku_specs,omitempty"`
+	Name              string  `json:"name"`
+	Num               int32   `json:"num"`
+	Checked           bool    `json:"checked"`
+	AddedPrice        float32 `json:"added_price"` // 加入购物车时的单价，0 表示没有记录
+	Price             float32 `json:"price"`
+	Stock             int32   `json:"stock"`
+	Limit             int32   `json:"limit"` // 限购数量，0 为不限购
+	PriceChanged      bool    `json:"price_changed"`
+	OffSale           bool    `json:"off_sale"`
+	InsufficientStock bool    `json:"insufficient_stock"`
+	LimitExceeded     bool    `json:"limit_exceeded"`
+}
+
+type CartValidateResponse struct {
+	Valid bool                    `json:"valid"`
+	Lines []CartLineCheckResponse `json:"lines"`
+}
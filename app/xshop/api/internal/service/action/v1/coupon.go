@@ -0,0 +1,48 @@
+package v1
+
+import (
+	pb "Advanced_Shop/api/action/v1"
+	"Advanced_Shop/app/xshop/api/internal/data"
+	"context"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CouponSrv interface {
+	CreateCouponTemplate(context.Context, *pb.CouponTemplateRequest) (*pb.CouponTemplateResponse, error)
+	UpdateCouponTemplate(context.Context, *pb.CouponTemplateRequest) (*emptypb.Empty, error)
+	CouponTemplateList(context.Context, *pb.CouponTemplateFilter) (*pb.CouponTemplateListResponse, error)
+	ClaimCoupon(context.Context, *pb.ClaimCouponRequest) (*pb.UserCouponResponse, error)
+	UserCouponList(context.Context, *pb.UserCouponFilter) (*pb.UserCouponListResponse, error)
+}
+
+type couponService struct {
+	data data.DataFactory
+}
+
+func NewCouponService(data data.DataFactory) CouponSrv {
+	return &couponService{
+		data: data,
+	}
+}
+
+func (cs *couponService) CreateCouponTemplate(ctx context.Context, request *pb.CouponTemplateRequest) (*pb.CouponTemplateResponse, error) {
+	return cs.data.Coupon().CreateCouponTemplate(ctx, request)
+}
+
+func (cs *couponService) UpdateCouponTemplate(ctx context.Context, request *pb.CouponTemplateRequest) (*emptypb.Empty, error) {
+	return cs.data.Coupon().UpdateCouponTemplate(ctx, request)
+}
+
+func (cs *couponService) CouponTemplateList(ctx context.Context, request *pb.CouponTemplateFilter) (*pb.CouponTemplateListResponse, error) {
+	return cs.data.Coupon().CouponTemplateList(ctx, request)
+}
+
+func (cs *couponService) ClaimCoupon(ctx context.Context, request *pb.ClaimCouponRequest) (*pb.UserCouponResponse, error) {
+	return cs.data.Coupon().ClaimCoupon(ctx, request)
+}
+
+func (cs *couponService) UserCouponList(ctx context.Context, request *pb.UserCouponFilter) (*pb.UserCouponListResponse, error) {
+	return cs.data.Coupon().UserCouponList(ctx, request)
+}
+
+var _ CouponSrv = (*couponService)(nil)
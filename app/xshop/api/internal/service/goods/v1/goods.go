@@ -10,6 +10,7 @@ import (
 
 type GoodsSrv interface {
 	List(ctx context.Context, request *gpb.GoodsFilterRequest) (*gpb.GoodsListResponse, error)
+	SuggestGoods(ctx context.Context, in *gpb.SuggestRequest, opts ...grpc.CallOption) (*gpb.SuggestResponse, error)
 	BatchGetGoods(ctx context.Context, in *gpb.BatchGoodsIdInfo, opts ...grpc.CallOption) (*gpb.GoodsListResponse, error)
 	CreateGoods(ctx context.Context, in *gpb.CreateGoodsInfo, opts ...grpc.CallOption) (*gpb.GoodsInfoResponse, error)
 	DeleteGoods(ctx context.Context, in *gpb.DeleteGoodsInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -37,6 +38,13 @@ type GoodsSrv interface {
 	CreateCategoryBrand(ctx context.Context, in *gpb.CategoryBrandRequest, opts ...grpc.CallOption) (*gpb.CategoryBrandResponse, error)
 	DeleteCategoryBrand(ctx context.Context, in *gpb.CategoryBrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCategoryBrand(ctx context.Context, in *gpb.CategoryBrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 规格和SKU
+	SpecificationList(ctx context.Context, in *gpb.SpecificationFilterRequest, opts ...grpc.CallOption) (*gpb.SpecificationListResponse, error)
+	CreateSpecification(ctx context.Context, in *gpb.SpecificationRequest, opts ...grpc.CallOption) (*gpb.SpecificationResponse, error)
+	UpdateSpecification(ctx context.Context, in *gpb.SpecificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GoodsSkuList(ctx context.Context, in *gpb.GoodInfoRequest, opts ...grpc.CallOption) (*gpb.SkuListResponse, error)
+	CreateSku(ctx context.Context, in *gpb.SkuInfoRequest, opts ...grpc.CallOption) (*gpb.SkuInfoResponse, error)
+	UpdateSku(ctx context.Context, in *gpb.SkuInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type goodsService struct {
@@ -49,6 +57,11 @@ func (gs *goodsService) List(ctx context.Context, request *gpb.GoodsFilterReques
 	return gs.data.Goods().GoodsList(ctx, request)
 }
 
+// SuggestGoods 搜索建议和热搜词
+func (gs *goodsService) SuggestGoods(ctx context.Context, in *gpb.SuggestRequest, opts ...grpc.CallOption) (*gpb.SuggestResponse, error) {
+	return gs.data.Goods().SuggestGoods(ctx, in)
+}
+
 // BatchGetGoods 批量获取商品
 func (gs *goodsService) BatchGetGoods(ctx context.Context, in *gpb.BatchGoodsIdInfo, opts ...grpc.CallOption) (*gpb.GoodsListResponse, error) {
 	return gs.data.Goods().BatchGetGoods(ctx, in)
@@ -174,6 +187,38 @@ func (gs *goodsService) UpdateCategoryBrand(ctx context.Context, in *gpb.Categor
 	return gs.data.Goods().UpdateCategoryBrand(ctx, in)
 }
 
+// -------------------------- 规格和SKU相关方法 --------------------------
+
+// SpecificationList 获取分类下的规格
+func (gs *goodsService) SpecificationList(ctx context.Context, in *gpb.SpecificationFilterRequest, opts ...grpc.CallOption) (*gpb.SpecificationListResponse, error) {
+	return gs.data.Goods().SpecificationList(ctx, in)
+}
+
+// CreateSpecification 创建规格
+func (gs *goodsService) CreateSpecification(ctx context.Context, in *gpb.SpecificationRequest, opts ...grpc.CallOption) (*gpb.SpecificationResponse, error) {
+	return gs.data.Goods().CreateSpecification(ctx, in)
+}
+
+// UpdateSpecification 更新规格
+func (gs *goodsService) UpdateSpecification(ctx context.Context, in *gpb.SpecificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return gs.data.Goods().UpdateSpecification(ctx, in)
+}
+
+// GoodsSkuList 获取商品的所有SKU
+func (gs *goodsService) GoodsSkuList(ctx context.Context, in *gpb.GoodInfoRequest, opts ...grpc.CallOption) (*gpb.SkuListResponse, error) {
+	return gs.data.Goods().GoodsSkuList(ctx, in)
+}
+
+// CreateSku 创建SKU
+func (gs *goodsService) CreateSku(ctx context.Context, in *gpb.SkuInfoRequest, opts ...grpc.CallOption) (*gpb.SkuInfoResponse, error) {
+	return gs.data.Goods().CreateSku(ctx, in)
+}
+
+// UpdateSku 更新SKU
+func (gs *goodsService) UpdateSku(ctx context.Context, in *gpb.SkuInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	return gs.data.Goods().UpdateSku(ctx, in)
+}
+
 // NewGoods 创建goodsService实例
 func NewGoods(data data.DataFactory) GoodsSrv {
 	return &goodsService{data: data}
@@ -10,6 +10,7 @@ import (
 type InventorySrv interface {
 	SetInv(ctx context.Context, in *pb.GoodsInvInfo) (*emptypb.Empty, error)
 	InvDetail(ctx context.Context, in *pb.GoodsInvInfo) (*pb.GoodsInvInfo, error)
+	BatchInvDetail(ctx context.Context, in *pb.BatchInvRequest) (*pb.BatchInvResponse, error)
 	Sell(ctx context.Context, in *pb.SellInfo) (*emptypb.Empty, error)
 	Reback(ctx context.Context, in *pb.SellInfo) (*emptypb.Empty, error)
 }
@@ -32,6 +33,10 @@ func (is *inventorySrv) InvDetail(ctx context.Context, in *pb.GoodsInvInfo) (*pb
 	return is.data.Inventory().InvDetail(ctx, in)
 }
 
+func (is *inventorySrv) BatchInvDetail(ctx context.Context, in *pb.BatchInvRequest) (*pb.BatchInvResponse, error) {
+	return is.data.Inventory().BatchInvDetail(ctx, in)
+}
+
 func (is *inventorySrv) Sell(ctx context.Context, in *pb.SellInfo) (*emptypb.Empty, error) {
 	return is.data.Inventory().Sell(ctx, in)
 }
@@ -14,14 +14,30 @@ type OrderSrv interface {
 	CreateCartItem(context.Context, *pb.CartItemRequest) (*pb.ShopCartInfoResponse, error)
 	UpdateCartItem(context.Context, *pb.CartItemRequest) (*emptypb.Empty, error)
 	DeleteCartItem(context.Context, *pb.CartItemRequest) (*emptypb.Empty, error)
+	MergeCart(context.Context, *pb.MergeCartRequest) (*emptypb.Empty, error)
+	ValidateCart(context.Context, *pb.ValidateCartRequest) (*pb.ValidateCartResponse, error)
 	// 订单
 	CreateOrder(context.Context, *pb.CreateRequest) (*emptypb.Empty, error)
-	CreateOrderCom(context.Context, *pb.OrderRequest) (*emptypb.Empty, error)
+	CreateOrderCom(context.Context, *pb.CreateRequest) (*emptypb.Empty, error)
+	CancelOrder(context.Context, *pb.OrderRequest) (*emptypb.Empty, error)
 	SubmitOrder(context.Context, *pb.OrderRequest) (*pb.SubmitResponse, error)
+	PreviewOrder(context.Context, *pb.OrderRequest) (*pb.PreviewOrderResponse, error)
 	OrderList(context.Context, *pb.OrderFilterRequest) (*pb.OrderListResponse, error)
 	OrderDetail(context.Context, *pb.OrderRequest) (*pb.OrderInfoDetailResponse, error)
 	UpdateOrderStatus(context.Context, *pb.OrderStatus) (*emptypb.Empty, error)
+	OrderStatusHistory(context.Context, *pb.OrderStatusHistoryRequest) (*pb.OrderStatusHistoryResponse, error)
+	// 退款
+	ApplyRefund(context.Context, *pb.RefundApplyRequest) (*pb.RefundInfo, error)
+	ApproveRefund(context.Context, *pb.RefundReviewRequest) (*pb.RefundInfo, error)
+	RejectRefund(context.Context, *pb.RefundReviewRequest) (*pb.RefundInfo, error)
+	RefundList(context.Context, *pb.RefundFilterRequest) (*pb.RefundListResponse, error)
 	OrderDetailByOrderSn(ctx context.Context, in *pb.AlipayOrderSnRequest) (*pb.OrderInfoDetailResponse, error)
+	// 支付通知
+	PayNotify(context.Context, *pb.PayNotification) (*pb.PayNotificationInfo, error)
+	PayNotificationList(context.Context, *pb.PayNotificationFilterRequest) (*pb.PayNotificationListResponse, error)
+	ReplayPayNotification(context.Context, *pb.PayNotificationReplayRequest) (*pb.PayNotificationInfo, error)
+	ShipOrder(context.Context, *pb.ShipRequest) (*pb.ShipmentInfo, error)
+	ShipmentDetail(context.Context, *pb.ShipmentRequest) (*pb.ShipmentInfo, error)
 }
 
 type orderService struct {
@@ -42,14 +58,28 @@ func (o orderService) CartItemList(ctx context.Context, info *pb.UserInfo) ([]or
 		return nil, 0, err
 	}
 	idList := make([]int32, 0)
+	skuIDs := make([]int32, 0)
 	for _, cart := range list.Data {
 		idList = append(idList, cart.GoodsId)
+		if cart.SkuId != 0 {
+			skuIDs = append(skuIDs, cart.SkuId)
+		}
 	}
 
 	goodsList, err := o.data.Goods().BatchGetGoods(ctx, &proto.BatchGoodsIdInfo{Id: idList})
 	if err != nil {
 		return nil, 0, err
 	}
+	skus := make(map[int32]*proto.SkuInfoResponse, len(skuIDs))
+	if len(skuIDs) > 0 {
+		skuList, err := o.data.Goods().BatchGetSkus(ctx, &proto.BatchSkuIdInfo{Id: skuIDs})
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, sku := range skuList.Data {
+			skus[sku.Id] = sku
+		}
+	}
 	var response []order.CartListResponse
 	for _, item := range list.Data {
 		for _, goodModel := range goodsList.Data {
@@ -70,6 +100,16 @@ func (o orderService) CartItemList(ctx context.Context, info *pb.UserInfo) ([]or
 					FrontImage:  goodModel.GoodsFrontImage,
 					Chacked:     item.Checked,
 				}
+				// 有规格的商品展示规格的价格和图片
+				if sku, ok := skus[item.SkuId]; ok {
+					dataCart.SkuID = sku.Id
+					dataCart.SkuSpecs = sku.SpecText
+					dataCart.GoodPrice = sku.Price
+					dataCart.MarketPrice = sku.MarketPrice
+					if sku.Image != "" {
+						dataCart.FrontImage = sku.Image
+					}
+				}
 				response = append(response, dataCart)
 			}
 		}
@@ -89,18 +129,34 @@ func (o orderService) DeleteCartItem(ctx context.Context, request *pb.CartItemRe
 	return o.data.Order().DeleteCartItem(ctx, request)
 }
 
+func (o orderService) MergeCart(ctx context.Context, request *pb.MergeCartRequest) (*emptypb.Empty, error) {
+	return o.data.Order().MergeCart(ctx, request)
+}
+
+func (o orderService) ValidateCart(ctx context.Context, request *pb.ValidateCartRequest) (*pb.ValidateCartResponse, error) {
+	return o.data.Order().ValidateCart(ctx, request)
+}
+
 func (o orderService) CreateOrder(ctx context.Context, request *pb.CreateRequest) (*emptypb.Empty, error) {
 	return o.data.Order().CreateOrder(ctx, request)
 }
 
-func (o orderService) CreateOrderCom(ctx context.Context, request *pb.OrderRequest) (*emptypb.Empty, error) {
+func (o orderService) CreateOrderCom(ctx context.Context, request *pb.CreateRequest) (*emptypb.Empty, error) {
 	return o.data.Order().CreateOrderCom(ctx, request)
 }
 
+func (o orderService) CancelOrder(ctx context.Context, request *pb.OrderRequest) (*emptypb.Empty, error) {
+	return o.data.Order().CancelOrder(ctx, request)
+}
+
 func (o orderService) SubmitOrder(ctx context.Context, request *pb.OrderRequest) (*pb.SubmitResponse, error) {
 	return o.data.Order().SubmitOrder(ctx, request)
 }
 
+func (o orderService) PreviewOrder(ctx context.Context, request *pb.OrderRequest) (*pb.PreviewOrderResponse, error) {
+	return o.data.Order().PreviewOrder(ctx, request)
+}
+
 func (o orderService) OrderList(ctx context.Context, request *pb.OrderFilterRequest) (*pb.OrderListResponse, error) {
 	return o.data.Order().OrderList(ctx, request)
 }
@@ -113,6 +169,46 @@ func (o orderService) UpdateOrderStatus(ctx context.Context, status *pb.OrderSta
 	return o.data.Order().UpdateOrderStatus(ctx, status)
 }
 
+func (o orderService) OrderStatusHistory(ctx context.Context, request *pb.OrderStatusHistoryRequest) (*pb.OrderStatusHistoryResponse, error) {
+	return o.data.Order().OrderStatusHistory(ctx, request)
+}
+
+func (o orderService) ApplyRefund(ctx context.Context, request *pb.RefundApplyRequest) (*pb.RefundInfo, error) {
+	return o.data.Order().ApplyRefund(ctx, request)
+}
+
+func (o orderService) ApproveRefund(ctx context.Context, request *pb.RefundReviewRequest) (*pb.RefundInfo, error) {
+	return o.data.Order().ApproveRefund(ctx, request)
+}
+
+func (o orderService) RejectRefund(ctx context.Context, request *pb.RefundReviewRequest) (*pb.RefundInfo, error) {
+	return o.data.Order().RejectRefund(ctx, request)
+}
+
+func (o orderService) RefundList(ctx context.Context, request *pb.RefundFilterRequest) (*pb.RefundListResponse, error) {
+	return o.data.Order().RefundList(ctx, request)
+}
+
 func (o orderService) OrderDetailByOrderSn(ctx context.Context, in *pb.AlipayOrderSnRequest) (*pb.OrderInfoDetailResponse, error) {
 	return o.data.Order().OrderDetailByOrderSn(ctx, in)
 }
+
+func (o orderService) PayNotify(ctx context.Context, request *pb.PayNotification) (*pb.PayNotificationInfo, error) {
+	return o.data.Order().PayNotify(ctx, request)
+}
+
+func (o orderService) PayNotificationList(ctx context.Context, request *pb.PayNotificationFilterRequest) (*pb.PayNotificationListResponse, error) {
+	return o.data.Order().PayNotificationList(ctx, request)
+}
+
+func (o orderService) ReplayPayNotification(ctx context.Context, request *pb.PayNotificationReplayRequest) (*pb.PayNotificationInfo, error) {
+	return o.data.Order().ReplayPayNotification(ctx, request)
+}
+
+func (o orderService) ShipOrder(ctx context.Context, request *pb.ShipRequest) (*pb.ShipmentInfo, error) {
+	return o.data.Order().ShipOrder(ctx, request)
+}
+
+func (o orderService) ShipmentDetail(ctx context.Context, request *pb.ShipmentRequest) (*pb.ShipmentInfo, error) {
+	return o.data.Order().ShipmentDetail(ctx, request)
+}
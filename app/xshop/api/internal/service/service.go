@@ -20,6 +20,7 @@ type ServiceFactory interface {
 	Address() v3.AddressSrv
 	Collection() v3.CollectionSrv
 	Message() v3.MessageSrv
+	Coupon() v3.CouponSrv
 }
 
 type service struct {
@@ -42,6 +43,10 @@ func (s *service) Message() v3.MessageSrv {
 	return v3.NewMessageService(s.data)
 }
 
+func (s *service) Coupon() v3.CouponSrv {
+	return v3.NewCouponService(s.data)
+}
+
 func (s *service) Inventory() v2.InventorySrv {
 	return v2.NewInventoryService(s.data)
 }
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"Advanced_Shop/app/pkg/common"
+	"Advanced_Shop/app/pkg/payment"
 	"Advanced_Shop/app/xshop/api/config"
 	v2 "Advanced_Shop/app/xshop/api/internal/controller/action/v1"
 	"Advanced_Shop/app/xshop/api/internal/controller/goods/v1"
@@ -29,7 +30,7 @@ func initRouter(g *restserver.Server, cfg *config.Config) {
 	}
 
 	serviceFactory := service.NewService(data, cfg.Sms, cfg.Jwt)
-	uController := user.NewUserController(g.Translator(), serviceFactory)
+	uController := user.NewUserController(g.Translator(), serviceFactory, cfg.Cart)
 	{
 		ugroup.POST("login", uController.Login)
 		ugroup.POST("register", common.Wrapper(uController.Register))
@@ -57,11 +58,20 @@ func initRouter(g *restserver.Server, cfg *config.Config) {
 		goodsController := goods.NewGoodsController(serviceFactory, g.Translator())
 		// 商品相关
 		goodsRouter.GET("/list", common.Wrapper(goodsController.GetGoodListView)) // 限流
+		goodsRouter.GET("/suggest", common.Wrapper(goodsController.GoodsSuggestView))
 		goodsRouter.POST("/", common.Wrapper(goodsController.CreateGoodView))
 		goodsRouter.GET("/:id", common.Wrapper(goodsController.GoodDetailView))
 		goodsRouter.PUT("/:id", common.Wrapper(goodsController.GoodUpdateView))
 		goodsRouter.PATCH("/:id", common.Wrapper(goodsController.GoodPatchUpdateView))
 		goodsRouter.DELETE("/:id", common.Wrapper(goodsController.GoodDeleteView))
+		goodsRouter.GET("/:id/skus", common.Wrapper(goodsController.GoodsSkuListView))
+		goodsRouter.POST("/:id/skus", common.Wrapper(goodsController.CreateSkuView))
+		v1.PUT("skus/:id", common.Wrapper(goodsController.UpdateSkuView))
+
+		// 规格相关
+		v1.GET("specifications", common.Wrapper(goodsController.SpecificationListView))
+		v1.POST("specifications", common.Wrapper(goodsController.CreateSpecificationView))
+		v1.PUT("specifications/:id", common.Wrapper(goodsController.UpdateSpecificationView))
 
 		// 图片相关
 		v1.GET("banners", common.Wrapper(goodsController.GetBannerListView))
@@ -97,25 +107,50 @@ func initRouter(g *restserver.Server, cfg *config.Config) {
 	v1 = orderGroup.Group("/v1")
 	orderRouter := v1.Group("orders")
 	{
-		orderController := v3.NewOrderController(serviceFactory, g.Translator(), cfg.Aliyun)
+		payments, err := payment.NewProviders(cfg.Aliyun, cfg.Payment)
+		if err != nil {
+			panic(err)
+		}
+		orderController := v3.NewOrderController(serviceFactory, g.Translator(), payments, cfg.Cart)
 
 		{
 			// order 相关
-			orderRouter.GET("", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderListView))       // 查看所有订单
-			orderRouter.POST("", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderCreateView))    // 创建订单
-			orderRouter.GET("/:id", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderDetailView)) // 订单细节
+			orderRouter.GET("", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderListView))                // 查看所有订单
+			orderRouter.POST("", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderCreateView))             // 创建订单
+			orderRouter.POST("/preview", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderPreviewView))    // 试算订单价格
+			orderRouter.GET("/:id", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderDetailView))          // 订单细节
+			orderRouter.POST("/:id/cancel", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderCancelView))  // 取消订单
+			orderRouter.GET("/:id/history", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderHistoryView)) // 订单状态变更记录
+
+			// 退款相关，审核只允许管理员
+			orderRouter.GET("/:id/refunds", jwtAuth.AuthFunc(), common.Wrapper(orderController.RefundListView))                        // 退款单列表
+			orderRouter.POST("/:id/refunds", jwtAuth.AuthFunc(), common.Wrapper(orderController.RefundApplyView))                      // 申请退款
+			orderRouter.POST("/:id/refunds/:refund_id/approve", jwtAuth.AuthFunc(), common.Wrapper(orderController.RefundApproveView)) // 审核通过
+			orderRouter.POST("/:id/refunds/:refund_id/reject", jwtAuth.AuthFunc(), common.Wrapper(orderController.RefundRejectView))   // 审核拒绝
+
+			// 支付通知排查，只允许管理员
+			orderRouter.GET("/:id/pay-notifications", jwtAuth.AuthFunc(), common.Wrapper(orderController.PayNotificationListView)) // 订单收到的支付通知
+
+			// 发货只允许管理员，用户可以查看自己订单的物流轨迹
+			orderRouter.POST("/:id/ship", jwtAuth.AuthFunc(), common.Wrapper(orderController.OrderShipView))         // 发货
+			orderRouter.GET("/:id/shipment", jwtAuth.AuthFunc(), common.Wrapper(orderController.ShipmentDetailView)) // 物流轨迹
 		}
-		// cart 相关
+		// cart 相关，未登录时使用游客购物车
 		cartRouter := v1.Group("shopcarts")
+		cartRouter.Use(optionalAuth(jwtAuth))
 		{
-			cartRouter.GET("", common.Wrapper(orderController.CartListView))              // 购物车列表
-			cartRouter.DELETE("/:id", common.Wrapper(orderController.DeleteCartItemView)) // 删除条目
-			cartRouter.POST("", common.Wrapper(orderController.AddItemView))              // 添加商品到购物车
-			cartRouter.PATCH("/:id", common.Wrapper(orderController.UpdatePatchView))     // 更新购物车中的某个商品
+			cartRouter.GET("", common.Wrapper(orderController.CartListView))               // 购物车列表
+			cartRouter.DELETE("/:id", common.Wrapper(orderController.DeleteCartItemView))  // 删除条目
+			cartRouter.POST("", common.Wrapper(orderController.AddItemView))               // 添加商品到购物车
+			cartRouter.PATCH("/:id", common.Wrapper(orderController.UpdatePatchView))      // 更新购物车中的某个商品
+			cartRouter.GET("/validate", common.Wrapper(orderController.CartValidateView))  // 校验下架、库存、限购和价格变化
+			cartRouter.POST("/validate", common.Wrapper(orderController.CartValidateView)) // 校验并确认新价格
 		}
-		// 阿里云回调
-		alipayRouter := v1.Group("/pay")
-		alipayRouter.POST("/callback", orderController.AlipayCallBackView)
+		// 支付回调
+		payRouter := v1.Group("/pay")
+		payRouter.POST("/callback", orderController.AlipayCallBackView)                                                                   // 支付宝回调，兼容原有地址
+		payRouter.POST("/notify/:pay_type", orderController.PayNotifyView)                                                                // 各渠道回调
+		payRouter.POST("/notifications/:notify_id/replay", jwtAuth.AuthFunc(), common.Wrapper(orderController.PayNotificationReplayView)) // 重放支付通知，只允许管理员
 
 	}
 
@@ -155,4 +190,15 @@ func initRouter(g *restserver.Server, cfg *config.Config) {
 		messageRouter.POST("", jwtAuth.AuthFunc(), common.Wrapper(ActionController.CreateMessageView)) // 添加留言
 	}
 
+	// 优惠券路由组，模板管理只允许管理员
+	couponRouter := v1.Group("coupons")
+	{
+		couponRouter.GET("", jwtAuth.AuthFunc(), common.Wrapper(ActionController.CouponTemplateListView))                 // 可领取的优惠券
+		couponRouter.POST("/:id/claim", jwtAuth.AuthFunc(), common.Wrapper(ActionController.ClaimCouponView))             // 领取优惠券
+		couponRouter.GET("/mine", jwtAuth.AuthFunc(), common.Wrapper(ActionController.UserCouponListView))                // 我的券包
+		couponRouter.GET("/templates", jwtAuth.AuthFunc(), common.Wrapper(ActionController.AdminCouponTemplateListView))  // 全部模板
+		couponRouter.POST("/templates", jwtAuth.AuthFunc(), common.Wrapper(ActionController.CreateCouponTemplateView))    // 新建模板
+		couponRouter.PUT("/templates/:id", jwtAuth.AuthFunc(), common.Wrapper(ActionController.UpdateCouponTemplateView)) // 修改模板
+	}
+
 }
@@ -118,6 +118,14 @@ func WithRunFunc(run RunFunc) Option {
 	}
 }
 
+// WithCommands adds sub commands to the application, such as maintenance
+// commands that share the configuration file of the server.
+func WithCommands(cmds ...*Command) Option {
+	return func(a *App) {
+		a.commands = append(a.commands, cmds...)
+	}
+}
+
 // WithDescription is used to set the description of the application.
 func WithDescription(desc string) Option {
 	return func(a *App) {
@@ -6,8 +6,11 @@ import (
 	"runtime"
 	"strings"
 
+	"Advanced_Shop/pkg/errors"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 )
 
 // Command is a sub command structure of a cli application.
@@ -89,6 +92,8 @@ func (c *Command) cobraCommand() *cobra.Command {
 			cmd.Flags().AddFlagSet(f)
 		}
 		// c.options.AddFlags(cmd.Flags())
+		// 子命令和应用读取同一个配置文件
+		cmd.Flags().AddFlag(pflag.Lookup(configFlagName))
 	}
 	addHelpCommandFlag(c.usage, cmd.Flags())
 
@@ -96,6 +101,12 @@ func (c *Command) cobraCommand() *cobra.Command {
 }
 
 func (c *Command) runCommand(cmd *cobra.Command, args []string) {
+	if c.options != nil {
+		if err := c.applyOptions(cmd); err != nil {
+			fmt.Printf("%v %v\n", color.RedString("Error:"), err)
+			os.Exit(1)
+		}
+	}
 	if c.runFunc != nil {
 		if err := c.runFunc(args); err != nil {
 			fmt.Printf("%v %v\n", color.RedString("Error:"), err)
@@ -104,6 +115,26 @@ func (c *Command) runCommand(cmd *cobra.Command, args []string) {
 	}
 }
 
+// applyOptions reads the flags and the configuration file into the options
+// and validates them, the same as the application does before running.
+func (c *Command) applyOptions(cmd *cobra.Command) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return err
+	}
+	if err := viper.Unmarshal(c.options); err != nil {
+		return err
+	}
+	if completeableOptions, ok := c.options.(CompleteableOptions); ok {
+		if err := completeableOptions.Complete(); err != nil {
+			return err
+		}
+	}
+	if errs := c.options.Validate(); len(errs) != 0 {
+		return errors.NewAggregate(errs)
+	}
+	return nil
+}
+
 // AddCommand adds sub command to the application.
 func (a *App) AddCommand(cmd *Command) {
 	a.commands = append(a.commands, cmd)
@@ -11,6 +11,8 @@ import (
 type EsOptions struct {
 	Host string
 	Port string
+	// NoHealthcheck 不做健康检查，ES 暂时不可用时也能创建客户端，请求失败由调用方处理
+	NoHealthcheck bool
 }
 
 func NewEsClient(opts *EsOptions) (*elastic.Client, error) {
@@ -18,6 +20,7 @@ func NewEsClient(opts *EsOptions) (*elastic.Client, error) {
 		elastic.SetErrorLog(log.New(os.Stderr, "ELASTIC ", log.LstdFlags)),
 		elastic.SetInfoLog(log.New(os.Stdout, "", log.LstdFlags)),
 		elastic.SetSniff(false),
+		elastic.SetHealthcheck(!opts.NoHealthcheck),
 		elastic.SetURL(fmt.Sprintf("http://%s:%s/", opts.Host, opts.Port)))
 	if err != nil {
 		return nil, err